@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+	"github.com/rickyreddygari/walletsdk/internal/storage/file"
+)
+
+func main() {
+	path := flag.String("file", os.Getenv("AUDIT_LOG_PATH"), "path to the audit log file")
+	flag.Parse()
+
+	if *path == "" {
+		log.Fatal("audit log path required: pass -file or set AUDIT_LOG_PATH")
+	}
+
+	entries, err := file.ReadAuditLog(*path)
+	if err != nil {
+		log.Fatalf("read audit log: %v", err)
+	}
+
+	result := service.VerifyAuditChain(entries)
+	if !result.Valid {
+		fmt.Printf("audit log INVALID at entry %d: %s\n", result.FirstInvalid, result.Reason)
+		os.Exit(1)
+	}
+
+	fmt.Printf("audit log OK: %d entries, head %s\n", result.Entries, result.HeadHash)
+}
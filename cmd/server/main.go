@@ -15,6 +15,8 @@ import (
 	"github.com/rickyreddygari/walletsdk/internal/app"
 )
 
+const maintenanceInterval = time.Minute
+
 func main() {
 	container, err := app.NewContainer()
 	if err != nil {
@@ -53,6 +55,34 @@ func main() {
 		}
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(maintenanceInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				purged, err := container.WalletService.PurgeDeletedWallets(ctx)
+				if err != nil {
+					log.Printf("purge deleted wallets: %v", err)
+				}
+				if purged > 0 {
+					log.Printf("removed keys of %d deleted wallets", purged)
+				}
+				expired, err := container.WalletService.ExpireApprovals(ctx)
+				if err != nil {
+					log.Printf("expire approvals: %v", err)
+				}
+				if expired > 0 {
+					log.Printf("expired %d approval requests", expired)
+				}
+			}
+		}
+	}()
+
 	<-ctx.Done()
 	log.Println("shutdown signal received")
 
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) CreateAPIKey(ctx context.Context, req *grpcpb.CreateAPIKeyRequest) (*grpcpb.CreateAPIKeyResponse, error) {
+	scopes, err := service.ParseScopes(req.GetScopes())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	key, err := s.apiKeys.CreateAPIKey(ctx, req.GetName(), req.GetTenantId(), scopes)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.CreateAPIKeyResponse{Key: toProtoAPIKey(&key.APIKey), Token: key.Token}, nil
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, _ *grpcpb.ListAPIKeysRequest) (*grpcpb.ListAPIKeysResponse, error) {
+	keys, err := s.apiKeys.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListAPIKeysResponse{Keys: make([]*grpcpb.APIKey, 0, len(keys))}
+	for i := range keys {
+		resp.Keys = append(resp.Keys, toProtoAPIKey(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, req *grpcpb.RevokeAPIKeyRequest) (*grpcpb.APIKey, error) {
+	key, err := s.apiKeys.RevokeAPIKey(ctx, req.GetKeyId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoAPIKey(key), nil
+}
+
+func toProtoAPIKey(key *service.APIKey) *grpcpb.APIKey {
+	resp := &grpcpb.APIKey{
+		Id:            key.ID,
+		TenantId:      key.TenantID,
+		Name:          key.Name,
+		Scopes:        make([]string, 0, len(key.Scopes)),
+		CreatedAtUnix: unixOrZero(key.CreatedAt),
+	}
+	for _, scope := range key.Scopes {
+		resp.Scopes = append(resp.Scopes, string(scope))
+	}
+	if key.RevokedAt != nil {
+		resp.RevokedAtUnix = key.RevokedAt.Unix()
+	}
+	return resp
+}
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) ListApprovals(ctx context.Context, req *grpcpb.ListApprovalsRequest) (*grpcpb.ListApprovalsResponse, error) {
+	requests, err := s.wallets.ListApprovals(ctx, service.ApprovalStatus(req.GetStatus()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListApprovalsResponse{Approvals: make([]*grpcpb.ApprovalRequest, 0, len(requests))}
+	for i := range requests {
+		resp.Approvals = append(resp.Approvals, toProtoApproval(&requests[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetApproval(ctx context.Context, req *grpcpb.GetApprovalRequest) (*grpcpb.ApprovalRequest, error) {
+	request, err := s.wallets.GetApproval(ctx, req.GetApprovalId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoApproval(request), nil
+}
+
+func (s *Server) ApproveRequest(ctx context.Context, req *grpcpb.DecideApprovalRequest) (*grpcpb.ApprovalRequest, error) {
+	request, err := s.wallets.ApproveRequest(ctx, req.GetApprovalId(), req.GetComment())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoApproval(request), nil
+}
+
+func (s *Server) RejectRequest(ctx context.Context, req *grpcpb.DecideApprovalRequest) (*grpcpb.ApprovalRequest, error) {
+	request, err := s.wallets.RejectRequest(ctx, req.GetApprovalId(), req.GetComment())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoApproval(request), nil
+}
+
+func toProtoApproval(request *service.ApprovalRequest) *grpcpb.ApprovalRequest {
+	decisions := make([]*grpcpb.ApprovalDecision, 0, len(request.Decisions))
+	for _, decision := range request.Decisions {
+		decisions = append(decisions, &grpcpb.ApprovalDecision{
+			Actor:    decision.Actor,
+			Approved: decision.Approved,
+			Comment:  decision.Comment,
+			AtUnix:   unixOrZero(decision.At),
+		})
+	}
+
+	return &grpcpb.ApprovalRequest{
+		Id:                  request.ID,
+		TenantId:            request.TenantID,
+		WalletId:            request.WalletID,
+		PolicyId:            request.PolicyID,
+		Requester:           request.Requester,
+		Transaction:         toProtoTransaction(&request.Transaction),
+		Required:            int32(request.Required),
+		Approvers:           request.Approvers,
+		Broadcast:           request.Broadcast,
+		Decisions:           decisions,
+		Status:              string(request.Status),
+		SignedTransaction:   request.SignedTransaction,
+		TxHash:              request.TxHash,
+		BroadcastError:      request.BroadcastError,
+		Error:               request.Error,
+		UserOperation:       toProtoUserOperationRequest(request.WalletID, request.UserOperation),
+		UserOperationResult: toProtoUserOperationResult(request.UserOperationResult),
+		CreatedAtUnix:       unixOrZero(request.CreatedAt),
+		UpdatedAtUnix:       unixOrZero(request.UpdatedAt),
+		ExpiresAtUnix:       unixOrZero(request.ExpiresAt),
+	}
+}
+
+func toProtoTransaction(tx *service.Transaction) *grpcpb.Transaction {
+	return &grpcpb.Transaction{
+		ChainId:              tx.ChainID,
+		From:                 tx.From,
+		To:                   tx.To,
+		Value:                tx.Value,
+		Data:                 tx.Data,
+		GasLimit:             tx.GasLimit,
+		GasPrice:             tx.GasPrice,
+		MaxPriorityFeePerGas: tx.MaxPriorityFeePerGas,
+		Nonce:                tx.Nonce,
+		AuthorizationList:    toProtoAuthorizations(tx.AuthorizationList),
+		MaxFeePerBlobGas:     tx.MaxFeePerBlobGas,
+		Blobs:                tx.Blobs,
+	}
+}
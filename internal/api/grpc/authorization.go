@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) SignAuthorization(ctx context.Context, req *grpcpb.SignAuthorizationRequest) (*grpcpb.Authorization, error) {
+	result, err := s.wallets.SignAuthorization(ctx, req.GetWalletId(), service.AuthorizationRequest{
+		Address: req.GetAddress(),
+		ChainID: req.GetChainId(),
+		Nonce:   req.Nonce,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoAuthorization(*result), nil
+}
+
+func fromProtoAuthorizations(list []*grpcpb.Authorization) ([]service.Authorization, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	authorizations := make([]service.Authorization, len(list))
+	for i, authorization := range list {
+		if authorization.GetYParity() > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "authorization %d has an invalid y_parity", i)
+		}
+		authorizations[i] = service.Authorization{
+			ChainID: authorization.GetChainId(),
+			Address: authorization.GetAddress(),
+			Nonce:   authorization.GetNonce(),
+			YParity: uint8(authorization.GetYParity()),
+			R:       authorization.GetR(),
+			S:       authorization.GetS(),
+		}
+	}
+	return authorizations, nil
+}
+
+func toProtoAuthorization(authorization service.Authorization) *grpcpb.Authorization {
+	return &grpcpb.Authorization{
+		ChainId: authorization.ChainID,
+		Address: authorization.Address,
+		Nonce:   authorization.Nonce,
+		YParity: uint32(authorization.YParity),
+		R:       authorization.R,
+		S:       authorization.S,
+	}
+}
+
+func toProtoAuthorizations(list []service.Authorization) []*grpcpb.Authorization {
+	if len(list) == 0 {
+		return nil
+	}
+	authorizations := make([]*grpcpb.Authorization, len(list))
+	for i, authorization := range list {
+		authorizations[i] = toProtoAuthorization(authorization)
+	}
+	return authorizations
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) ContractCall(ctx context.Context, req *grpcpb.ContractCallRequest) (*grpcpb.ContractCallResponse, error) {
+	args := make([]json.RawMessage, len(req.GetArgs()))
+	for i, arg := range req.GetArgs() {
+		if !json.Valid([]byte(arg)) {
+			return nil, status.Errorf(codes.InvalidArgument, "argument %d is not valid JSON", i)
+		}
+		args[i] = json.RawMessage(arg)
+	}
+
+	result, err := s.contracts.ContractCall(ctx, req.GetWalletId(), service.ContractCallRequest{
+		Contract: req.GetContract(),
+		ABI:      req.GetAbi(),
+		Method:   req.GetMethod(),
+		Args:     args,
+		Mode:     service.ContractCallMode(req.GetMode()),
+		ChainID:  req.GetChainId(),
+		Value:    req.GetValue(),
+		GasLimit: req.GetGasLimit(),
+		GasPrice: req.GetGasPrice(),
+		Nonce:    req.GetNonce(),
+		Force:    req.GetForce(),
+	})
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		return &grpcpb.ContractCallResponse{Approval: toProtoApproval(pending.Request)}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	outputs, err := toProtoOutputs(result.Outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcpb.ContractCallResponse{
+		Data:              result.Data,
+		SignedTransaction: result.SignedTransaction,
+		Outputs:           outputs,
+	}, nil
+}
+
+func toProtoOutputs(outputs []service.ContractOutput) ([]*grpcpb.ContractOutput, error) {
+	converted := make([]*grpcpb.ContractOutput, 0, len(outputs))
+	for _, output := range outputs {
+		value, err := json.Marshal(output.Value)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "encode output")
+		}
+		converted = append(converted, &grpcpb.ContractOutput{Name: output.Name, Type: output.Type, Value: string(value)})
+	}
+	return converted, nil
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) DecodeTransaction(ctx context.Context, req *grpcpb.DecodeTransactionRequest) (*grpcpb.DecodedTransaction, error) {
+	decoded, err := s.decoder.DecodeTransaction(ctx, req.GetRawTransaction(), req.GetAbi())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.DecodedTransaction{
+		Type:                 int32(decoded.Type),
+		Hash:                 decoded.Hash,
+		From:                 decoded.From,
+		To:                   decoded.To,
+		ChainId:              decoded.ChainID,
+		Nonce:                decoded.Nonce,
+		Value:                decoded.Value,
+		GasLimit:             decoded.GasLimit,
+		GasPrice:             decoded.GasPrice,
+		MaxFeePerGas:         decoded.MaxFeePerGas,
+		MaxPriorityFeePerGas: decoded.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     decoded.MaxFeePerBlobGas,
+		BlobVersionedHashes:  decoded.BlobVersionedHashes,
+		Data:                 decoded.Data,
+	}
+	if decoded.Call != nil {
+		if resp.Call, err = toProtoDecodedCall(*decoded.Call); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (s *Server) DecodeCalldata(ctx context.Context, req *grpcpb.DecodeCalldataRequest) (*grpcpb.DecodedCall, error) {
+	call, err := s.decoder.DecodeCalldata(ctx, req.GetData(), req.GetAbi())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoDecodedCall(*call)
+}
+
+func toProtoDecodedCall(call service.DecodedCall) (*grpcpb.DecodedCall, error) {
+	args, err := toProtoOutputs(call.Args)
+	if err != nil {
+		return nil, err
+	}
+	resp := &grpcpb.DecodedCall{
+		Selector:  call.Selector,
+		Signature: call.Signature,
+		Method:    call.Method,
+		Standards: call.Standards,
+		Args:      args,
+	}
+	for _, inner := range call.Calls {
+		converted, err := toProtoDecodedCall(inner)
+		if err != nil {
+			return nil, err
+		}
+		resp.Calls = append(resp.Calls, converted)
+	}
+	return resp, nil
+}
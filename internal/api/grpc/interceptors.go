@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// ActorInterceptor tags each call with the peer address so audit entries
+// identify unauthenticated callers.
+func ActorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			host := p.Addr.String()
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			ctx = service.ContextWithActor(ctx, "remote:"+host)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// methodScopes lists the scope each RPC requires. Methods missing from the
+// table are rejected so new RPCs cannot be exposed without a decision.
+var methodScopes = map[string]service.Scope{
+	grpcpb.WalletService_GetWallet_FullMethodName:                 service.ScopeWalletsRead,
+	grpcpb.WalletService_ListWallets_FullMethodName:               service.ScopeWalletsRead,
+	grpcpb.WalletService_GetBalance_FullMethodName:                service.ScopeWalletsRead,
+	grpcpb.WalletService_CreateWallet_FullMethodName:              service.ScopeWalletsCreate,
+	grpcpb.WalletService_SignMessage_FullMethodName:               service.ScopeSign,
+	grpcpb.WalletService_SignTransaction_FullMethodName:           service.ScopeSign,
+	grpcpb.WalletService_SimulateTransaction_FullMethodName:       service.ScopeSign,
+	grpcpb.WalletService_ContractCall_FullMethodName:              service.ScopeSign,
+	grpcpb.WalletService_TransferToken_FullMethodName:             service.ScopeSign,
+	grpcpb.WalletService_ApproveToken_FullMethodName:              service.ScopeSign,
+	grpcpb.WalletService_SignUserOperation_FullMethodName:         service.ScopeSign,
+	grpcpb.WalletService_SignSerializedTransaction_FullMethodName: service.ScopeSign,
+	grpcpb.WalletService_SignPSBT_FullMethodName:                  service.ScopeSign,
+	grpcpb.WalletService_SignAuthorization_FullMethodName:         service.ScopeSign,
+	grpcpb.WalletService_SignPermit_FullMethodName:                service.ScopeSign,
+	grpcpb.WalletService_ProposeSafeTransaction_FullMethodName:    service.ScopeSign,
+	grpcpb.WalletService_GetSafeProposal_FullMethodName:           service.ScopeWalletsRead,
+	grpcpb.WalletService_ListSafeProposals_FullMethodName:         service.ScopeWalletsRead,
+	grpcpb.WalletService_SignSafeProposal_FullMethodName:          service.ScopeSign,
+	grpcpb.WalletService_ExecuteSafeProposal_FullMethodName:       service.ScopeSign,
+	grpcpb.WalletService_DecodeTransaction_FullMethodName:         service.ScopeWalletsRead,
+	grpcpb.WalletService_DecodeCalldata_FullMethodName:            service.ScopeWalletsRead,
+	grpcpb.WalletService_IssueSIWENonce_FullMethodName:            service.ScopeWalletsRead,
+	grpcpb.WalletService_SignInWithEthereum_FullMethodName:        service.ScopeSign,
+	grpcpb.WalletService_VerifySignature_FullMethodName:           service.ScopeWalletsRead,
+	grpcpb.WalletService_VerifySIWE_FullMethodName:                service.ScopeWalletsRead,
+	grpcpb.WalletService_DisableWallet_FullMethodName:             service.ScopeAdmin,
+	grpcpb.WalletService_EnableWallet_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_ArchiveWallet_FullMethodName:             service.ScopeAdmin,
+	grpcpb.WalletService_DeleteWallet_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_ListAuditEntries_FullMethodName:          service.ScopeAdmin,
+	grpcpb.WalletService_VerifyAuditLog_FullMethodName:            service.ScopeAdmin,
+	grpcpb.WalletService_CreateAPIKey_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_ListAPIKeys_FullMethodName:               service.ScopeAdmin,
+	grpcpb.WalletService_RevokeAPIKey_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_CreateTenant_FullMethodName:              service.ScopePlatform,
+	grpcpb.WalletService_GetTenant_FullMethodName:                 service.ScopePlatform,
+	grpcpb.WalletService_ListTenants_FullMethodName:               service.ScopePlatform,
+	grpcpb.WalletService_UpdateTenant_FullMethodName:              service.ScopePlatform,
+	grpcpb.WalletService_ListNetworks_FullMethodName:              service.ScopeWalletsRead,
+	grpcpb.WalletService_AddNetwork_FullMethodName:                service.ScopePlatform,
+	grpcpb.WalletService_DisableNetwork_FullMethodName:            service.ScopePlatform,
+	grpcpb.WalletService_EnableNetwork_FullMethodName:             service.ScopePlatform,
+	grpcpb.WalletService_CreatePolicy_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_GetPolicy_FullMethodName:                 service.ScopeAdmin,
+	grpcpb.WalletService_ListPolicies_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_UpdatePolicy_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_DeletePolicy_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_ListPolicyVersions_FullMethodName:        service.ScopeAdmin,
+	grpcpb.WalletService_DryRunPolicy_FullMethodName:              service.ScopeAdmin,
+	grpcpb.WalletService_ListApprovals_FullMethodName:             service.ScopeWalletsRead,
+	grpcpb.WalletService_GetApproval_FullMethodName:               service.ScopeWalletsRead,
+	grpcpb.WalletService_ApproveRequest_FullMethodName:            service.ScopeSign,
+	grpcpb.WalletService_RejectRequest_FullMethodName:             service.ScopeSign,
+}
+
+// AuthInterceptor authenticates the bearer token in the "authorization"
+// metadata and enforces the scope required by the called method.
+func AuthInterceptor(auth *service.Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		scope, ok := methodScopes[info.FullMethod]
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "method %s is not exposed", info.FullMethod)
+		}
+
+		principal, err := auth.Authenticate(ctx, bearerToken(ctx))
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		ctx = service.ContextWithPrincipal(ctx, principal)
+
+		if err := auth.Authorize(ctx, scope); err != nil {
+			return nil, toStatusError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+			return strings.TrimSpace(value[7:])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/config"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) ListNetworks(ctx context.Context, _ *grpcpb.ListNetworksRequest) (*grpcpb.ListNetworksResponse, error) {
+	networks, err := s.networks.ListNetworks(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListNetworksResponse{Networks: make([]*grpcpb.NetworkInfo, 0, len(networks))}
+	for i := range networks {
+		resp.Networks = append(resp.Networks, toProtoNetwork(&networks[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) AddNetwork(ctx context.Context, req *grpcpb.AddNetworkRequest) (*grpcpb.NetworkInfo, error) {
+	network := config.ChainlistNetwork{
+		Network:   req.GetNetwork(),
+		Name:      req.GetName(),
+		ShortName: req.GetShortName(),
+		ChainID:   req.GetChainId(),
+		RPC:       req.GetRpc(),
+		NativeCurrency: config.NativeCurrency{
+			Name:     req.GetNativeCurrency().GetName(),
+			Symbol:   req.GetNativeCurrency().GetSymbol(),
+			Decimals: uint8(req.GetNativeCurrency().GetDecimals()),
+		},
+		Family:        req.GetFamily(),
+		CosmosChainID: req.GetCosmosChainId(),
+		Bech32Prefix:  req.GetBech32Prefix(),
+		BitcoinChain:  req.GetBitcoinChain(),
+		BundlerURL:    req.GetBundlerUrl(),
+		Disabled:      req.GetDisabled(),
+	}
+	for _, explorer := range req.GetExplorers() {
+		network.Explorers = append(network.Explorers, config.Explorer{
+			Name:     explorer.GetName(),
+			URL:      explorer.GetUrl(),
+			Standard: explorer.GetStandard(),
+		})
+	}
+	if len(req.GetTokens()) > 0 {
+		network.Tokens = make(map[string]config.TokenConfig, len(req.GetTokens()))
+		for _, token := range req.GetTokens() {
+			network.Tokens[token.GetSymbol()] = config.TokenConfig{
+				Address:  token.GetAddress(),
+				Decimals: uint8(token.GetDecimals()),
+			}
+		}
+	}
+
+	info, err := s.networks.AddNetwork(ctx, network)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoNetwork(info), nil
+}
+
+func (s *Server) DisableNetwork(ctx context.Context, req *grpcpb.NetworkRequest) (*grpcpb.NetworkInfo, error) {
+	info, err := s.networks.DisableNetwork(ctx, req.GetNetwork())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoNetwork(info), nil
+}
+
+func (s *Server) EnableNetwork(ctx context.Context, req *grpcpb.NetworkRequest) (*grpcpb.NetworkInfo, error) {
+	info, err := s.networks.EnableNetwork(ctx, req.GetNetwork())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoNetwork(info), nil
+}
+
+func toProtoNetwork(network *service.NetworkInfo) *grpcpb.NetworkInfo {
+	return &grpcpb.NetworkInfo{
+		Network:     network.Network,
+		Name:        network.Name,
+		Family:      string(network.Family),
+		ChainId:     network.ChainID,
+		NativeAsset: network.NativeAsset,
+		Decimals:    uint32(network.Decimals),
+		ExplorerUrl: network.ExplorerURL,
+		Disabled:    network.Disabled,
+	}
+}
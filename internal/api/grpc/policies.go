@@ -0,0 +1,201 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) CreatePolicy(ctx context.Context, req *grpcpb.Policy) (*grpcpb.Policy, error) {
+	policy, err := fromProtoPolicy(req)
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.policies.CreatePolicy(ctx, policy)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoPolicy(created), nil
+}
+
+func (s *Server) GetPolicy(ctx context.Context, req *grpcpb.GetPolicyRequest) (*grpcpb.Policy, error) {
+	policy, err := s.policies.GetPolicy(ctx, req.GetPolicyId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoPolicy(policy), nil
+}
+
+func (s *Server) ListPolicies(ctx context.Context, req *grpcpb.ListPoliciesRequest) (*grpcpb.ListPoliciesResponse, error) {
+	policies, err := s.policies.ListPolicies(ctx, req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListPoliciesResponse{Policies: make([]*grpcpb.Policy, 0, len(policies))}
+	for i := range policies {
+		resp.Policies = append(resp.Policies, toProtoPolicy(&policies[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdatePolicy(ctx context.Context, req *grpcpb.Policy) (*grpcpb.Policy, error) {
+	policy, err := fromProtoPolicy(req)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.policies.UpdatePolicy(ctx, policy)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoPolicy(updated), nil
+}
+
+func (s *Server) DeletePolicy(ctx context.Context, req *grpcpb.DeletePolicyRequest) (*grpcpb.DeletePolicyResponse, error) {
+	if err := s.policies.DeletePolicy(ctx, req.GetPolicyId()); err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.DeletePolicyResponse{}, nil
+}
+
+func (s *Server) ListPolicyVersions(ctx context.Context, req *grpcpb.GetPolicyRequest) (*grpcpb.ListPoliciesResponse, error) {
+	versions, err := s.policies.ListPolicyVersions(ctx, req.GetPolicyId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListPoliciesResponse{Policies: make([]*grpcpb.Policy, 0, len(versions))}
+	for i := range versions {
+		resp.Policies = append(resp.Policies, toProtoPolicy(&versions[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) DryRunPolicy(ctx context.Context, req *grpcpb.DryRunPolicyRequest) (*grpcpb.DryRunPolicyResponse, error) {
+	if req.GetTransaction() == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction is required")
+	}
+	tx, err := fromProtoTransaction(req.GetTransaction())
+	if err != nil {
+		return nil, err
+	}
+	dryRun := service.PolicyDryRunRequest{
+		PolicyID:    req.GetPolicyId(),
+		Version:     int(req.GetVersion()),
+		WalletID:    req.GetWalletId(),
+		Transaction: *tx,
+	}
+	if dryRun.PolicyID == "" {
+		if req.GetPolicy() == nil {
+			return nil, status.Error(codes.InvalidArgument, "policy is required")
+		}
+		candidate, err := fromProtoPolicy(req.GetPolicy())
+		if err != nil {
+			return nil, err
+		}
+		dryRun.Policy = &candidate
+	}
+
+	result, err := s.policies.DryRunPolicy(ctx, dryRun)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.DryRunPolicyResponse{
+		PolicyId:         result.PolicyID,
+		Version:          int32(result.Version),
+		Allowed:          result.Allowed,
+		Rule:             result.Rule,
+		Reason:           result.Reason,
+		RequiresApproval: result.RequiresApproval,
+	}, nil
+}
+
+func fromProtoPolicy(policy *grpcpb.Policy) (service.Policy, error) {
+	rules := policy.GetRules()
+	var window time.Duration
+	if raw := rules.GetWindow(); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return service.Policy{}, status.Error(codes.InvalidArgument, "invalid window duration")
+		}
+		window = parsed
+	}
+
+	var quorum *service.QuorumRule
+	if q := rules.GetQuorum(); q != nil {
+		var ttl time.Duration
+		if raw := q.GetTtl(); raw != "" {
+			parsed, err := time.ParseDuration(raw)
+			if err != nil {
+				return service.Policy{}, status.Error(codes.InvalidArgument, "invalid quorum ttl")
+			}
+			ttl = parsed
+		}
+		quorum = &service.QuorumRule{
+			Required:  int(q.GetRequired()),
+			Approvers: q.GetApprovers(),
+			TTL:       service.Duration(ttl),
+			Broadcast: q.GetBroadcast(),
+		}
+	}
+
+	return service.Policy{
+		ID:         policy.GetId(),
+		WalletID:   policy.GetWalletId(),
+		Name:       policy.GetName(),
+		Networks:   policy.GetNetworks(),
+		Expression: policy.GetExpression(),
+		Rules: service.PolicyRules{
+			MaxValue:            rules.GetMaxValue(),
+			WindowValue:         rules.GetWindowValue(),
+			Window:              service.Duration(window),
+			AllowedDestinations: rules.GetAllowedDestinations(),
+			DeniedDestinations:  rules.GetDeniedDestinations(),
+			AllowedMethods:      rules.GetAllowedMethods(),
+			Quorum:              quorum,
+			AllowDelegateCall:   rules.GetAllowDelegateCall(),
+		},
+	}, nil
+}
+
+func toProtoPolicy(policy *service.Policy) *grpcpb.Policy {
+	rules := &grpcpb.PolicyRules{
+		MaxValue:            policy.Rules.MaxValue,
+		WindowValue:         policy.Rules.WindowValue,
+		AllowedDestinations: policy.Rules.AllowedDestinations,
+		DeniedDestinations:  policy.Rules.DeniedDestinations,
+		AllowedMethods:      policy.Rules.AllowedMethods,
+		AllowDelegateCall:   policy.Rules.AllowDelegateCall,
+	}
+	if policy.Rules.Window != 0 {
+		rules.Window = time.Duration(policy.Rules.Window).String()
+	}
+	if q := policy.Rules.Quorum; q != nil {
+		rules.Quorum = &grpcpb.QuorumRule{
+			Required:  int32(q.Required),
+			Approvers: q.Approvers,
+			Broadcast: q.Broadcast,
+		}
+		if q.TTL != 0 {
+			rules.Quorum.Ttl = time.Duration(q.TTL).String()
+		}
+	}
+
+	return &grpcpb.Policy{
+		Id:            policy.ID,
+		TenantId:      policy.TenantID,
+		WalletId:      policy.WalletID,
+		Name:          policy.Name,
+		Networks:      policy.Networks,
+		Rules:         rules,
+		Expression:    policy.Expression,
+		Version:       int32(policy.Version),
+		CreatedAtUnix: unixOrZero(policy.CreatedAt),
+		UpdatedAtUnix: unixOrZero(policy.UpdatedAt),
+	}
+}
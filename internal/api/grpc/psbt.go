@@ -0,0 +1,27 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) SignPSBT(ctx context.Context, req *grpcpb.SignPSBTRequest) (*grpcpb.SignPSBTResponse, error) {
+	result, err := s.wallets.SignPSBT(ctx, req.GetWalletId(), service.PSBTRequest{PSBT: req.GetPsbt()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	signedInputs := make([]int32, len(result.SignedInputs))
+	for i, input := range result.SignedInputs {
+		signedInputs[i] = int32(input)
+	}
+	return &grpcpb.SignPSBTResponse{
+		Psbt:         result.PSBT,
+		Txid:         result.TxID,
+		SignedInputs: signedInputs,
+		Complete:     result.Complete,
+		Transaction:  result.Transaction,
+	}, nil
+}
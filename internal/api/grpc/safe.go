@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) ProposeSafeTransaction(ctx context.Context, req *grpcpb.ProposeSafeTransactionRequest) (*grpcpb.SafeProposal, error) {
+	tx := req.GetTransaction()
+	if tx.GetOperation() > uint32(service.SafeOperationDelegateCall) {
+		return nil, status.Error(codes.InvalidArgument, "operation must be 0 (call) or 1 (delegatecall)")
+	}
+	proposal, err := s.wallets.ProposeSafeTransaction(ctx, service.SafeProposalRequest{
+		Network: req.GetNetwork(),
+		Safe:    req.GetSafe(),
+		Transaction: service.SafeTransaction{
+			To:             tx.GetTo(),
+			Value:          tx.GetValue(),
+			Data:           tx.GetData(),
+			Operation:      service.SafeOperation(tx.GetOperation()),
+			SafeTxGas:      tx.GetSafeTxGas(),
+			BaseGas:        tx.GetBaseGas(),
+			GasPrice:       tx.GetGasPrice(),
+			GasToken:       tx.GetGasToken(),
+			RefundReceiver: tx.GetRefundReceiver(),
+			Nonce:          tx.GetNonce(),
+		},
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoSafeProposal(proposal), nil
+}
+
+func (s *Server) GetSafeProposal(ctx context.Context, req *grpcpb.GetSafeProposalRequest) (*grpcpb.SafeProposal, error) {
+	proposal, err := s.wallets.GetSafeProposal(ctx, req.GetProposalId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoSafeProposal(proposal), nil
+}
+
+func (s *Server) ListSafeProposals(ctx context.Context, req *grpcpb.ListSafeProposalsRequest) (*grpcpb.ListSafeProposalsResponse, error) {
+	proposals, err := s.wallets.ListSafeProposals(ctx, service.SafeProposalStatus(req.GetStatus()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListSafeProposalsResponse{Proposals: make([]*grpcpb.SafeProposal, 0, len(proposals))}
+	for i := range proposals {
+		resp.Proposals = append(resp.Proposals, toProtoSafeProposal(&proposals[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) SignSafeProposal(ctx context.Context, req *grpcpb.SignSafeProposalRequest) (*grpcpb.SafeProposal, error) {
+	proposal, err := s.wallets.SignSafeProposal(ctx, req.GetProposalId(), req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoSafeProposal(proposal), nil
+}
+
+func (s *Server) ExecuteSafeProposal(ctx context.Context, req *grpcpb.ExecuteSafeProposalRequest) (*grpcpb.ExecuteSafeProposalResponse, error) {
+	proposal, err := s.wallets.ExecuteSafeProposal(ctx, req.GetProposalId(), req.GetWalletId(), service.SafeExecuteRequest{
+		TokenTxOptions: fromProtoTokenOptions(req.GetOptions()),
+	})
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		return &grpcpb.ExecuteSafeProposalResponse{Approval: toProtoApproval(pending.Request)}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.ExecuteSafeProposalResponse{Proposal: toProtoSafeProposal(proposal)}, nil
+}
+
+func toProtoSafeProposal(proposal *service.SafeProposal) *grpcpb.SafeProposal {
+	typedData, _ := json.Marshal(proposal.TypedData)
+	tx := proposal.Transaction
+
+	signatures := make([]*grpcpb.SafeSignature, 0, len(proposal.Signatures))
+	for _, signature := range proposal.Signatures {
+		signatures = append(signatures, &grpcpb.SafeSignature{
+			Owner:        signature.Owner,
+			WalletId:     signature.WalletID,
+			Signature:    signature.Signature,
+			SignedAtUnix: unixOrZero(signature.SignedAt),
+		})
+	}
+
+	return &grpcpb.SafeProposal{
+		Id:        proposal.ID,
+		TenantId:  proposal.TenantID,
+		Network:   proposal.Network,
+		ChainId:   proposal.ChainID,
+		Safe:      proposal.Safe,
+		Version:   proposal.Version,
+		Threshold: int32(proposal.Threshold),
+		Owners:    proposal.Owners,
+		Transaction: &grpcpb.SafeTransaction{
+			To:             tx.To,
+			Value:          tx.Value,
+			Data:           tx.Data,
+			Operation:      uint32(tx.Operation),
+			SafeTxGas:      tx.SafeTxGas,
+			BaseGas:        tx.BaseGas,
+			GasPrice:       tx.GasPrice,
+			GasToken:       tx.GasToken,
+			RefundReceiver: tx.RefundReceiver,
+			Nonce:          tx.Nonce,
+		},
+		SafeTxHash:        proposal.SafeTxHash,
+		TypedData:         string(typedData),
+		Signatures:        signatures,
+		Status:            string(proposal.Status),
+		ExecutionData:     proposal.ExecutionData,
+		SignedTransaction: proposal.SignedTransaction,
+		TxHash:            proposal.TxHash,
+		BroadcastError:    proposal.BroadcastError,
+		CreatedAtUnix:     unixOrZero(proposal.CreatedAt),
+		UpdatedAtUnix:     unixOrZero(proposal.UpdatedAt),
+	}
+}
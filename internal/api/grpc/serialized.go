@@ -0,0 +1,22 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) SignSerializedTransaction(ctx context.Context, req *grpcpb.SignSerializedTransactionRequest) (*grpcpb.SignSerializedTransactionResponse, error) {
+	result, err := s.wallets.SignSerializedTransaction(ctx, req.GetWalletId(), service.SerializedTransactionRequest{
+		Transaction: req.GetTransaction(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.SignSerializedTransactionResponse{
+		Transaction: result.Transaction,
+		Signature:   result.Signature,
+		Txid:        result.TxID,
+	}, nil
+}
@@ -2,25 +2,79 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
 	"github.com/rickyreddygari/walletsdk/internal/service"
 )
 
+// Services groups the application services exposed over gRPC.
+type Services struct {
+	Wallets    service.WalletService
+	Balances   service.BalanceService
+	Audits     service.AuditService
+	APIKeys    service.APIKeyService
+	Tenants    service.TenantService
+	Policies   service.PolicyService
+	Contracts  service.ContractService
+	Decoder    service.DecoderService
+	SIWE       service.SIWEService
+	Signatures service.SignatureService
+	Networks   service.NetworkService
+}
+
 type Server struct {
 	grpcpb.UnimplementedWalletServiceServer
-	wallets  service.WalletService
-	balances service.BalanceService
+	wallets    service.WalletService
+	balances   service.BalanceService
+	audits     service.AuditService
+	apiKeys    service.APIKeyService
+	tenants    service.TenantService
+	policies   service.PolicyService
+	contracts  service.ContractService
+	decoder    service.DecoderService
+	siwe       service.SIWEService
+	signatures service.SignatureService
+	networks   service.NetworkService
 }
 
-func NewServer(wallets service.WalletService, balances service.BalanceService) *Server {
-	return &Server{wallets: wallets, balances: balances}
+func NewServer(svc Services) *Server {
+	return &Server{
+		wallets:    svc.Wallets,
+		balances:   svc.Balances,
+		audits:     svc.Audits,
+		apiKeys:    svc.APIKeys,
+		tenants:    svc.Tenants,
+		policies:   svc.Policies,
+		contracts:  svc.Contracts,
+		decoder:    svc.Decoder,
+		siwe:       svc.SIWE,
+		signatures: svc.Signatures,
+		networks:   svc.Networks,
+	}
 }
 
 func (s *Server) CreateWallet(ctx context.Context, req *grpcpb.CreateWalletRequest) (*grpcpb.WalletResponse, error) {
-	wallet, err := s.wallets.CreateWallet(ctx, req.GetNetwork())
+	var opts []service.CreateWalletOption
+	if req.GetAccountType() != "" {
+		opts = append(opts, service.WithAccountType(service.AccountType(req.GetAccountType())))
+	}
+	if req.GetFactory() != "" {
+		opts = append(opts, service.WithAccountFactory(req.GetFactory()))
+	}
+	if req.GetSalt() != "" {
+		opts = append(opts, service.WithAccountSalt(req.GetSalt()))
+	}
+	if req.GetAddressType() != "" {
+		opts = append(opts, service.WithAddressType(service.AddressType(req.GetAddressType())))
+	}
+	wallet, err := s.wallets.CreateWallet(ctx, req.GetNetwork(), opts...)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	return toProtoWallet(wallet), nil
 }
@@ -28,7 +82,7 @@ func (s *Server) CreateWallet(ctx context.Context, req *grpcpb.CreateWalletReque
 func (s *Server) GetWallet(ctx context.Context, req *grpcpb.GetWalletRequest) (*grpcpb.WalletResponse, error) {
 	wallet, err := s.wallets.GetWallet(ctx, req.GetWalletId())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	return toProtoWallet(wallet), nil
 }
@@ -36,7 +90,7 @@ func (s *Server) GetWallet(ctx context.Context, req *grpcpb.GetWalletRequest) (*
 func (s *Server) ListWallets(ctx context.Context, req *grpcpb.ListWalletsRequest) (*grpcpb.ListWalletsResponse, error) {
 	wallets, err := s.wallets.ListWallets(ctx, req.GetNetwork())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	resp := &grpcpb.ListWalletsResponse{Wallets: make([]*grpcpb.WalletResponse, 0, len(wallets))}
@@ -50,54 +104,214 @@ func (s *Server) ListWallets(ctx context.Context, req *grpcpb.ListWalletsRequest
 func (s *Server) SignMessage(ctx context.Context, req *grpcpb.SignMessageRequest) (*grpcpb.SignMessageResponse, error) {
 	sig, err := s.wallets.SignMessage(ctx, req.GetWalletId(), req.GetPayload())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	return &grpcpb.SignMessageResponse{Signature: sig.Signature, PublicKey: sig.PublicKey}, nil
 }
 
 func (s *Server) SignTransaction(ctx context.Context, req *grpcpb.SignTransactionRequest) (*grpcpb.SignTransactionResponse, error) {
-	tx := fromProtoTransaction(req.GetTransaction())
-	signed, err := s.wallets.SignTransaction(ctx, req.GetWalletId(), tx)
+	tx, err := fromProtoTransaction(req.GetTransaction())
 	if err != nil {
 		return nil, err
 	}
+	var opts []service.SignOption
+	if req.GetForce() {
+		opts = append(opts, service.ForceSign())
+	}
+	signed, err := s.wallets.SignTransaction(ctx, req.GetWalletId(), tx, opts...)
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		return &grpcpb.SignTransactionResponse{Approval: toProtoApproval(pending.Request)}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
 	return &grpcpb.SignTransactionResponse{SignedTransaction: signed}, nil
 }
 
 func (s *Server) GetBalance(ctx context.Context, req *grpcpb.GetBalanceRequest) (*grpcpb.GetBalanceResponse, error) {
-	balance, err := s.balances.GetBalance(ctx, req.GetWalletId())
+	var opts []service.BalanceOption
+	if req.GetToken() != "" {
+		opts = append(opts, service.WithBalanceToken(req.GetToken()))
+	}
+	balance, err := s.balances.GetBalance(ctx, req.GetWalletId(), opts...)
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	return &grpcpb.GetBalanceResponse{Balance: &grpcpb.Balance{Asset: balance.Asset, Amount: balance.Amount}}, nil
 }
 
-func toProtoWallet(wallet *service.Wallet) *grpcpb.WalletResponse {
-	var createdAt int64
-	if !wallet.CreatedAt.IsZero() {
-		createdAt = wallet.CreatedAt.Unix()
+func (s *Server) DisableWallet(ctx context.Context, req *grpcpb.DisableWalletRequest) (*grpcpb.WalletResponse, error) {
+	wallet, err := s.wallets.DisableWallet(ctx, req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
 	}
-	return &grpcpb.WalletResponse{
+	return toProtoWallet(wallet), nil
+}
+
+func (s *Server) EnableWallet(ctx context.Context, req *grpcpb.EnableWalletRequest) (*grpcpb.WalletResponse, error) {
+	wallet, err := s.wallets.EnableWallet(ctx, req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoWallet(wallet), nil
+}
+
+func (s *Server) ArchiveWallet(ctx context.Context, req *grpcpb.ArchiveWalletRequest) (*grpcpb.WalletResponse, error) {
+	wallet, err := s.wallets.ArchiveWallet(ctx, req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoWallet(wallet), nil
+}
+
+func (s *Server) DeleteWallet(ctx context.Context, req *grpcpb.DeleteWalletRequest) (*grpcpb.WalletResponse, error) {
+	wallet, err := s.wallets.DeleteWallet(ctx, req.GetWalletId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoWallet(wallet), nil
+}
+
+func (s *Server) ListAuditEntries(ctx context.Context, req *grpcpb.ListAuditEntriesRequest) (*grpcpb.ListAuditEntriesResponse, error) {
+	filter := service.AuditFilter{
+		WalletID:  req.GetWalletId(),
+		Actor:     req.GetActor(),
+		Operation: req.GetOperation(),
+		Limit:     int(req.GetLimit()),
+	}
+	if req.GetSinceUnix() > 0 {
+		filter.Since = time.Unix(req.GetSinceUnix(), 0)
+	}
+	if req.GetUntilUnix() > 0 {
+		filter.Until = time.Unix(req.GetUntilUnix(), 0)
+	}
+
+	entries, err := s.audits.ListEntries(ctx, filter)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListAuditEntriesResponse{Entries: make([]*grpcpb.AuditEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &grpcpb.AuditEntry{
+			Sequence:          entry.Sequence,
+			TimestampUnixNano: entry.Timestamp.UnixNano(),
+			Actor:             entry.Actor,
+			TenantId:          entry.TenantID,
+			WalletId:          entry.WalletID,
+			Operation:         entry.Operation,
+			PayloadHash:       entry.PayloadHash,
+			Result:            entry.Result,
+			Delegate:          entry.Delegate,
+			Outcome:           entry.Outcome,
+			Error:             entry.Error,
+			PrevHash:          entry.PrevHash,
+			Hash:              entry.Hash,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Server) VerifyAuditLog(ctx context.Context, _ *grpcpb.VerifyAuditLogRequest) (*grpcpb.VerifyAuditLogResponse, error) {
+	result, err := s.audits.Verify(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.VerifyAuditLogResponse{
+		Valid:        result.Valid,
+		Entries:      int64(result.Entries),
+		HeadHash:     result.HeadHash,
+		FirstInvalid: result.FirstInvalid,
+		Reason:       result.Reason,
+	}, nil
+}
+
+func toProtoWallet(wallet *service.Wallet) *grpcpb.WalletResponse {
+	resp := &grpcpb.WalletResponse{
 		Id:            wallet.ID,
+		TenantId:      wallet.TenantID,
 		Network:       wallet.Network,
 		Address:       wallet.Address,
 		PublicKey:     wallet.PublicKey,
-		CreatedAtUnix: createdAt,
+		CreatedAtUnix: unixOrZero(wallet.CreatedAt),
+		Status:        string(wallet.Status),
+		UpdatedAtUnix: unixOrZero(wallet.UpdatedAt),
+		AccountType:   string(wallet.AccountType),
+		Family:        string(wallet.Family),
+		AddressType:   string(wallet.AddressType),
+	}
+	if account := wallet.SmartAccount; account != nil {
+		resp.SmartAccount = &grpcpb.SmartAccount{
+			Owner:      account.Owner,
+			Factory:    account.Factory,
+			Salt:       account.Salt,
+			EntryPoint: string(account.EntryPoint),
+			Deployed:   account.Deployed,
+		}
+	}
+	if wallet.DeleteAfter != nil {
+		resp.DeleteAfterUnix = wallet.DeleteAfter.Unix()
+	}
+	if wallet.KeyDestroyedAt != nil {
+		resp.KeyDestroyedAtUnix = wallet.KeyDestroyedAt.Unix()
+	}
+	return resp
+}
+
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// toStatusError maps service errors onto gRPC status codes.
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, "resource not found")
+	case errors.Is(err, service.ErrValidation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrNotImplemented):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, service.ErrWalletInactive), errors.Is(err, service.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrPolicyViolation):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrSimulationReverted):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
 	}
 }
 
-func fromProtoTransaction(tx *grpcpb.Transaction) *service.Transaction {
+func fromProtoTransaction(tx *grpcpb.Transaction) (*service.Transaction, error) {
 	if tx == nil {
-		return nil
+		return nil, nil
 	}
-	return &service.Transaction{
-		ChainID:  tx.GetChainId(),
-		From:     tx.GetFrom(),
-		To:       tx.GetTo(),
-		Value:    tx.GetValue(),
-		Data:     tx.GetData(),
-		GasLimit: tx.GetGasLimit(),
-		GasPrice: tx.GetGasPrice(),
-		Nonce:    tx.GetNonce(),
+	authorizations, err := fromProtoAuthorizations(tx.GetAuthorizationList())
+	if err != nil {
+		return nil, err
 	}
+	return &service.Transaction{
+		ChainID:              tx.GetChainId(),
+		From:                 tx.GetFrom(),
+		To:                   tx.GetTo(),
+		Value:                tx.GetValue(),
+		Data:                 tx.GetData(),
+		GasLimit:             tx.GetGasLimit(),
+		GasPrice:             tx.GetGasPrice(),
+		MaxPriorityFeePerGas: tx.GetMaxPriorityFeePerGas(),
+		Nonce:                tx.GetNonce(),
+		AuthorizationList:    authorizations,
+		MaxFeePerBlobGas:     tx.GetMaxFeePerBlobGas(),
+		Blobs:                tx.GetBlobs(),
+	}, nil
 }
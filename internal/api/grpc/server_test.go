@@ -46,4 +46,3 @@ func TestGRPCWalletLifecycle(t *testing.T) {
 		t.Fatalf("expected balance")
 	}
 }
-
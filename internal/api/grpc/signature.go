@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) VerifySignature(ctx context.Context, req *grpcpb.VerifySignatureRequest) (*grpcpb.VerifySignatureResponse, error) {
+	verify := service.SignatureVerifyRequest{
+		Network:   req.GetNetwork(),
+		Address:   req.GetAddress(),
+		Message:   req.GetMessage(),
+		Hash:      req.GetHash(),
+		Signature: req.GetSignature(),
+	}
+	if raw := req.GetTypedData(); raw != "" {
+		verify.TypedData = &service.TypedData{}
+		if err := json.Unmarshal([]byte(raw), verify.TypedData); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid typed data")
+		}
+	}
+
+	result, err := s.signatures.VerifySignature(ctx, verify)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.VerifySignatureResponse{
+		Valid:   result.Valid,
+		Method:  string(result.Method),
+		Address: result.Address,
+		Reason:  result.Reason,
+	}, nil
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+)
+
+func (s *Server) SimulateTransaction(ctx context.Context, req *grpcpb.SimulateTransactionRequest) (*grpcpb.SimulationResult, error) {
+	if req.GetTransaction() == nil {
+		return nil, status.Error(codes.InvalidArgument, "transaction is required")
+	}
+	tx, err := fromProtoTransaction(req.GetTransaction())
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.wallets.SimulateTransaction(ctx, req.GetWalletId(), tx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.SimulationResult{
+		Success:      result.Success,
+		RevertReason: result.RevertReason,
+		RevertData:   result.RevertData,
+		ReturnData:   result.ReturnData,
+		GasEstimate:  result.GasEstimate,
+		Trace:        string(result.Trace),
+	}, nil
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) IssueSIWENonce(ctx context.Context, _ *grpcpb.IssueSIWENonceRequest) (*grpcpb.SIWENonce, error) {
+	nonce, err := s.siwe.IssueNonce(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.SIWENonce{Nonce: nonce.Nonce, ExpiresAtUnix: nonce.ExpiresAt.Unix()}, nil
+}
+
+func (s *Server) SignInWithEthereum(ctx context.Context, req *grpcpb.SignInWithEthereumRequest) (*grpcpb.SignInWithEthereumResponse, error) {
+	signIn := service.SIWESignRequest{
+		Domain:    req.GetDomain(),
+		URI:       req.GetUri(),
+		Statement: req.GetStatement(),
+		ChainID:   req.GetChainId(),
+		Nonce:     req.GetNonce(),
+		RequestID: req.GetRequestId(),
+		Resources: req.GetResources(),
+	}
+	if raw := req.GetExpiresIn(); raw != "" {
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expires_in %q", raw)
+		}
+		signIn.ExpiresIn = service.Duration(parsed)
+	}
+	if unix := req.GetNotBeforeUnix(); unix != 0 {
+		notBefore := time.Unix(unix, 0).UTC()
+		signIn.NotBefore = &notBefore
+	}
+
+	result, err := s.siwe.SignIn(ctx, req.GetWalletId(), signIn)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.SignInWithEthereumResponse{
+		Message:   result.Message,
+		Signature: result.Signature,
+		Fields:    toProtoSIWEMessage(result.Fields),
+	}, nil
+}
+
+func (s *Server) VerifySIWE(ctx context.Context, req *grpcpb.VerifySIWERequest) (*grpcpb.VerifySIWEResponse, error) {
+	result, err := s.siwe.Verify(ctx, service.SIWEVerifyRequest{
+		Message:   req.GetMessage(),
+		Signature: req.GetSignature(),
+		Domain:    req.GetDomain(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.VerifySIWEResponse{
+		Valid:   result.Valid,
+		Address: result.Address,
+		Reason:  result.Reason,
+		Fields:  toProtoSIWEMessage(result.Fields),
+	}, nil
+}
+
+func toProtoSIWEMessage(msg *service.SIWEMessage) *grpcpb.SIWEMessage {
+	if msg == nil {
+		return nil
+	}
+	out := &grpcpb.SIWEMessage{
+		Domain:       msg.Domain,
+		Address:      msg.Address,
+		Statement:    msg.Statement,
+		Uri:          msg.URI,
+		Version:      msg.Version,
+		ChainId:      msg.ChainID,
+		Nonce:        msg.Nonce,
+		IssuedAtUnix: msg.IssuedAt.Unix(),
+		RequestId:    msg.RequestID,
+		Resources:    msg.Resources,
+	}
+	if msg.ExpirationTime != nil {
+		out.ExpirationTimeUnix = msg.ExpirationTime.Unix()
+	}
+	if msg.NotBefore != nil {
+		out.NotBeforeUnix = msg.NotBefore.Unix()
+	}
+	return out
+}
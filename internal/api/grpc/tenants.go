@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) CreateTenant(ctx context.Context, req *grpcpb.Tenant) (*grpcpb.Tenant, error) {
+	tenant, err := s.tenants.CreateTenant(ctx, fromProtoTenant(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoTenant(tenant), nil
+}
+
+func (s *Server) GetTenant(ctx context.Context, req *grpcpb.GetTenantRequest) (*grpcpb.Tenant, error) {
+	tenant, err := s.tenants.GetTenant(ctx, req.GetTenantId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoTenant(tenant), nil
+}
+
+func (s *Server) ListTenants(ctx context.Context, _ *grpcpb.ListTenantsRequest) (*grpcpb.ListTenantsResponse, error) {
+	tenants, err := s.tenants.ListTenants(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &grpcpb.ListTenantsResponse{Tenants: make([]*grpcpb.Tenant, 0, len(tenants))}
+	for i := range tenants {
+		resp.Tenants = append(resp.Tenants, toProtoTenant(&tenants[i]))
+	}
+	return resp, nil
+}
+
+func (s *Server) UpdateTenant(ctx context.Context, req *grpcpb.Tenant) (*grpcpb.Tenant, error) {
+	tenant, err := s.tenants.UpdateTenant(ctx, fromProtoTenant(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoTenant(tenant), nil
+}
+
+func fromProtoTenant(tenant *grpcpb.Tenant) service.Tenant {
+	return service.Tenant{
+		ID:              tenant.GetId(),
+		Name:            tenant.GetName(),
+		AllowedNetworks: tenant.GetAllowedNetworks(),
+		MaxWallets:      int(tenant.GetMaxWallets()),
+	}
+}
+
+func toProtoTenant(tenant *service.Tenant) *grpcpb.Tenant {
+	return &grpcpb.Tenant{
+		Id:              tenant.ID,
+		Name:            tenant.Name,
+		AllowedNetworks: tenant.AllowedNetworks,
+		MaxWallets:      int32(tenant.MaxWallets),
+		CreatedAtUnix:   unixOrZero(tenant.CreatedAt),
+		UpdatedAtUnix:   unixOrZero(tenant.UpdatedAt),
+	}
+}
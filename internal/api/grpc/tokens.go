@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) TransferToken(ctx context.Context, req *grpcpb.TransferTokenRequest) (*grpcpb.TokenTxResponse, error) {
+	result, err := s.wallets.TransferToken(ctx, req.GetWalletId(), service.TokenTransferRequest{
+		Token:          req.GetToken(),
+		To:             req.GetTo(),
+		Amount:         req.GetAmount(),
+		TokenTxOptions: fromProtoTokenOptions(req.GetOptions()),
+	})
+	return toTokenTxResponse(result, err)
+}
+
+func (s *Server) ApproveToken(ctx context.Context, req *grpcpb.ApproveTokenRequest) (*grpcpb.TokenTxResponse, error) {
+	result, err := s.wallets.ApproveToken(ctx, req.GetWalletId(), service.TokenApprovalRequest{
+		Token:          req.GetToken(),
+		Spender:        req.GetSpender(),
+		Amount:         req.GetAmount(),
+		TokenTxOptions: fromProtoTokenOptions(req.GetOptions()),
+	})
+	return toTokenTxResponse(result, err)
+}
+
+func fromProtoTokenOptions(opts *grpcpb.TokenTxOptions) service.TokenTxOptions {
+	return service.TokenTxOptions{
+		GasLimit:  opts.GetGasLimit(),
+		GasPrice:  opts.GetGasPrice(),
+		Nonce:     opts.GetNonce(),
+		Broadcast: opts.GetBroadcast(),
+		Force:     opts.GetForce(),
+	}
+}
+
+func toTokenTxResponse(result *service.TokenTxResult, err error) (*grpcpb.TokenTxResponse, error) {
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		return &grpcpb.TokenTxResponse{Approval: toProtoApproval(pending.Request)}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &grpcpb.TokenTxResponse{
+		Token:             toProtoToken(result.Token),
+		Amount:            result.Amount,
+		Transaction:       toProtoTransaction(result.Transaction),
+		SignedTransaction: result.SignedTransaction,
+		TxHash:            result.TxHash,
+		BroadcastError:    result.BroadcastError,
+	}, nil
+}
+
+func (s *Server) SignPermit(ctx context.Context, req *grpcpb.SignPermitRequest) (*grpcpb.SignPermitResponse, error) {
+	result, err := s.wallets.SignPermit(ctx, req.GetWalletId(), service.PermitRequest{
+		Kind:       service.PermitKind(req.GetKind()),
+		Token:      req.GetToken(),
+		Spender:    req.GetSpender(),
+		Value:      req.GetValue(),
+		Deadline:   req.GetDeadline(),
+		Expiration: req.GetExpiration(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	typedData, err := json.Marshal(result.TypedData)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "encode typed data")
+	}
+	return &grpcpb.SignPermitResponse{
+		Token:     toProtoToken(result.Token),
+		Value:     result.Value,
+		Deadline:  result.Deadline,
+		Signature: result.Signature,
+		V:         uint32(result.V),
+		R:         result.R,
+		S:         result.S,
+		TypedData: string(typedData),
+	}, nil
+}
+
+func toProtoToken(token service.Token) *grpcpb.Token {
+	return &grpcpb.Token{
+		Symbol:   token.Symbol,
+		Address:  token.Address,
+		Decimals: uint32(token.Decimals),
+	}
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Server) SignUserOperation(ctx context.Context, req *grpcpb.SignUserOperationRequest) (*grpcpb.SignUserOperationResponse, error) {
+	result, err := s.wallets.SignUserOperation(ctx, req.GetWalletId(), service.UserOperationRequest{
+		Version:       service.EntryPointVersion(req.GetVersion()),
+		EntryPoint:    req.GetEntryPoint(),
+		UserOperation: fromProtoUserOperation(req.GetUserOperation()),
+		Submit:        req.GetSubmit(),
+	})
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		return &grpcpb.SignUserOperationResponse{Approval: toProtoApproval(pending.Request)}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return toProtoUserOperationResult(result), nil
+}
+
+func toProtoUserOperationResult(result *service.UserOperationResult) *grpcpb.SignUserOperationResponse {
+	if result == nil {
+		return nil
+	}
+	return &grpcpb.SignUserOperationResponse{
+		Version:       string(result.EntryPoint.Version),
+		EntryPoint:    result.EntryPoint.Address,
+		ChainId:       result.ChainID,
+		UserOpHash:    result.UserOpHash,
+		UserOperation: toProtoUserOperation(result.UserOperation),
+		Submitted:     result.Submitted,
+		SubmitError:   result.SubmitError,
+	}
+}
+
+func toProtoUserOperationRequest(walletID string, req *service.UserOperationRequest) *grpcpb.SignUserOperationRequest {
+	if req == nil {
+		return nil
+	}
+	return &grpcpb.SignUserOperationRequest{
+		WalletId:      walletID,
+		Version:       string(req.Version),
+		EntryPoint:    req.EntryPoint,
+		UserOperation: toProtoUserOperation(&req.UserOperation),
+		Submit:        req.Submit,
+	}
+}
+
+func fromProtoUserOperation(op *grpcpb.UserOperation) service.UserOperation {
+	return service.UserOperation{
+		Sender:                        op.GetSender(),
+		Nonce:                         op.GetNonce(),
+		InitCode:                      op.GetInitCode(),
+		Factory:                       op.GetFactory(),
+		FactoryData:                   op.GetFactoryData(),
+		CallData:                      op.GetCallData(),
+		CallGasLimit:                  op.GetCallGasLimit(),
+		VerificationGasLimit:          op.GetVerificationGasLimit(),
+		PreVerificationGas:            op.GetPreVerificationGas(),
+		MaxFeePerGas:                  op.GetMaxFeePerGas(),
+		MaxPriorityFeePerGas:          op.GetMaxPriorityFeePerGas(),
+		PaymasterAndData:              op.GetPaymasterAndData(),
+		Paymaster:                     op.GetPaymaster(),
+		PaymasterVerificationGasLimit: op.GetPaymasterVerificationGasLimit(),
+		PaymasterPostOpGasLimit:       op.GetPaymasterPostOpGasLimit(),
+		PaymasterData:                 op.GetPaymasterData(),
+		Signature:                     op.GetSignature(),
+	}
+}
+
+func toProtoUserOperation(op *service.UserOperation) *grpcpb.UserOperation {
+	if op == nil {
+		return nil
+	}
+	return &grpcpb.UserOperation{
+		Sender:                        op.Sender,
+		Nonce:                         op.Nonce,
+		InitCode:                      op.InitCode,
+		Factory:                       op.Factory,
+		FactoryData:                   op.FactoryData,
+		CallData:                      op.CallData,
+		CallGasLimit:                  op.CallGasLimit,
+		VerificationGasLimit:          op.VerificationGasLimit,
+		PreVerificationGas:            op.PreVerificationGas,
+		MaxFeePerGas:                  op.MaxFeePerGas,
+		MaxPriorityFeePerGas:          op.MaxPriorityFeePerGas,
+		PaymasterAndData:              op.PaymasterAndData,
+		Paymaster:                     op.Paymaster,
+		PaymasterVerificationGasLimit: op.PaymasterVerificationGasLimit,
+		PaymasterPostOpGasLimit:       op.PaymasterPostOpGasLimit,
+		PaymasterData:                 op.PaymasterData,
+		Signature:                     op.Signature,
+	}
+}
@@ -22,8 +22,15 @@ const (
 )
 
 type CreateWalletRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Network string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	// account_type is "eoa" (the default) or "smart".
+	AccountType string `protobuf:"bytes,2,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Factory     string `protobuf:"bytes,3,opt,name=factory,proto3" json:"factory,omitempty"`
+	// salt is a decimal or 0x-prefixed hex uint256.
+	Salt string `protobuf:"bytes,4,opt,name=salt,proto3" json:"salt,omitempty"`
+	// address_type is "p2wpkh" (the default) or "p2tr" on bitcoin networks.
+	AddressType   string `protobuf:"bytes,5,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,20 +72,133 @@ func (x *CreateWalletRequest) GetNetwork() string {
 	return ""
 }
 
-type WalletResponse struct {
+func (x *CreateWalletRequest) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *CreateWalletRequest) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *CreateWalletRequest) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *CreateWalletRequest) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+type SmartAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Network       string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	CreatedAtUnix int64                  `protobuf:"varint,5,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Factory       string                 `protobuf:"bytes,2,opt,name=factory,proto3" json:"factory,omitempty"`
+	Salt          string                 `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	EntryPoint    string                 `protobuf:"bytes,4,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	Deployed      bool                   `protobuf:"varint,5,opt,name=deployed,proto3" json:"deployed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *SmartAccount) Reset() {
+	*x = SmartAccount{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SmartAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmartAccount) ProtoMessage() {}
+
+func (x *SmartAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmartAccount.ProtoReflect.Descriptor instead.
+func (*SmartAccount) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SmartAccount) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SmartAccount) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *SmartAccount) GetSalt() string {
+	if x != nil {
+		return x.Salt
+	}
+	return ""
+}
+
+func (x *SmartAccount) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *SmartAccount) GetDeployed() bool {
+	if x != nil {
+		return x.Deployed
+	}
+	return false
+}
+
+type WalletResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Network            string                 `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	Address            string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	PublicKey          string                 `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAtUnix      int64                  `protobuf:"varint,5,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	UpdatedAtUnix      int64                  `protobuf:"varint,7,opt,name=updated_at_unix,json=updatedAtUnix,proto3" json:"updated_at_unix,omitempty"`
+	DeleteAfterUnix    int64                  `protobuf:"varint,8,opt,name=delete_after_unix,json=deleteAfterUnix,proto3" json:"delete_after_unix,omitempty"`
+	KeyDestroyedAtUnix int64                  `protobuf:"varint,9,opt,name=key_destroyed_at_unix,json=keyDestroyedAtUnix,proto3" json:"key_destroyed_at_unix,omitempty"`
+	TenantId           string                 `protobuf:"bytes,10,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	AccountType        string                 `protobuf:"bytes,11,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	SmartAccount       *SmartAccount          `protobuf:"bytes,12,opt,name=smart_account,json=smartAccount,proto3" json:"smart_account,omitempty"`
+	Family             string                 `protobuf:"bytes,13,opt,name=family,proto3" json:"family,omitempty"`
+	AddressType        string                 `protobuf:"bytes,14,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
 func (x *WalletResponse) Reset() {
 	*x = WalletResponse{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[1]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -90,7 +210,7 @@ func (x *WalletResponse) String() string {
 func (*WalletResponse) ProtoMessage() {}
 
 func (x *WalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[1]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -103,7 +223,7 @@ func (x *WalletResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WalletResponse.ProtoReflect.Descriptor instead.
 func (*WalletResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{1}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *WalletResponse) GetId() string {
@@ -141,6 +261,69 @@ func (x *WalletResponse) GetCreatedAtUnix() int64 {
 	return 0
 }
 
+func (x *WalletResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetDeleteAfterUnix() int64 {
+	if x != nil {
+		return x.DeleteAfterUnix
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetKeyDestroyedAtUnix() int64 {
+	if x != nil {
+		return x.KeyDestroyedAtUnix
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetSmartAccount() *SmartAccount {
+	if x != nil {
+		return x.SmartAccount
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
 type GetWalletRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
@@ -150,7 +333,7 @@ type GetWalletRequest struct {
 
 func (x *GetWalletRequest) Reset() {
 	*x = GetWalletRequest{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[2]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -162,7 +345,7 @@ func (x *GetWalletRequest) String() string {
 func (*GetWalletRequest) ProtoMessage() {}
 
 func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[2]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -175,7 +358,7 @@ func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
 func (*GetWalletRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{2}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetWalletRequest) GetWalletId() string {
@@ -194,7 +377,7 @@ type ListWalletsRequest struct {
 
 func (x *ListWalletsRequest) Reset() {
 	*x = ListWalletsRequest{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[3]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +389,7 @@ func (x *ListWalletsRequest) String() string {
 func (*ListWalletsRequest) ProtoMessage() {}
 
 func (x *ListWalletsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[3]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +402,7 @@ func (x *ListWalletsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWalletsRequest.ProtoReflect.Descriptor instead.
 func (*ListWalletsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{3}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ListWalletsRequest) GetNetwork() string {
@@ -238,7 +421,7 @@ type ListWalletsResponse struct {
 
 func (x *ListWalletsResponse) Reset() {
 	*x = ListWalletsResponse{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[4]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -250,7 +433,7 @@ func (x *ListWalletsResponse) String() string {
 func (*ListWalletsResponse) ProtoMessage() {}
 
 func (x *ListWalletsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[4]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -263,7 +446,7 @@ func (x *ListWalletsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWalletsResponse.ProtoReflect.Descriptor instead.
 func (*ListWalletsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{4}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListWalletsResponse) GetWallets() []*WalletResponse {
@@ -283,7 +466,7 @@ type SignMessageRequest struct {
 
 func (x *SignMessageRequest) Reset() {
 	*x = SignMessageRequest{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[5]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -295,7 +478,7 @@ func (x *SignMessageRequest) String() string {
 func (*SignMessageRequest) ProtoMessage() {}
 
 func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[5]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -308,7 +491,7 @@ func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{5}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SignMessageRequest) GetWalletId() string {
@@ -335,7 +518,7 @@ type SignMessageResponse struct {
 
 func (x *SignMessageResponse) Reset() {
 	*x = SignMessageResponse{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[6]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +530,7 @@ func (x *SignMessageResponse) String() string {
 func (*SignMessageResponse) ProtoMessage() {}
 
 func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[6]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +543,7 @@ func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{6}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SignMessageResponse) GetSignature() string {
@@ -378,16 +561,18 @@ func (x *SignMessageResponse) GetPublicKey() string {
 }
 
 type SignTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WalletId    string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Transaction *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// Sign even when simulation shows the transaction reverting.
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SignTransactionRequest) Reset() {
 	*x = SignTransactionRequest{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[7]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -399,7 +584,7 @@ func (x *SignTransactionRequest) String() string {
 func (*SignTransactionRequest) ProtoMessage() {}
 
 func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[7]
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +597,7 @@ func (x *SignTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{7}
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SignTransactionRequest) GetWalletId() string {
@@ -429,71 +614,35 @@ func (x *SignTransactionRequest) GetTransaction() *Transaction {
 	return nil
 }
 
-type SignTransactionResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	SignedTransaction string                 `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *SignTransactionResponse) Reset() {
-	*x = SignTransactionResponse{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SignTransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SignTransactionResponse) ProtoMessage() {}
-
-func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[8]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SignTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *SignTransactionResponse) GetSignedTransaction() string {
+func (x *SignTransactionRequest) GetForce() bool {
 	if x != nil {
-		return x.SignedTransaction
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-type GetBalanceRequest struct {
+type SimulateTransactionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceRequest) Reset() {
-	*x = GetBalanceRequest{}
+func (x *SimulateTransactionRequest) Reset() {
+	*x = SimulateTransactionRequest{}
 	mi := &file_internal_api_grpc_wallet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceRequest) String() string {
+func (x *SimulateTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceRequest) ProtoMessage() {}
+func (*SimulateTransactionRequest) ProtoMessage() {}
 
-func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+func (x *SimulateTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_grpc_wallet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,39 +654,52 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
-func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetBalanceRequest) GetWalletId() string {
+func (x *SimulateTransactionRequest) GetWalletId() string {
 	if x != nil {
 		return x.WalletId
 	}
 	return ""
 }
 
-type GetBalanceResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Balance       *Balance               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+func (x *SimulateTransactionRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type SimulationResult struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Success      bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	RevertReason string                 `protobuf:"bytes,2,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty"`
+	RevertData   string                 `protobuf:"bytes,3,opt,name=revert_data,json=revertData,proto3" json:"revert_data,omitempty"`
+	ReturnData   string                 `protobuf:"bytes,4,opt,name=return_data,json=returnData,proto3" json:"return_data,omitempty"`
+	GasEstimate  uint64                 `protobuf:"varint,5,opt,name=gas_estimate,json=gasEstimate,proto3" json:"gas_estimate,omitempty"`
+	// JSON callTracer output, when the node supports debug_traceCall.
+	Trace         string `protobuf:"bytes,6,opt,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
+func (x *SimulationResult) Reset() {
+	*x = SimulationResult{}
 	mi := &file_internal_api_grpc_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *SimulationResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*SimulationResult) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *SimulationResult) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_grpc_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,41 +711,6113 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulationResult.ProtoReflect.Descriptor instead.
+func (*SimulationResult) Descriptor() ([]byte, []int) {
 	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetBalanceResponse) GetBalance() *Balance {
+func (x *SimulationResult) GetSuccess() bool {
 	if x != nil {
-		return x.Balance
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type Balance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SimulationResult) GetRevertReason() string {
+	if x != nil {
+		return x.RevertReason
+	}
+	return ""
 }
 
-func (x *Balance) Reset() {
-	*x = Balance{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SimulationResult) GetRevertData() string {
+	if x != nil {
+		return x.RevertData
+	}
+	return ""
 }
 
-func (x *Balance) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SimulationResult) GetReturnData() string {
+	if x != nil {
+		return x.ReturnData
+	}
+	return ""
+}
+
+func (x *SimulationResult) GetGasEstimate() uint64 {
+	if x != nil {
+		return x.GasEstimate
+	}
+	return 0
+}
+
+func (x *SimulationResult) GetTrace() string {
+	if x != nil {
+		return x.Trace
+	}
+	return ""
+}
+
+type SignTransactionResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	SignedTransaction string                 `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	// Set instead of signed_transaction when a quorum policy queued the
+	// transaction for approval.
+	Approval      *ApprovalRequest `protobuf:"bytes,2,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignTransactionResponse) Reset() {
+	*x = SignTransactionResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignTransactionResponse) ProtoMessage() {}
+
+func (x *SignTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SignTransactionResponse) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *SignTransactionResponse) GetApproval() *ApprovalRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// ContractCallRequest encodes a call from a JSON ABI, fragment or
+// human-readable signature. Each arg is a JSON value. mode is "send" (the
+// default) to sign a transaction or "call" for a read-only eth_call.
+type ContractCallRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Contract      string                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	Abi           string                 `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	Method        string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Args          []string               `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	Mode          string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	ChainId       int64                  `protobuf:"varint,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Value         string                 `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	GasLimit      uint64                 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice      string                 `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,11,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Force         bool                   `protobuf:"varint,12,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractCallRequest) Reset() {
+	*x = ContractCallRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractCallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCallRequest) ProtoMessage() {}
+
+func (x *ContractCallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCallRequest.ProtoReflect.Descriptor instead.
+func (*ContractCallRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ContractCallRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ContractCallRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ContractCallRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *ContractCallRequest) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *ContractCallRequest) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ContractCallRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ContractOutput struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type  string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// JSON encoded value.
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractOutput) Reset() {
+	*x = ContractOutput{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractOutput) ProtoMessage() {}
+
+func (x *ContractOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractOutput.ProtoReflect.Descriptor instead.
+func (*ContractOutput) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ContractOutput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContractOutput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ContractOutput) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ContractCallResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Data              string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	SignedTransaction string                 `protobuf:"bytes,2,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	Outputs           []*ContractOutput      `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// Set when a quorum policy queued the transaction for approval.
+	Approval      *ApprovalRequest `protobuf:"bytes,4,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContractCallResponse) Reset() {
+	*x = ContractCallResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContractCallResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCallResponse) ProtoMessage() {}
+
+func (x *ContractCallResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCallResponse.ProtoReflect.Descriptor instead.
+func (*ContractCallResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ContractCallResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ContractCallResponse) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *ContractCallResponse) GetOutputs() []*ContractOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *ContractCallResponse) GetApproval() *ApprovalRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// Transaction fields left empty are read from the network.
+type TokenTxOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GasLimit      uint64                 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice      string                 `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Broadcast     bool                   `protobuf:"varint,4,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Force         bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTxOptions) Reset() {
+	*x = TokenTxOptions{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTxOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTxOptions) ProtoMessage() {}
+
+func (x *TokenTxOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTxOptions.ProtoReflect.Descriptor instead.
+func (*TokenTxOptions) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TokenTxOptions) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *TokenTxOptions) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *TokenTxOptions) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *TokenTxOptions) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+func (x *TokenTxOptions) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// token is a registered symbol or a contract address; amount is in whole
+// tokens, such as "12.5".
+type TransferTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Options       *TokenTxOptions        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferTokenRequest) Reset() {
+	*x = TransferTokenRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferTokenRequest) ProtoMessage() {}
+
+func (x *TransferTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferTokenRequest.ProtoReflect.Descriptor instead.
+func (*TransferTokenRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TransferTokenRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *TransferTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TransferTokenRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TransferTokenRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransferTokenRequest) GetOptions() *TokenTxOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// amount may be "max" for an unlimited allowance.
+type ApproveTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Spender       string                 `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Options       *TokenTxOptions        `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveTokenRequest) Reset() {
+	*x = ApproveTokenRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTokenRequest) ProtoMessage() {}
+
+func (x *ApproveTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTokenRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTokenRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApproveTokenRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ApproveTokenRequest) GetOptions() *TokenTxOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Token) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Token) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type TokenTxResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Amount in the token's base units.
+	Amount            string       `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Transaction       *Transaction `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	SignedTransaction string       `protobuf:"bytes,4,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	TxHash            string       `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BroadcastError    string       `protobuf:"bytes,6,opt,name=broadcast_error,json=broadcastError,proto3" json:"broadcast_error,omitempty"`
+	// Set instead of the other fields when a quorum policy queued the
+	// transaction for approval.
+	Approval      *ApprovalRequest `protobuf:"bytes,7,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenTxResponse) Reset() {
+	*x = TokenTxResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenTxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenTxResponse) ProtoMessage() {}
+
+func (x *TokenTxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenTxResponse.ProtoReflect.Descriptor instead.
+func (*TokenTxResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TokenTxResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *TokenTxResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TokenTxResponse) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TokenTxResponse) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *TokenTxResponse) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *TokenTxResponse) GetBroadcastError() string {
+	if x != nil {
+		return x.BroadcastError
+	}
+	return ""
+}
+
+func (x *TokenTxResponse) GetApproval() *ApprovalRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// kind is "eip2612" (the default) or "permit2". value is in whole tokens or
+// "max"; deadline and expiration are unix seconds.
+type SignPermitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Spender       string                 `protobuf:"bytes,4,opt,name=spender,proto3" json:"spender,omitempty"`
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Deadline      int64                  `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Expiration    int64                  `protobuf:"varint,7,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignPermitRequest) Reset() {
+	*x = SignPermitRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignPermitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPermitRequest) ProtoMessage() {}
+
+func (x *SignPermitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPermitRequest.ProtoReflect.Descriptor instead.
+func (*SignPermitRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SignPermitRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignPermitRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SignPermitRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *SignPermitRequest) GetSpender() string {
+	if x != nil {
+		return x.Spender
+	}
+	return ""
+}
+
+func (x *SignPermitRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SignPermitRequest) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SignPermitRequest) GetExpiration() int64 {
+	if x != nil {
+		return x.Expiration
+	}
+	return 0
+}
+
+type SignPermitResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Token     *Token                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Value     string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Deadline  int64                  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signature string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	V         uint32                 `protobuf:"varint,5,opt,name=v,proto3" json:"v,omitempty"`
+	R         string                 `protobuf:"bytes,6,opt,name=r,proto3" json:"r,omitempty"`
+	S         string                 `protobuf:"bytes,7,opt,name=s,proto3" json:"s,omitempty"`
+	// JSON EIP-712 document that was signed.
+	TypedData     string `protobuf:"bytes,8,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignPermitResponse) Reset() {
+	*x = SignPermitResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignPermitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPermitResponse) ProtoMessage() {}
+
+func (x *SignPermitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPermitResponse.ProtoReflect.Descriptor instead.
+func (*SignPermitResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SignPermitResponse) GetToken() *Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *SignPermitResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SignPermitResponse) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *SignPermitResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignPermitResponse) GetV() uint32 {
+	if x != nil {
+		return x.V
+	}
+	return 0
+}
+
+func (x *SignPermitResponse) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *SignPermitResponse) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+func (x *SignPermitResponse) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+// ERC-4337 user operation. Quantities and byte fields are 0x hex. init_code
+// and paymaster_and_data are the v0.6 fields; v0.7 uses factory and the
+// paymaster fields instead.
+type UserOperation struct {
+	state                         protoimpl.MessageState `protogen:"open.v1"`
+	Sender                        string                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Nonce                         string                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	InitCode                      string                 `protobuf:"bytes,3,opt,name=init_code,json=initCode,proto3" json:"init_code,omitempty"`
+	Factory                       string                 `protobuf:"bytes,4,opt,name=factory,proto3" json:"factory,omitempty"`
+	FactoryData                   string                 `protobuf:"bytes,5,opt,name=factory_data,json=factoryData,proto3" json:"factory_data,omitempty"`
+	CallData                      string                 `protobuf:"bytes,6,opt,name=call_data,json=callData,proto3" json:"call_data,omitempty"`
+	CallGasLimit                  string                 `protobuf:"bytes,7,opt,name=call_gas_limit,json=callGasLimit,proto3" json:"call_gas_limit,omitempty"`
+	VerificationGasLimit          string                 `protobuf:"bytes,8,opt,name=verification_gas_limit,json=verificationGasLimit,proto3" json:"verification_gas_limit,omitempty"`
+	PreVerificationGas            string                 `protobuf:"bytes,9,opt,name=pre_verification_gas,json=preVerificationGas,proto3" json:"pre_verification_gas,omitempty"`
+	MaxFeePerGas                  string                 `protobuf:"bytes,10,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas          string                 `protobuf:"bytes,11,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	PaymasterAndData              string                 `protobuf:"bytes,12,opt,name=paymaster_and_data,json=paymasterAndData,proto3" json:"paymaster_and_data,omitempty"`
+	Paymaster                     string                 `protobuf:"bytes,13,opt,name=paymaster,proto3" json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit string                 `protobuf:"bytes,14,opt,name=paymaster_verification_gas_limit,json=paymasterVerificationGasLimit,proto3" json:"paymaster_verification_gas_limit,omitempty"`
+	PaymasterPostOpGasLimit       string                 `protobuf:"bytes,15,opt,name=paymaster_post_op_gas_limit,json=paymasterPostOpGasLimit,proto3" json:"paymaster_post_op_gas_limit,omitempty"`
+	PaymasterData                 string                 `protobuf:"bytes,16,opt,name=paymaster_data,json=paymasterData,proto3" json:"paymaster_data,omitempty"`
+	Signature                     string                 `protobuf:"bytes,17,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *UserOperation) Reset() {
+	*x = UserOperation{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserOperation) ProtoMessage() {}
+
+func (x *UserOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserOperation.ProtoReflect.Descriptor instead.
+func (*UserOperation) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserOperation) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *UserOperation) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *UserOperation) GetInitCode() string {
+	if x != nil {
+		return x.InitCode
+	}
+	return ""
+}
+
+func (x *UserOperation) GetFactory() string {
+	if x != nil {
+		return x.Factory
+	}
+	return ""
+}
+
+func (x *UserOperation) GetFactoryData() string {
+	if x != nil {
+		return x.FactoryData
+	}
+	return ""
+}
+
+func (x *UserOperation) GetCallData() string {
+	if x != nil {
+		return x.CallData
+	}
+	return ""
+}
+
+func (x *UserOperation) GetCallGasLimit() string {
+	if x != nil {
+		return x.CallGasLimit
+	}
+	return ""
+}
+
+func (x *UserOperation) GetVerificationGasLimit() string {
+	if x != nil {
+		return x.VerificationGasLimit
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPreVerificationGas() string {
+	if x != nil {
+		return x.PreVerificationGas
+	}
+	return ""
+}
+
+func (x *UserOperation) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *UserOperation) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPaymasterAndData() string {
+	if x != nil {
+		return x.PaymasterAndData
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPaymaster() string {
+	if x != nil {
+		return x.Paymaster
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPaymasterVerificationGasLimit() string {
+	if x != nil {
+		return x.PaymasterVerificationGasLimit
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPaymasterPostOpGasLimit() string {
+	if x != nil {
+		return x.PaymasterPostOpGasLimit
+	}
+	return ""
+}
+
+func (x *UserOperation) GetPaymasterData() string {
+	if x != nil {
+		return x.PaymasterData
+	}
+	return ""
+}
+
+func (x *UserOperation) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// version is "v0.6" or "v0.7" (the default). entry_point defaults to the
+// canonical deployment of that version.
+type SignUserOperationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	EntryPoint    string                 `protobuf:"bytes,3,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	UserOperation *UserOperation         `protobuf:"bytes,4,opt,name=user_operation,json=userOperation,proto3" json:"user_operation,omitempty"`
+	Submit        bool                   `protobuf:"varint,5,opt,name=submit,proto3" json:"submit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUserOperationRequest) Reset() {
+	*x = SignUserOperationRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUserOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUserOperationRequest) ProtoMessage() {}
+
+func (x *SignUserOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUserOperationRequest.ProtoReflect.Descriptor instead.
+func (*SignUserOperationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SignUserOperationRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignUserOperationRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SignUserOperationRequest) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *SignUserOperationRequest) GetUserOperation() *UserOperation {
+	if x != nil {
+		return x.UserOperation
+	}
+	return nil
+}
+
+func (x *SignUserOperationRequest) GetSubmit() bool {
+	if x != nil {
+		return x.Submit
+	}
+	return false
+}
+
+type SignUserOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	EntryPoint    string                 `protobuf:"bytes,2,opt,name=entry_point,json=entryPoint,proto3" json:"entry_point,omitempty"`
+	ChainId       int64                  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	UserOpHash    string                 `protobuf:"bytes,4,opt,name=user_op_hash,json=userOpHash,proto3" json:"user_op_hash,omitempty"`
+	UserOperation *UserOperation         `protobuf:"bytes,5,opt,name=user_operation,json=userOperation,proto3" json:"user_operation,omitempty"`
+	Submitted     bool                   `protobuf:"varint,6,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	SubmitError   string                 `protobuf:"bytes,7,opt,name=submit_error,json=submitError,proto3" json:"submit_error,omitempty"`
+	// Set instead of the other fields when a quorum policy queued the
+	// operation.
+	Approval      *ApprovalRequest `protobuf:"bytes,8,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignUserOperationResponse) Reset() {
+	*x = SignUserOperationResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignUserOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignUserOperationResponse) ProtoMessage() {}
+
+func (x *SignUserOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignUserOperationResponse.ProtoReflect.Descriptor instead.
+func (*SignUserOperationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SignUserOperationResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SignUserOperationResponse) GetEntryPoint() string {
+	if x != nil {
+		return x.EntryPoint
+	}
+	return ""
+}
+
+func (x *SignUserOperationResponse) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SignUserOperationResponse) GetUserOpHash() string {
+	if x != nil {
+		return x.UserOpHash
+	}
+	return ""
+}
+
+func (x *SignUserOperationResponse) GetUserOperation() *UserOperation {
+	if x != nil {
+		return x.UserOperation
+	}
+	return nil
+}
+
+func (x *SignUserOperationResponse) GetSubmitted() bool {
+	if x != nil {
+		return x.Submitted
+	}
+	return false
+}
+
+func (x *SignUserOperationResponse) GetSubmitError() string {
+	if x != nil {
+		return x.SubmitError
+	}
+	return ""
+}
+
+func (x *SignUserOperationResponse) GetApproval() *ApprovalRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// abi is an optional JSON ABI or signature tried before the built-in
+// registry of ERC-20/721/1155, Multicall and Permit2 methods.
+type DecodeTransactionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RawTransaction string                 `protobuf:"bytes,1,opt,name=raw_transaction,json=rawTransaction,proto3" json:"raw_transaction,omitempty"`
+	Abi            string                 `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DecodeTransactionRequest) Reset() {
+	*x = DecodeTransactionRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionRequest) ProtoMessage() {}
+
+func (x *DecodeTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DecodeTransactionRequest) GetRawTransaction() string {
+	if x != nil {
+		return x.RawTransaction
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+type DecodeCalldataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Abi           string                 `protobuf:"bytes,2,opt,name=abi,proto3" json:"abi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeCalldataRequest) Reset() {
+	*x = DecodeCalldataRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeCalldataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeCalldataRequest) ProtoMessage() {}
+
+func (x *DecodeCalldataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeCalldataRequest.ProtoReflect.Descriptor instead.
+func (*DecodeCalldataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DecodeCalldataRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DecodeCalldataRequest) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+// Amounts are decimal wei strings.
+type DecodedTransaction struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Type                 int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Hash                 string                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	From                 string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	ChainId              int64                  `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce                uint64                 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Value                string                 `protobuf:"bytes,7,opt,name=value,proto3" json:"value,omitempty"`
+	GasLimit             uint64                 `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice             string                 `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	MaxFeePerGas         string                 `protobuf:"bytes,10,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string                 `protobuf:"bytes,11,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	Data                 string                 `protobuf:"bytes,12,opt,name=data,proto3" json:"data,omitempty"`
+	Call                 *DecodedCall           `protobuf:"bytes,13,opt,name=call,proto3" json:"call,omitempty"`
+	MaxFeePerBlobGas     string                 `protobuf:"bytes,14,opt,name=max_fee_per_blob_gas,json=maxFeePerBlobGas,proto3" json:"max_fee_per_blob_gas,omitempty"`
+	BlobVersionedHashes  []string               `protobuf:"bytes,15,rep,name=blob_versioned_hashes,json=blobVersionedHashes,proto3" json:"blob_versioned_hashes,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DecodedTransaction) Reset() {
+	*x = DecodedTransaction{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedTransaction) ProtoMessage() {}
+
+func (x *DecodedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedTransaction.ProtoReflect.Descriptor instead.
+func (*DecodedTransaction) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DecodedTransaction) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *DecodedTransaction) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetMaxFeePerGas() string {
+	if x != nil {
+		return x.MaxFeePerGas
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetCall() *DecodedCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *DecodedTransaction) GetMaxFeePerBlobGas() string {
+	if x != nil {
+		return x.MaxFeePerBlobGas
+	}
+	return ""
+}
+
+func (x *DecodedTransaction) GetBlobVersionedHashes() []string {
+	if x != nil {
+		return x.BlobVersionedHashes
+	}
+	return nil
+}
+
+type DecodedCall struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Selector      string                 `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Standards     []string               `protobuf:"bytes,4,rep,name=standards,proto3" json:"standards,omitempty"`
+	Args          []*ContractOutput      `protobuf:"bytes,5,rep,name=args,proto3" json:"args,omitempty"`
+	Calls         []*DecodedCall         `protobuf:"bytes,6,rep,name=calls,proto3" json:"calls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodedCall) Reset() {
+	*x = DecodedCall{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodedCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedCall) ProtoMessage() {}
+
+func (x *DecodedCall) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedCall.ProtoReflect.Descriptor instead.
+func (*DecodedCall) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DecodedCall) GetSelector() string {
+	if x != nil {
+		return x.Selector
+	}
+	return ""
+}
+
+func (x *DecodedCall) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *DecodedCall) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DecodedCall) GetStandards() []string {
+	if x != nil {
+		return x.Standards
+	}
+	return nil
+}
+
+func (x *DecodedCall) GetArgs() []*ContractOutput {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *DecodedCall) GetCalls() []*DecodedCall {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
+type IssueSIWENonceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueSIWENonceRequest) Reset() {
+	*x = IssueSIWENonceRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueSIWENonceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueSIWENonceRequest) ProtoMessage() {}
+
+func (x *IssueSIWENonceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueSIWENonceRequest.ProtoReflect.Descriptor instead.
+func (*IssueSIWENonceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{29}
+}
+
+type SIWENonce struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nonce         string                 `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ExpiresAtUnix int64                  `protobuf:"varint,2,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SIWENonce) Reset() {
+	*x = SIWENonce{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIWENonce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIWENonce) ProtoMessage() {}
+
+func (x *SIWENonce) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIWENonce.ProtoReflect.Descriptor instead.
+func (*SIWENonce) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SIWENonce) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SIWENonce) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+// nonce must come from IssueSIWENonce and is issued when empty. chain_id
+// defaults to the wallet's network; expires_in is a Go duration such as "5m".
+type SignInWithEthereumRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Uri           string                 `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Statement     string                 `protobuf:"bytes,4,opt,name=statement,proto3" json:"statement,omitempty"`
+	ChainId       int64                  `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce         string                 `protobuf:"bytes,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ExpiresIn     string                 `protobuf:"bytes,7,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	NotBeforeUnix int64                  `protobuf:"varint,8,opt,name=not_before_unix,json=notBeforeUnix,proto3" json:"not_before_unix,omitempty"`
+	RequestId     string                 `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Resources     []string               `protobuf:"bytes,10,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInWithEthereumRequest) Reset() {
+	*x = SignInWithEthereumRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithEthereumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithEthereumRequest) ProtoMessage() {}
+
+func (x *SignInWithEthereumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInWithEthereumRequest.ProtoReflect.Descriptor instead.
+func (*SignInWithEthereumRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SignInWithEthereumRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SignInWithEthereumRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetExpiresIn() string {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetNotBeforeUnix() int64 {
+	if x != nil {
+		return x.NotBeforeUnix
+	}
+	return 0
+}
+
+func (x *SignInWithEthereumRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumRequest) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// Parsed EIP-4361 message fields. Unset times are zero.
+type SIWEMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Domain             string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Address            string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Statement          string                 `protobuf:"bytes,3,opt,name=statement,proto3" json:"statement,omitempty"`
+	Uri                string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Version            string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId            int64                  `protobuf:"varint,6,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce              string                 `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	IssuedAtUnix       int64                  `protobuf:"varint,8,opt,name=issued_at_unix,json=issuedAtUnix,proto3" json:"issued_at_unix,omitempty"`
+	ExpirationTimeUnix int64                  `protobuf:"varint,9,opt,name=expiration_time_unix,json=expirationTimeUnix,proto3" json:"expiration_time_unix,omitempty"`
+	NotBeforeUnix      int64                  `protobuf:"varint,10,opt,name=not_before_unix,json=notBeforeUnix,proto3" json:"not_before_unix,omitempty"`
+	RequestId          string                 `protobuf:"bytes,11,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Resources          []string               `protobuf:"bytes,12,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SIWEMessage) Reset() {
+	*x = SIWEMessage{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SIWEMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SIWEMessage) ProtoMessage() {}
+
+func (x *SIWEMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SIWEMessage.ProtoReflect.Descriptor instead.
+func (*SIWEMessage) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SIWEMessage) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SIWEMessage) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetIssuedAtUnix() int64 {
+	if x != nil {
+		return x.IssuedAtUnix
+	}
+	return 0
+}
+
+func (x *SIWEMessage) GetExpirationTimeUnix() int64 {
+	if x != nil {
+		return x.ExpirationTimeUnix
+	}
+	return 0
+}
+
+func (x *SIWEMessage) GetNotBeforeUnix() int64 {
+	if x != nil {
+		return x.NotBeforeUnix
+	}
+	return 0
+}
+
+func (x *SIWEMessage) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SIWEMessage) GetResources() []string {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type SignInWithEthereumResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Fields        *SIWEMessage           `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignInWithEthereumResponse) Reset() {
+	*x = SignInWithEthereumResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignInWithEthereumResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithEthereumResponse) ProtoMessage() {}
+
+func (x *SignInWithEthereumResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInWithEthereumResponse.ProtoReflect.Descriptor instead.
+func (*SignInWithEthereumResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SignInWithEthereumResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignInWithEthereumResponse) GetFields() *SIWEMessage {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// domain, when set, must match the domain in the message.
+type VerifySIWERequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Domain        string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySIWERequest) Reset() {
+	*x = VerifySIWERequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySIWERequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySIWERequest) ProtoMessage() {}
+
+func (x *VerifySIWERequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySIWERequest.ProtoReflect.Descriptor instead.
+func (*VerifySIWERequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifySIWERequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifySIWERequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifySIWERequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type VerifySIWEResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Fields        *SIWEMessage           `protobuf:"bytes,4,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySIWEResponse) Reset() {
+	*x = VerifySIWEResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySIWEResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySIWEResponse) ProtoMessage() {}
+
+func (x *VerifySIWEResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySIWEResponse.ProtoReflect.Descriptor instead.
+func (*VerifySIWEResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VerifySIWEResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifySIWEResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifySIWEResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *VerifySIWEResponse) GetFields() *SIWEMessage {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Set exactly one of message (EIP-191), hash (0x 32 bytes) or typed_data
+// (a JSON EIP-712 document).
+type VerifySignatureRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Hash          string                 `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	TypedData     string                 `protobuf:"bytes,5,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+	Signature     string                 `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignatureRequest) Reset() {
+	*x = VerifySignatureRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureRequest) ProtoMessage() {}
+
+func (x *VerifySignatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureRequest.ProtoReflect.Descriptor instead.
+func (*VerifySignatureRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifySignatureRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+func (x *VerifySignatureRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// method is "ecrecover", "erc1271" or "erc6492" when the signature is valid.
+type VerifySignatureResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifySignatureResponse) Reset() {
+	*x = VerifySignatureResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifySignatureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySignatureResponse) ProtoMessage() {}
+
+func (x *VerifySignatureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySignatureResponse.ProtoReflect.Descriptor instead.
+func (*VerifySignatureResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VerifySignatureResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifySignatureResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *VerifySignatureResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifySignatureResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SafeTx fields. value and the gas fields are wei amounts, decimal or 0x hex;
+// operation is 0 (call) or 1 (delegatecall). An empty nonce uses the Safe's
+// current nonce.
+type SafeTransaction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	To             string                 `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Value          string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Data           string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Operation      uint32                 `protobuf:"varint,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	SafeTxGas      string                 `protobuf:"bytes,5,opt,name=safe_tx_gas,json=safeTxGas,proto3" json:"safe_tx_gas,omitempty"`
+	BaseGas        string                 `protobuf:"bytes,6,opt,name=base_gas,json=baseGas,proto3" json:"base_gas,omitempty"`
+	GasPrice       string                 `protobuf:"bytes,7,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasToken       string                 `protobuf:"bytes,8,opt,name=gas_token,json=gasToken,proto3" json:"gas_token,omitempty"`
+	RefundReceiver string                 `protobuf:"bytes,9,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+	Nonce          string                 `protobuf:"bytes,10,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SafeTransaction) Reset() {
+	*x = SafeTransaction{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SafeTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeTransaction) ProtoMessage() {}
+
+func (x *SafeTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeTransaction.ProtoReflect.Descriptor instead.
+func (*SafeTransaction) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SafeTransaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetOperation() uint32 {
+	if x != nil {
+		return x.Operation
+	}
+	return 0
+}
+
+func (x *SafeTransaction) GetSafeTxGas() string {
+	if x != nil {
+		return x.SafeTxGas
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetBaseGas() string {
+	if x != nil {
+		return x.BaseGas
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetGasToken() string {
+	if x != nil {
+		return x.GasToken
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetRefundReceiver() string {
+	if x != nil {
+		return x.RefundReceiver
+	}
+	return ""
+}
+
+func (x *SafeTransaction) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type ProposeSafeTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Safe          string                 `protobuf:"bytes,2,opt,name=safe,proto3" json:"safe,omitempty"`
+	Transaction   *SafeTransaction       `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposeSafeTransactionRequest) Reset() {
+	*x = ProposeSafeTransactionRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposeSafeTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposeSafeTransactionRequest) ProtoMessage() {}
+
+func (x *ProposeSafeTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposeSafeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ProposeSafeTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ProposeSafeTransactionRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ProposeSafeTransactionRequest) GetSafe() string {
+	if x != nil {
+		return x.Safe
+	}
+	return ""
+}
+
+func (x *ProposeSafeTransactionRequest) GetTransaction() *SafeTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type SafeSignature struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         string                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	SignedAtUnix  int64                  `protobuf:"varint,4,opt,name=signed_at_unix,json=signedAtUnix,proto3" json:"signed_at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SafeSignature) Reset() {
+	*x = SafeSignature{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SafeSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeSignature) ProtoMessage() {}
+
+func (x *SafeSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeSignature.ProtoReflect.Descriptor instead.
+func (*SafeSignature) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SafeSignature) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *SafeSignature) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SafeSignature) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SafeSignature) GetSignedAtUnix() int64 {
+	if x != nil {
+		return x.SignedAtUnix
+	}
+	return 0
+}
+
+// status is "pending", "ready" or "executed". execution_data is the
+// execTransaction calldata, set once the threshold is met. typed_data is the
+// SafeTx EIP-712 document as JSON.
+type SafeProposal struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Network           string                 `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	ChainId           int64                  `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Safe              string                 `protobuf:"bytes,5,opt,name=safe,proto3" json:"safe,omitempty"`
+	Version           string                 `protobuf:"bytes,6,opt,name=version,proto3" json:"version,omitempty"`
+	Threshold         int32                  `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Owners            []string               `protobuf:"bytes,8,rep,name=owners,proto3" json:"owners,omitempty"`
+	Transaction       *SafeTransaction       `protobuf:"bytes,9,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	SafeTxHash        string                 `protobuf:"bytes,10,opt,name=safe_tx_hash,json=safeTxHash,proto3" json:"safe_tx_hash,omitempty"`
+	TypedData         string                 `protobuf:"bytes,11,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+	Signatures        []*SafeSignature       `protobuf:"bytes,12,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Status            string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	ExecutionData     string                 `protobuf:"bytes,14,opt,name=execution_data,json=executionData,proto3" json:"execution_data,omitempty"`
+	SignedTransaction string                 `protobuf:"bytes,15,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	TxHash            string                 `protobuf:"bytes,16,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BroadcastError    string                 `protobuf:"bytes,17,opt,name=broadcast_error,json=broadcastError,proto3" json:"broadcast_error,omitempty"`
+	CreatedAtUnix     int64                  `protobuf:"varint,18,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	UpdatedAtUnix     int64                  `protobuf:"varint,19,opt,name=updated_at_unix,json=updatedAtUnix,proto3" json:"updated_at_unix,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SafeProposal) Reset() {
+	*x = SafeProposal{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SafeProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafeProposal) ProtoMessage() {}
+
+func (x *SafeProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafeProposal.ProtoReflect.Descriptor instead.
+func (*SafeProposal) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SafeProposal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SafeProposal) GetSafe() string {
+	if x != nil {
+		return x.Safe
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SafeProposal) GetOwners() []string {
+	if x != nil {
+		return x.Owners
+	}
+	return nil
+}
+
+func (x *SafeProposal) GetTransaction() *SafeTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *SafeProposal) GetSafeTxHash() string {
+	if x != nil {
+		return x.SafeTxHash
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetTypedData() string {
+	if x != nil {
+		return x.TypedData
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetSignatures() []*SafeSignature {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+func (x *SafeProposal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetExecutionData() string {
+	if x != nil {
+		return x.ExecutionData
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetBroadcastError() string {
+	if x != nil {
+		return x.BroadcastError
+	}
+	return ""
+}
+
+func (x *SafeProposal) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *SafeProposal) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+type GetSafeProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSafeProposalRequest) Reset() {
+	*x = GetSafeProposalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSafeProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSafeProposalRequest) ProtoMessage() {}
+
+func (x *GetSafeProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSafeProposalRequest.ProtoReflect.Descriptor instead.
+func (*GetSafeProposalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetSafeProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+type ListSafeProposalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSafeProposalsRequest) Reset() {
+	*x = ListSafeProposalsRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSafeProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSafeProposalsRequest) ProtoMessage() {}
+
+func (x *ListSafeProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSafeProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListSafeProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListSafeProposalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSafeProposalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposals     []*SafeProposal        `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSafeProposalsResponse) Reset() {
+	*x = ListSafeProposalsResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSafeProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSafeProposalsResponse) ProtoMessage() {}
+
+func (x *ListSafeProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSafeProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListSafeProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListSafeProposalsResponse) GetProposals() []*SafeProposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type SignSafeProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignSafeProposalRequest) Reset() {
+	*x = SignSafeProposalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSafeProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSafeProposalRequest) ProtoMessage() {}
+
+func (x *SignSafeProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSafeProposalRequest.ProtoReflect.Descriptor instead.
+func (*SignSafeProposalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SignSafeProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *SignSafeProposalRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+// wallet_id must be an owner of the Safe; it signs and pays for the
+// execTransaction call.
+type ExecuteSafeProposalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Options       *TokenTxOptions        `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteSafeProposalRequest) Reset() {
+	*x = ExecuteSafeProposalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteSafeProposalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSafeProposalRequest) ProtoMessage() {}
+
+func (x *ExecuteSafeProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSafeProposalRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteSafeProposalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExecuteSafeProposalRequest) GetProposalId() string {
+	if x != nil {
+		return x.ProposalId
+	}
+	return ""
+}
+
+func (x *ExecuteSafeProposalRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *ExecuteSafeProposalRequest) GetOptions() *TokenTxOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// approval is set instead of proposal when a quorum policy queued the
+// execution for approval.
+type ExecuteSafeProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proposal      *SafeProposal          `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	Approval      *ApprovalRequest       `protobuf:"bytes,2,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteSafeProposalResponse) Reset() {
+	*x = ExecuteSafeProposalResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteSafeProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteSafeProposalResponse) ProtoMessage() {}
+
+func (x *ExecuteSafeProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteSafeProposalResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteSafeProposalResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ExecuteSafeProposalResponse) GetProposal() *SafeProposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *ExecuteSafeProposalResponse) GetApproval() *ApprovalRequest {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// token is a symbol from the network's registry or a contract address. The
+// native asset is read when it is empty.
+type GetBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceRequest) Reset() {
+	*x = GetBalanceRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceRequest) ProtoMessage() {}
+
+func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetBalanceRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *GetBalanceRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balance       *Balance               `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceResponse) ProtoMessage() {}
+
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetBalanceResponse) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*Balance) ProtoMessage() {}
 
-func (x *Balance) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[11]
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Balance) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *Balance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Transaction struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ChainId  int64                  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	From     string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To       string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value    string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Data     string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	GasLimit uint64                 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice string                 `protobuf:"bytes,7,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce    uint64                 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// A transaction with an authorization list is an EIP-7702 set-code
+	// transaction; gas_price is then its max fee per gas, as it is for blob
+	// transactions.
+	MaxPriorityFeePerGas string           `protobuf:"bytes,9,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	AuthorizationList    []*Authorization `protobuf:"bytes,10,rep,name=authorization_list,json=authorizationList,proto3" json:"authorization_list,omitempty"`
+	// Blobs make it an EIP-4844 blob transaction, signed in its network
+	// encoding with the KZG sidecar. Each blob is 0x hex of at most 131072
+	// bytes.
+	MaxFeePerBlobGas string   `protobuf:"bytes,11,opt,name=max_fee_per_blob_gas,json=maxFeePerBlobGas,proto3" json:"max_fee_per_blob_gas,omitempty"`
+	Blobs            []string `protobuf:"bytes,12,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Transaction) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Transaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Transaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Transaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Transaction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *Transaction) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *Transaction) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+func (x *Transaction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Transaction) GetMaxPriorityFeePerGas() string {
+	if x != nil {
+		return x.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (x *Transaction) GetAuthorizationList() []*Authorization {
+	if x != nil {
+		return x.AuthorizationList
+	}
+	return nil
+}
+
+func (x *Transaction) GetMaxFeePerBlobGas() string {
+	if x != nil {
+		return x.MaxFeePerBlobGas
+	}
+	return ""
+}
+
+func (x *Transaction) GetBlobs() []string {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+type Authorization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChainId       int64                  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce         uint64                 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	YParity       uint32                 `protobuf:"varint,4,opt,name=y_parity,json=yParity,proto3" json:"y_parity,omitempty"`
+	R             string                 `protobuf:"bytes,5,opt,name=r,proto3" json:"r,omitempty"`
+	S             string                 `protobuf:"bytes,6,opt,name=s,proto3" json:"s,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Authorization) Reset() {
+	*x = Authorization{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Authorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authorization) ProtoMessage() {}
+
+func (x *Authorization) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Authorization.ProtoReflect.Descriptor instead.
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Authorization) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Authorization) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Authorization) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *Authorization) GetYParity() uint32 {
+	if x != nil {
+		return x.YParity
+	}
+	return 0
+}
+
+func (x *Authorization) GetR() string {
+	if x != nil {
+		return x.R
+	}
+	return ""
+}
+
+func (x *Authorization) GetS() string {
+	if x != nil {
+		return x.S
+	}
+	return ""
+}
+
+// chain_id defaults to the wallet's network and nonce to the account's
+// pending nonce.
+type SignAuthorizationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId       int64                  `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce         *uint64                `protobuf:"varint,4,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignAuthorizationRequest) Reset() {
+	*x = SignAuthorizationRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignAuthorizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignAuthorizationRequest) ProtoMessage() {}
+
+func (x *SignAuthorizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignAuthorizationRequest.ProtoReflect.Descriptor instead.
+func (*SignAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SignAuthorizationRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignAuthorizationRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SignAuthorizationRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *SignAuthorizationRequest) GetNonce() uint64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return 0
+}
+
+// transaction is in the chain's wire encoding: base64 for Solana.
+type SignSerializedTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Transaction   string                 `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignSerializedTransactionRequest) Reset() {
+	*x = SignSerializedTransactionRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSerializedTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSerializedTransactionRequest) ProtoMessage() {}
+
+func (x *SignSerializedTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSerializedTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SignSerializedTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SignSerializedTransactionRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignSerializedTransactionRequest) GetTransaction() string {
+	if x != nil {
+		return x.Transaction
+	}
+	return ""
+}
+
+type SignSerializedTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   string                 `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Txid          string                 `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignSerializedTransactionResponse) Reset() {
+	*x = SignSerializedTransactionResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignSerializedTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignSerializedTransactionResponse) ProtoMessage() {}
+
+func (x *SignSerializedTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignSerializedTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SignSerializedTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SignSerializedTransactionResponse) GetTransaction() string {
+	if x != nil {
+		return x.Transaction
+	}
+	return ""
+}
+
+func (x *SignSerializedTransactionResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *SignSerializedTransactionResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+// psbt is a base64 BIP-174 PSBT.
+type SignPSBTRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Psbt          string                 `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignPSBTRequest) Reset() {
+	*x = SignPSBTRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignPSBTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPSBTRequest) ProtoMessage() {}
+
+func (x *SignPSBTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPSBTRequest.ProtoReflect.Descriptor instead.
+func (*SignPSBTRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SignPSBTRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *SignPSBTRequest) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+type SignPSBTResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Psbt         string                 `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Txid         string                 `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	SignedInputs []int32                `protobuf:"varint,3,rep,packed,name=signed_inputs,json=signedInputs,proto3" json:"signed_inputs,omitempty"`
+	Complete     bool                   `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	// transaction is the hex network serialization, set when complete.
+	Transaction   string `protobuf:"bytes,5,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignPSBTResponse) Reset() {
+	*x = SignPSBTResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignPSBTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPSBTResponse) ProtoMessage() {}
+
+func (x *SignPSBTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPSBTResponse.ProtoReflect.Descriptor instead.
+func (*SignPSBTResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SignPSBTResponse) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *SignPSBTResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *SignPSBTResponse) GetSignedInputs() []int32 {
+	if x != nil {
+		return x.SignedInputs
+	}
+	return nil
+}
+
+func (x *SignPSBTResponse) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+func (x *SignPSBTResponse) GetTransaction() string {
+	if x != nil {
+		return x.Transaction
+	}
+	return ""
+}
+
+type DisableWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableWalletRequest) Reset() {
+	*x = DisableWalletRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableWalletRequest) ProtoMessage() {}
+
+func (x *DisableWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableWalletRequest.ProtoReflect.Descriptor instead.
+func (*DisableWalletRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DisableWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type EnableWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnableWalletRequest) Reset() {
+	*x = EnableWalletRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnableWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableWalletRequest) ProtoMessage() {}
+
+func (x *EnableWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableWalletRequest.ProtoReflect.Descriptor instead.
+func (*EnableWalletRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *EnableWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type ArchiveWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveWalletRequest) Reset() {
+	*x = ArchiveWalletRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWalletRequest) ProtoMessage() {}
+
+func (x *ArchiveWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWalletRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveWalletRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ArchiveWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type DeleteWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWalletRequest) Reset() {
+	*x = DeleteWalletRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWalletRequest) ProtoMessage() {}
+
+func (x *DeleteWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWalletRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWalletRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteWalletRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Sequence          uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TimestampUnixNano int64                  `protobuf:"varint,2,opt,name=timestamp_unix_nano,json=timestampUnixNano,proto3" json:"timestamp_unix_nano,omitempty"`
+	Actor             string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	WalletId          string                 `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Operation         string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	PayloadHash       string                 `protobuf:"bytes,6,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	Result            string                 `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	Outcome           string                 `protobuf:"bytes,8,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error             string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	PrevHash          string                 `protobuf:"bytes,10,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash              string                 `protobuf:"bytes,11,opt,name=hash,proto3" json:"hash,omitempty"`
+	TenantId          string                 `protobuf:"bytes,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Delegate          string                 `protobuf:"bytes,13,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AuditEntry) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetTimestampUnixNano() int64 {
+	if x != nil {
+		return x.TimestampUnixNano
+	}
+	return 0
+}
+
+func (x *AuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPayloadHash() string {
+	if x != nil {
+		return x.PayloadHash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+type ListAuditEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	SinceUnix     int64                  `protobuf:"varint,4,opt,name=since_unix,json=sinceUnix,proto3" json:"since_unix,omitempty"`
+	UntilUnix     int64                  `protobuf:"varint,5,opt,name=until_unix,json=untilUnix,proto3" json:"until_unix,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesRequest) Reset() {
+	*x = ListAuditEntriesRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesRequest) ProtoMessage() {}
+
+func (x *ListAuditEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAuditEntriesRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ListAuditEntriesRequest) GetSinceUnix() int64 {
+	if x != nil {
+		return x.SinceUnix
+	}
+	return 0
+}
+
+func (x *ListAuditEntriesRequest) GetUntilUnix() int64 {
+	if x != nil {
+		return x.UntilUnix
+	}
+	return 0
+}
+
+func (x *ListAuditEntriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditEntry          `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEntriesResponse) Reset() {
+	*x = ListAuditEntriesResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEntriesResponse) ProtoMessage() {}
+
+func (x *ListAuditEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListAuditEntriesResponse) GetEntries() []*AuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type VerifyAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogRequest) Reset() {
+	*x = VerifyAuditLogRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogRequest) ProtoMessage() {}
+
+func (x *VerifyAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{65}
+}
+
+type VerifyAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Entries       int64                  `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	HeadHash      string                 `protobuf:"bytes,3,opt,name=head_hash,json=headHash,proto3" json:"head_hash,omitempty"`
+	FirstInvalid  uint64                 `protobuf:"varint,4,opt,name=first_invalid,json=firstInvalid,proto3" json:"first_invalid,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditLogResponse) Reset() {
+	*x = VerifyAuditLogResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditLogResponse) ProtoMessage() {}
+
+func (x *VerifyAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *VerifyAuditLogResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditLogResponse) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
+	}
+	return ""
+}
+
+func (x *VerifyAuditLogResponse) GetFirstInvalid() uint64 {
+	if x != nil {
+		return x.FirstInvalid
+	}
+	return 0
+}
+
+func (x *VerifyAuditLogResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type APIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	CreatedAtUnix int64                  `protobuf:"varint,4,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	RevokedAtUnix int64                  `protobuf:"varint,5,opt,name=revoked_at_unix,json=revokedAtUnix,proto3" json:"revoked_at_unix,omitempty"`
+	TenantId      string                 `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *APIKey) Reset() {
+	*x = APIKey{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *APIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIKey) ProtoMessage() {}
+
+func (x *APIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
+func (*APIKey) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *APIKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *APIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *APIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *APIKey) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *APIKey) GetRevokedAtUnix() int64 {
+	if x != nil {
+		return x.RevokedAtUnix
+	}
+	return 0
+}
+
+func (x *APIKey) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	TenantId      string                 `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *APIKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateAPIKeyResponse) GetKey() *APIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysRequest) Reset() {
+	*x = ListAPIKeysRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysRequest) ProtoMessage() {}
+
+func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{70}
+}
+
+type ListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*APIKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAPIKeysResponse) Reset() {
+	*x = ListAPIKeysResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAPIKeysResponse) ProtoMessage() {}
+
+func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListAPIKeysResponse) GetKeys() []*APIKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type Tenant struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AllowedNetworks []string               `protobuf:"bytes,3,rep,name=allowed_networks,json=allowedNetworks,proto3" json:"allowed_networks,omitempty"`
+	MaxWallets      int32                  `protobuf:"varint,4,opt,name=max_wallets,json=maxWallets,proto3" json:"max_wallets,omitempty"`
+	CreatedAtUnix   int64                  `protobuf:"varint,5,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	UpdatedAtUnix   int64                  `protobuf:"varint,6,opt,name=updated_at_unix,json=updatedAtUnix,proto3" json:"updated_at_unix,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tenant) GetAllowedNetworks() []string {
+	if x != nil {
+		return x.AllowedNetworks
+	}
+	return nil
+}
+
+func (x *Tenant) GetMaxWallets() int32 {
+	if x != nil {
+		return x.MaxWallets
+	}
+	return 0
+}
+
+func (x *Tenant) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Tenant) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+type GetTenantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantRequest) Reset() {
+	*x = GetTenantRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantRequest) ProtoMessage() {}
+
+func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{75}
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenants       []*Tenant              `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+// AddNetworkRequest is a network in chainlist form. network defaults to
+// short_name, and rpc URLs may reference environment variables as ${VAR}.
+type AddNetworkRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Network        string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortName      string                 `protobuf:"bytes,3,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	ChainId        int64                  `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Rpc            []string               `protobuf:"bytes,5,rep,name=rpc,proto3" json:"rpc,omitempty"`
+	NativeCurrency *NativeCurrency        `protobuf:"bytes,6,opt,name=native_currency,json=nativeCurrency,proto3" json:"native_currency,omitempty"`
+	Explorers      []*Explorer            `protobuf:"bytes,7,rep,name=explorers,proto3" json:"explorers,omitempty"`
+	// family is "ethereum" (the default), "solana", "bitcoin", "cosmos" or
+	// "tron".
+	Family        string   `protobuf:"bytes,8,opt,name=family,proto3" json:"family,omitempty"`
+	CosmosChainId string   `protobuf:"bytes,9,opt,name=cosmos_chain_id,json=cosmosChainId,proto3" json:"cosmos_chain_id,omitempty"`
+	Bech32Prefix  string   `protobuf:"bytes,10,opt,name=bech32_prefix,json=bech32Prefix,proto3" json:"bech32_prefix,omitempty"`
+	BundlerUrl    string   `protobuf:"bytes,11,opt,name=bundler_url,json=bundlerUrl,proto3" json:"bundler_url,omitempty"`
+	Tokens        []*Token `protobuf:"bytes,12,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Disabled      bool     `protobuf:"varint,13,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// bitcoin_chain is "mainnet", "testnet3", "signet" or "regtest", and is
+	// required on bitcoin networks.
+	BitcoinChain  string `protobuf:"bytes,14,opt,name=bitcoin_chain,json=bitcoinChain,proto3" json:"bitcoin_chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddNetworkRequest) Reset() {
+	*x = AddNetworkRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNetworkRequest) ProtoMessage() {}
+
+func (x *AddNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNetworkRequest.ProtoReflect.Descriptor instead.
+func (*AddNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AddNetworkRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *AddNetworkRequest) GetRpc() []string {
+	if x != nil {
+		return x.Rpc
+	}
+	return nil
+}
+
+func (x *AddNetworkRequest) GetNativeCurrency() *NativeCurrency {
+	if x != nil {
+		return x.NativeCurrency
+	}
+	return nil
+}
+
+func (x *AddNetworkRequest) GetExplorers() []*Explorer {
+	if x != nil {
+		return x.Explorers
+	}
+	return nil
+}
+
+func (x *AddNetworkRequest) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetCosmosChainId() string {
+	if x != nil {
+		return x.CosmosChainId
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetBech32Prefix() string {
+	if x != nil {
+		return x.Bech32Prefix
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetBundlerUrl() string {
+	if x != nil {
+		return x.BundlerUrl
+	}
+	return ""
+}
+
+func (x *AddNetworkRequest) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *AddNetworkRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *AddNetworkRequest) GetBitcoinChain() string {
+	if x != nil {
+		return x.BitcoinChain
+	}
+	return ""
+}
+
+type NativeCurrency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NativeCurrency) Reset() {
+	*x = NativeCurrency{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NativeCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NativeCurrency) ProtoMessage() {}
+
+func (x *NativeCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NativeCurrency.ProtoReflect.Descriptor instead.
+func (*NativeCurrency) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NativeCurrency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NativeCurrency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *NativeCurrency) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type Explorer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Standard      string                 `protobuf:"bytes,3,opt,name=standard,proto3" json:"standard,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Explorer) Reset() {
+	*x = Explorer{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Explorer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Explorer) ProtoMessage() {}
+
+func (x *Explorer) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Explorer.ProtoReflect.Descriptor instead.
+func (*Explorer) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Explorer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Explorer) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Explorer) GetStandard() string {
+	if x != nil {
+		return x.Standard
+	}
+	return ""
+}
+
+type NetworkInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Family        string                 `protobuf:"bytes,3,opt,name=family,proto3" json:"family,omitempty"`
+	ChainId       int64                  `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	NativeAsset   string                 `protobuf:"bytes,5,opt,name=native_asset,json=nativeAsset,proto3" json:"native_asset,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	ExplorerUrl   string                 `protobuf:"bytes,7,opt,name=explorer_url,json=explorerUrl,proto3" json:"explorer_url,omitempty"`
+	Disabled      bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkInfo) Reset() {
+	*x = NetworkInfo{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkInfo) ProtoMessage() {}
+
+func (x *NetworkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkInfo.ProtoReflect.Descriptor instead.
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NetworkInfo) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetChainId() int64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *NetworkInfo) GetNativeAsset() string {
+	if x != nil {
+		return x.NativeAsset
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *NetworkInfo) GetExplorerUrl() string {
+	if x != nil {
+		return x.ExplorerUrl
+	}
+	return ""
+}
+
+func (x *NetworkInfo) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type NetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkRequest) Reset() {
+	*x = NetworkRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkRequest) ProtoMessage() {}
+
+func (x *NetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkRequest.ProtoReflect.Descriptor instead.
+func (*NetworkRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NetworkRequest) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+type ListNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksRequest) Reset() {
+	*x = ListNetworksRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksRequest) ProtoMessage() {}
+
+func (x *ListNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksRequest.ProtoReflect.Descriptor instead.
+func (*ListNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{82}
+}
+
+type ListNetworksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Networks      []*NetworkInfo         `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNetworksResponse) Reset() {
+	*x = ListNetworksResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNetworksResponse) ProtoMessage() {}
+
+func (x *ListNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNetworksResponse.ProtoReflect.Descriptor instead.
+func (*ListNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListNetworksResponse) GetNetworks() []*NetworkInfo {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type PolicyRules struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MaxValue            string                 `protobuf:"bytes,1,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	WindowValue         string                 `protobuf:"bytes,2,opt,name=window_value,json=windowValue,proto3" json:"window_value,omitempty"`
+	Window              string                 `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	AllowedDestinations []string               `protobuf:"bytes,4,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	DeniedDestinations  []string               `protobuf:"bytes,5,rep,name=denied_destinations,json=deniedDestinations,proto3" json:"denied_destinations,omitempty"`
+	AllowedMethods      []string               `protobuf:"bytes,6,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
+	Quorum              *QuorumRule            `protobuf:"bytes,7,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	AllowDelegateCall   bool                   `protobuf:"varint,8,opt,name=allow_delegate_call,json=allowDelegateCall,proto3" json:"allow_delegate_call,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PolicyRules) Reset() {
+	*x = PolicyRules{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyRules) ProtoMessage() {}
+
+func (x *PolicyRules) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyRules.ProtoReflect.Descriptor instead.
+func (*PolicyRules) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PolicyRules) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *PolicyRules) GetWindowValue() string {
+	if x != nil {
+		return x.WindowValue
+	}
+	return ""
+}
+
+func (x *PolicyRules) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *PolicyRules) GetAllowedDestinations() []string {
+	if x != nil {
+		return x.AllowedDestinations
+	}
+	return nil
+}
+
+func (x *PolicyRules) GetDeniedDestinations() []string {
+	if x != nil {
+		return x.DeniedDestinations
+	}
+	return nil
+}
+
+func (x *PolicyRules) GetAllowedMethods() []string {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+func (x *PolicyRules) GetQuorum() *QuorumRule {
+	if x != nil {
+		return x.Quorum
+	}
+	return nil
+}
+
+func (x *PolicyRules) GetAllowDelegateCall() bool {
+	if x != nil {
+		return x.AllowDelegateCall
+	}
+	return false
+}
+
+type QuorumRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Required      int32                  `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Approvers     []string               `protobuf:"bytes,2,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Ttl           string                 `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	Broadcast     bool                   `protobuf:"varint,4,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuorumRule) Reset() {
+	*x = QuorumRule{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuorumRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuorumRule) ProtoMessage() {}
+
+func (x *QuorumRule) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuorumRule.ProtoReflect.Descriptor instead.
+func (*QuorumRule) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *QuorumRule) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *QuorumRule) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *QuorumRule) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *QuorumRule) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+type Policy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId      string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	WalletId      string                 `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Networks      []string               `protobuf:"bytes,5,rep,name=networks,proto3" json:"networks,omitempty"`
+	Rules         *PolicyRules           `protobuf:"bytes,6,opt,name=rules,proto3" json:"rules,omitempty"`
+	CreatedAtUnix int64                  `protobuf:"varint,7,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	UpdatedAtUnix int64                  `protobuf:"varint,8,opt,name=updated_at_unix,json=updatedAtUnix,proto3" json:"updated_at_unix,omitempty"`
+	Expression    string                 `protobuf:"bytes,9,opt,name=expression,proto3" json:"expression,omitempty"`
+	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Policy) Reset() {
+	*x = Policy{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Policy) ProtoMessage() {}
+
+func (x *Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Policy.ProtoReflect.Descriptor instead.
+func (*Policy) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *Policy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Policy) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Policy) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *Policy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Policy) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *Policy) GetRules() *PolicyRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Policy) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *Policy) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+func (x *Policy) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Policy) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPolicyRequest) Reset() {
+	*x = GetPolicyRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPolicyRequest) ProtoMessage() {}
+
+func (x *GetPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetPolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type ListPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      string                 `protobuf:"bytes,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesRequest) Reset() {
+	*x = ListPoliciesRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesRequest) ProtoMessage() {}
+
+func (x *ListPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListPoliciesRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+type ListPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPoliciesResponse) Reset() {
+	*x = ListPoliciesResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoliciesResponse) ProtoMessage() {}
+
+func (x *ListPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type DeletePolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePolicyRequest) Reset() {
+	*x = DeletePolicyRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyRequest) ProtoMessage() {}
+
+func (x *DeletePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *DeletePolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+type DeletePolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePolicyResponse) Reset() {
+	*x = DeletePolicyResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePolicyResponse) ProtoMessage() {}
+
+func (x *DeletePolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeletePolicyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{91}
+}
+
+// DryRunPolicyRequest evaluates transaction against the stored policy_id, at
+// version or the latest, or against the unsaved candidate policy.
+type DryRunPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Policy        *Policy                `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	WalletId      string                 `protobuf:"bytes,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Transaction   *Transaction           `protobuf:"bytes,5,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DryRunPolicyRequest) Reset() {
+	*x = DryRunPolicyRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunPolicyRequest) ProtoMessage() {}
+
+func (x *DryRunPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DryRunPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DryRunPolicyRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *DryRunPolicyRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DryRunPolicyRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *DryRunPolicyRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *DryRunPolicyRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type DryRunPolicyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId         string                 `protobuf:"bytes,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version          int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Allowed          bool                   `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Rule             string                 `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequiresApproval bool                   `protobuf:"varint,6,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DryRunPolicyResponse) Reset() {
+	*x = DryRunPolicyResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DryRunPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DryRunPolicyResponse) ProtoMessage() {}
+
+func (x *DryRunPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DryRunPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DryRunPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DryRunPolicyResponse) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *DryRunPolicyResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DryRunPolicyResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *DryRunPolicyResponse) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *DryRunPolicyResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DryRunPolicyResponse) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+type ApprovalDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Actor         string                 `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Approved      bool                   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	AtUnix        int64                  `protobuf:"varint,4,opt,name=at_unix,json=atUnix,proto3" json:"at_unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovalDecision) Reset() {
+	*x = ApprovalDecision{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalDecision) ProtoMessage() {}
+
+func (x *ApprovalDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalDecision.ProtoReflect.Descriptor instead.
+func (*ApprovalDecision) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ApprovalDecision) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ApprovalDecision) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ApprovalDecision) GetAtUnix() int64 {
+	if x != nil {
+		return x.AtUnix
+	}
+	return 0
+}
+
+type ApprovalRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId          string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	WalletId          string                 `protobuf:"bytes,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	PolicyId          string                 `protobuf:"bytes,4,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Requester         string                 `protobuf:"bytes,5,opt,name=requester,proto3" json:"requester,omitempty"`
+	Transaction       *Transaction           `protobuf:"bytes,6,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Required          int32                  `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Approvers         []string               `protobuf:"bytes,8,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Broadcast         bool                   `protobuf:"varint,9,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Decisions         []*ApprovalDecision    `protobuf:"bytes,10,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	Status            string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	SignedTransaction string                 `protobuf:"bytes,12,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	TxHash            string                 `protobuf:"bytes,13,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BroadcastError    string                 `protobuf:"bytes,14,opt,name=broadcast_error,json=broadcastError,proto3" json:"broadcast_error,omitempty"`
+	CreatedAtUnix     int64                  `protobuf:"varint,15,opt,name=created_at_unix,json=createdAtUnix,proto3" json:"created_at_unix,omitempty"`
+	UpdatedAtUnix     int64                  `protobuf:"varint,16,opt,name=updated_at_unix,json=updatedAtUnix,proto3" json:"updated_at_unix,omitempty"`
+	ExpiresAtUnix     int64                  `protobuf:"varint,17,opt,name=expires_at_unix,json=expiresAtUnix,proto3" json:"expires_at_unix,omitempty"`
+	Error             string                 `protobuf:"bytes,18,opt,name=error,proto3" json:"error,omitempty"`
+	// Set for queued user operations, which are signed into
+	// user_operation_result once approved.
+	UserOperation       *SignUserOperationRequest  `protobuf:"bytes,19,opt,name=user_operation,json=userOperation,proto3" json:"user_operation,omitempty"`
+	UserOperationResult *SignUserOperationResponse `protobuf:"bytes,20,opt,name=user_operation_result,json=userOperationResult,proto3" json:"user_operation_result,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ApprovalRequest) Reset() {
+	*x = ApprovalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalRequest) ProtoMessage() {}
+
+func (x *ApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,54 +6828,173 @@ func (x *Balance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
-func (*Balance) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ApprovalRequest.ProtoReflect.Descriptor instead.
+func (*ApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *Balance) GetAsset() string {
+func (x *ApprovalRequest) GetId() string {
 	if x != nil {
-		return x.Asset
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Balance) GetAmount() string {
+func (x *ApprovalRequest) GetTenantId() string {
 	if x != nil {
-		return x.Amount
+		return x.TenantId
 	}
 	return ""
 }
 
-type Transaction struct {
+func (x *ApprovalRequest) GetWalletId() string {
+	if x != nil {
+		return x.WalletId
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetRequired() int32 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetApprovers() []string {
+	if x != nil {
+		return x.Approvers
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetBroadcast() bool {
+	if x != nil {
+		return x.Broadcast
+	}
+	return false
+}
+
+func (x *ApprovalRequest) GetDecisions() []*ApprovalDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetSignedTransaction() string {
+	if x != nil {
+		return x.SignedTransaction
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetBroadcastError() string {
+	if x != nil {
+		return x.BroadcastError
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetCreatedAtUnix() int64 {
+	if x != nil {
+		return x.CreatedAtUnix
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetUpdatedAtUnix() int64 {
+	if x != nil {
+		return x.UpdatedAtUnix
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetExpiresAtUnix() int64 {
+	if x != nil {
+		return x.ExpiresAtUnix
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ApprovalRequest) GetUserOperation() *SignUserOperationRequest {
+	if x != nil {
+		return x.UserOperation
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetUserOperationResult() *SignUserOperationResponse {
+	if x != nil {
+		return x.UserOperationResult
+	}
+	return nil
+}
+
+type ListApprovalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ChainId       int64                  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Data          string                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
-	GasLimit      uint64                 `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	GasPrice      string                 `protobuf:"bytes,7,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	Nonce         uint64                 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[12]
+func (x *ListApprovalsRequest) Reset() {
+	*x = ListApprovalsRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *ListApprovalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*ListApprovalsRequest) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_grpc_wallet_proto_msgTypes[12]
+func (x *ListApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,81 +7005,193 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *Transaction) GetChainId() int64 {
+func (x *ListApprovalsRequest) GetStatus() string {
 	if x != nil {
-		return x.ChainId
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *Transaction) GetFrom() string {
+type ListApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*ApprovalRequest     `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApprovalsResponse) Reset() {
+	*x = ListApprovalsResponse{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApprovalsResponse) ProtoMessage() {}
+
+func (x *ListApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[97]
 	if x != nil {
-		return x.From
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetTo() string {
+// Deprecated: Use ListApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListApprovalsResponse) GetApprovals() []*ApprovalRequest {
 	if x != nil {
-		return x.To
+		return x.Approvals
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction) GetValue() string {
+type GetApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetApprovalRequest) Reset() {
+	*x = GetApprovalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApprovalRequest) ProtoMessage() {}
+
+func (x *GetApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[98]
 	if x != nil {
-		return x.Value
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetData() string {
+// Deprecated: Use GetApprovalRequest.ProtoReflect.Descriptor instead.
+func (*GetApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetApprovalRequest) GetApprovalId() string {
 	if x != nil {
-		return x.Data
+		return x.ApprovalId
 	}
 	return ""
 }
 
-func (x *Transaction) GetGasLimit() uint64 {
+type DecideApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecideApprovalRequest) Reset() {
+	*x = DecideApprovalRequest{}
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecideApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideApprovalRequest) ProtoMessage() {}
+
+func (x *DecideApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_grpc_wallet_proto_msgTypes[99]
 	if x != nil {
-		return x.GasLimit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Transaction) GetGasPrice() string {
+// Deprecated: Use DecideApprovalRequest.ProtoReflect.Descriptor instead.
+func (*DecideApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_grpc_wallet_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DecideApprovalRequest) GetApprovalId() string {
 	if x != nil {
-		return x.GasPrice
+		return x.ApprovalId
 	}
 	return ""
 }
 
-func (x *Transaction) GetNonce() uint64 {
+func (x *DecideApprovalRequest) GetComment() string {
 	if x != nil {
-		return x.Nonce
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
 var File_internal_api_grpc_wallet_proto protoreflect.FileDescriptor
 
 const file_internal_api_grpc_wallet_proto_rawDesc = "" +
 	"\n" +
-	"\x1einternal/api/grpc/wallet.proto\x12\twallet.v1\"/\n" +
+	"\x1einternal/api/grpc/wallet.proto\x12\twallet.v1\"\xa3\x01\n" +
 	"\x13CreateWalletRequest\x12\x18\n" +
-	"\anetwork\x18\x01 \x01(\tR\anetwork\"\x9b\x01\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12!\n" +
+	"\faccount_type\x18\x02 \x01(\tR\vaccountType\x12\x18\n" +
+	"\afactory\x18\x03 \x01(\tR\afactory\x12\x12\n" +
+	"\x04salt\x18\x04 \x01(\tR\x04salt\x12!\n" +
+	"\faddress_type\x18\x05 \x01(\tR\vaddressType\"\x8f\x01\n" +
+	"\fSmartAccount\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x18\n" +
+	"\afactory\x18\x02 \x01(\tR\afactory\x12\x12\n" +
+	"\x04salt\x18\x03 \x01(\tR\x04salt\x12\x1f\n" +
+	"\ventry_point\x18\x04 \x01(\tR\n" +
+	"entryPoint\x12\x1a\n" +
+	"\bdeployed\x18\x05 \x01(\bR\bdeployed\"\xf3\x03\n" +
 	"\x0eWalletResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\anetwork\x18\x02 \x01(\tR\anetwork\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x04 \x01(\tR\tpublicKey\x12&\n" +
-	"\x0fcreated_at_unix\x18\x05 \x01(\x03R\rcreatedAtUnix\"/\n" +
+	"\x0fcreated_at_unix\x18\x05 \x01(\x03R\rcreatedAtUnix\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12&\n" +
+	"\x0fupdated_at_unix\x18\a \x01(\x03R\rupdatedAtUnix\x12*\n" +
+	"\x11delete_after_unix\x18\b \x01(\x03R\x0fdeleteAfterUnix\x121\n" +
+	"\x15key_destroyed_at_unix\x18\t \x01(\x03R\x12keyDestroyedAtUnix\x12\x1b\n" +
+	"\ttenant_id\x18\n" +
+	" \x01(\tR\btenantId\x12!\n" +
+	"\faccount_type\x18\v \x01(\tR\vaccountType\x12<\n" +
+	"\rsmart_account\x18\f \x01(\v2\x17.wallet.v1.SmartAccountR\fsmartAccount\x12\x16\n" +
+	"\x06family\x18\r \x01(\tR\x06family\x12!\n" +
+	"\faddress_type\x18\x0e \x01(\tR\vaddressType\"/\n" +
 	"\x10GetWalletRequest\x12\x1b\n" +
 	"\twallet_id\x18\x01 \x01(\tR\bwalletId\".\n" +
 	"\x12ListWalletsRequest\x12\x18\n" +
@@ -739,19 +7204,298 @@ const file_internal_api_grpc_wallet_proto_rawDesc = "" +
 	"\x13SignMessageResponse\x12\x1c\n" +
 	"\tsignature\x18\x01 \x01(\tR\tsignature\x12\x1d\n" +
 	"\n" +
-	"public_key\x18\x02 \x01(\tR\tpublicKey\"o\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\"\x85\x01\n" +
 	"\x16SignTransactionRequest\x12\x1b\n" +
 	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x128\n" +
-	"\vtransaction\x18\x02 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\"H\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"s\n" +
+	"\x1aSimulateTransactionRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x128\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\"\xcc\x01\n" +
+	"\x10SimulationResult\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rrevert_reason\x18\x02 \x01(\tR\frevertReason\x12\x1f\n" +
+	"\vrevert_data\x18\x03 \x01(\tR\n" +
+	"revertData\x12\x1f\n" +
+	"\vreturn_data\x18\x04 \x01(\tR\n" +
+	"returnData\x12!\n" +
+	"\fgas_estimate\x18\x05 \x01(\x04R\vgasEstimate\x12\x14\n" +
+	"\x05trace\x18\x06 \x01(\tR\x05trace\"\x80\x01\n" +
 	"\x17SignTransactionResponse\x12-\n" +
-	"\x12signed_transaction\x18\x01 \x01(\tR\x11signedTransaction\"0\n" +
+	"\x12signed_transaction\x18\x01 \x01(\tR\x11signedTransaction\x126\n" +
+	"\bapproval\x18\x02 \x01(\v2\x1a.wallet.v1.ApprovalRequestR\bapproval\"\xb7\x02\n" +
+	"\x13ContractCallRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\tR\bcontract\x12\x10\n" +
+	"\x03abi\x18\x03 \x01(\tR\x03abi\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x12\n" +
+	"\x04args\x18\x05 \x03(\tR\x04args\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x19\n" +
+	"\bchain_id\x18\a \x01(\x03R\achainId\x12\x14\n" +
+	"\x05value\x18\b \x01(\tR\x05value\x12\x1b\n" +
+	"\tgas_limit\x18\t \x01(\x04R\bgasLimit\x12\x1b\n" +
+	"\tgas_price\x18\n" +
+	" \x01(\tR\bgasPrice\x12\x14\n" +
+	"\x05nonce\x18\v \x01(\x04R\x05nonce\x12\x14\n" +
+	"\x05force\x18\f \x01(\bR\x05force\"N\n" +
+	"\x0eContractOutput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"\xc6\x01\n" +
+	"\x14ContractCallResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12-\n" +
+	"\x12signed_transaction\x18\x02 \x01(\tR\x11signedTransaction\x123\n" +
+	"\aoutputs\x18\x03 \x03(\v2\x19.wallet.v1.ContractOutputR\aoutputs\x126\n" +
+	"\bapproval\x18\x04 \x01(\v2\x1a.wallet.v1.ApprovalRequestR\bapproval\"\x94\x01\n" +
+	"\x0eTokenTxOptions\x12\x1b\n" +
+	"\tgas_limit\x18\x01 \x01(\x04R\bgasLimit\x12\x1b\n" +
+	"\tgas_price\x18\x02 \x01(\tR\bgasPrice\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\x12\x1c\n" +
+	"\tbroadcast\x18\x04 \x01(\bR\tbroadcast\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"\xa6\x01\n" +
+	"\x14TransferTokenRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x123\n" +
+	"\aoptions\x18\x05 \x01(\v2\x19.wallet.v1.TokenTxOptionsR\aoptions\"\xaf\x01\n" +
+	"\x13ApproveTokenRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x18\n" +
+	"\aspender\x18\x03 \x01(\tR\aspender\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x123\n" +
+	"\aoptions\x18\x05 \x01(\v2\x19.wallet.v1.TokenTxOptionsR\aoptions\"U\n" +
+	"\x05Token\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bdecimals\x18\x03 \x01(\rR\bdecimals\"\xb4\x02\n" +
+	"\x0fTokenTxResponse\x12&\n" +
+	"\x05token\x18\x01 \x01(\v2\x10.wallet.v1.TokenR\x05token\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x128\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\x12-\n" +
+	"\x12signed_transaction\x18\x04 \x01(\tR\x11signedTransaction\x12\x17\n" +
+	"\atx_hash\x18\x05 \x01(\tR\x06txHash\x12'\n" +
+	"\x0fbroadcast_error\x18\x06 \x01(\tR\x0ebroadcastError\x126\n" +
+	"\bapproval\x18\a \x01(\v2\x1a.wallet.v1.ApprovalRequestR\bapproval\"\xc6\x01\n" +
+	"\x11SignPermitRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x18\n" +
+	"\aspender\x18\x04 \x01(\tR\aspender\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\x12\x1a\n" +
+	"\bdeadline\x18\x06 \x01(\x03R\bdeadline\x12\x1e\n" +
+	"\n" +
+	"expiration\x18\a \x01(\x03R\n" +
+	"expiration\"\xd5\x01\n" +
+	"\x12SignPermitResponse\x12&\n" +
+	"\x05token\x18\x01 \x01(\v2\x10.wallet.v1.TokenR\x05token\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1a\n" +
+	"\bdeadline\x18\x03 \x01(\x03R\bdeadline\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\x12\f\n" +
+	"\x01v\x18\x05 \x01(\rR\x01v\x12\f\n" +
+	"\x01r\x18\x06 \x01(\tR\x01r\x12\f\n" +
+	"\x01s\x18\a \x01(\tR\x01s\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\b \x01(\tR\ttypedData\"\xb9\x05\n" +
+	"\rUserOperation\x12\x16\n" +
+	"\x06sender\x18\x01 \x01(\tR\x06sender\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\tR\x05nonce\x12\x1b\n" +
+	"\tinit_code\x18\x03 \x01(\tR\binitCode\x12\x18\n" +
+	"\afactory\x18\x04 \x01(\tR\afactory\x12!\n" +
+	"\ffactory_data\x18\x05 \x01(\tR\vfactoryData\x12\x1b\n" +
+	"\tcall_data\x18\x06 \x01(\tR\bcallData\x12$\n" +
+	"\x0ecall_gas_limit\x18\a \x01(\tR\fcallGasLimit\x124\n" +
+	"\x16verification_gas_limit\x18\b \x01(\tR\x14verificationGasLimit\x120\n" +
+	"\x14pre_verification_gas\x18\t \x01(\tR\x12preVerificationGas\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\n" +
+	" \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\v \x01(\tR\x14maxPriorityFeePerGas\x12,\n" +
+	"\x12paymaster_and_data\x18\f \x01(\tR\x10paymasterAndData\x12\x1c\n" +
+	"\tpaymaster\x18\r \x01(\tR\tpaymaster\x12G\n" +
+	" paymaster_verification_gas_limit\x18\x0e \x01(\tR\x1dpaymasterVerificationGasLimit\x12<\n" +
+	"\x1bpaymaster_post_op_gas_limit\x18\x0f \x01(\tR\x17paymasterPostOpGasLimit\x12%\n" +
+	"\x0epaymaster_data\x18\x10 \x01(\tR\rpaymasterData\x12\x1c\n" +
+	"\tsignature\x18\x11 \x01(\tR\tsignature\"\xcb\x01\n" +
+	"\x18SignUserOperationRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1f\n" +
+	"\ventry_point\x18\x03 \x01(\tR\n" +
+	"entryPoint\x12?\n" +
+	"\x0euser_operation\x18\x04 \x01(\v2\x18.wallet.v1.UserOperationR\ruserOperation\x12\x16\n" +
+	"\x06submit\x18\x05 \x01(\bR\x06submit\"\xcd\x02\n" +
+	"\x19SignUserOperationResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
+	"\ventry_point\x18\x02 \x01(\tR\n" +
+	"entryPoint\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\x03R\achainId\x12 \n" +
+	"\fuser_op_hash\x18\x04 \x01(\tR\n" +
+	"userOpHash\x12?\n" +
+	"\x0euser_operation\x18\x05 \x01(\v2\x18.wallet.v1.UserOperationR\ruserOperation\x12\x1c\n" +
+	"\tsubmitted\x18\x06 \x01(\bR\tsubmitted\x12!\n" +
+	"\fsubmit_error\x18\a \x01(\tR\vsubmitError\x126\n" +
+	"\bapproval\x18\b \x01(\v2\x1a.wallet.v1.ApprovalRequestR\bapproval\"U\n" +
+	"\x18DecodeTransactionRequest\x12'\n" +
+	"\x0fraw_transaction\x18\x01 \x01(\tR\x0erawTransaction\x12\x10\n" +
+	"\x03abi\x18\x02 \x01(\tR\x03abi\"=\n" +
+	"\x15DecodeCalldataRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x10\n" +
+	"\x03abi\x18\x02 \x01(\tR\x03abi\"\xe4\x03\n" +
+	"\x12DecodedTransaction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x19\n" +
+	"\bchain_id\x18\x05 \x01(\x03R\achainId\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\x04R\x05nonce\x12\x14\n" +
+	"\x05value\x18\a \x01(\tR\x05value\x12\x1b\n" +
+	"\tgas_limit\x18\b \x01(\x04R\bgasLimit\x12\x1b\n" +
+	"\tgas_price\x18\t \x01(\tR\bgasPrice\x12%\n" +
+	"\x0fmax_fee_per_gas\x18\n" +
+	" \x01(\tR\fmaxFeePerGas\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\v \x01(\tR\x14maxPriorityFeePerGas\x12\x12\n" +
+	"\x04data\x18\f \x01(\tR\x04data\x12*\n" +
+	"\x04call\x18\r \x01(\v2\x16.wallet.v1.DecodedCallR\x04call\x12.\n" +
+	"\x14max_fee_per_blob_gas\x18\x0e \x01(\tR\x10maxFeePerBlobGas\x122\n" +
+	"\x15blob_versioned_hashes\x18\x0f \x03(\tR\x13blobVersionedHashes\"\xda\x01\n" +
+	"\vDecodedCall\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1c\n" +
+	"\tstandards\x18\x04 \x03(\tR\tstandards\x12-\n" +
+	"\x04args\x18\x05 \x03(\v2\x19.wallet.v1.ContractOutputR\x04args\x12,\n" +
+	"\x05calls\x18\x06 \x03(\v2\x16.wallet.v1.DecodedCallR\x05calls\"\x17\n" +
+	"\x15IssueSIWENonceRequest\"I\n" +
+	"\tSIWENonce\x12\x14\n" +
+	"\x05nonce\x18\x01 \x01(\tR\x05nonce\x12&\n" +
+	"\x0fexpires_at_unix\x18\x02 \x01(\x03R\rexpiresAtUnix\"\xb5\x02\n" +
+	"\x19SignInWithEthereumRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x10\n" +
+	"\x03uri\x18\x03 \x01(\tR\x03uri\x12\x1c\n" +
+	"\tstatement\x18\x04 \x01(\tR\tstatement\x12\x19\n" +
+	"\bchain_id\x18\x05 \x01(\x03R\achainId\x12\x14\n" +
+	"\x05nonce\x18\x06 \x01(\tR\x05nonce\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\a \x01(\tR\texpiresIn\x12&\n" +
+	"\x0fnot_before_unix\x18\b \x01(\x03R\rnotBeforeUnix\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId\x12\x1c\n" +
+	"\tresources\x18\n" +
+	" \x03(\tR\tresources\"\xf7\x02\n" +
+	"\vSIWEMessage\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tstatement\x18\x03 \x01(\tR\tstatement\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12\x19\n" +
+	"\bchain_id\x18\x06 \x01(\x03R\achainId\x12\x14\n" +
+	"\x05nonce\x18\a \x01(\tR\x05nonce\x12$\n" +
+	"\x0eissued_at_unix\x18\b \x01(\x03R\fissuedAtUnix\x120\n" +
+	"\x14expiration_time_unix\x18\t \x01(\x03R\x12expirationTimeUnix\x12&\n" +
+	"\x0fnot_before_unix\x18\n" +
+	" \x01(\x03R\rnotBeforeUnix\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\v \x01(\tR\trequestId\x12\x1c\n" +
+	"\tresources\x18\f \x03(\tR\tresources\"\x84\x01\n" +
+	"\x1aSignInWithEthereumResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12.\n" +
+	"\x06fields\x18\x03 \x01(\v2\x16.wallet.v1.SIWEMessageR\x06fields\"c\n" +
+	"\x11VerifySIWERequest\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\"\x8c\x01\n" +
+	"\x12VerifySIWEResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12.\n" +
+	"\x06fields\x18\x04 \x01(\v2\x16.wallet.v1.SIWEMessageR\x06fields\"\xb7\x01\n" +
+	"\x16VerifySignatureRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04hash\x18\x04 \x01(\tR\x04hash\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\x05 \x01(\tR\ttypedData\x12\x1c\n" +
+	"\tsignature\x18\x06 \x01(\tR\tsignature\"y\n" +
+	"\x17VerifySignatureResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x9d\x02\n" +
+	"\x0fSafeTransaction\x12\x0e\n" +
+	"\x02to\x18\x01 \x01(\tR\x02to\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\tR\x04data\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\rR\toperation\x12\x1e\n" +
+	"\vsafe_tx_gas\x18\x05 \x01(\tR\tsafeTxGas\x12\x19\n" +
+	"\bbase_gas\x18\x06 \x01(\tR\abaseGas\x12\x1b\n" +
+	"\tgas_price\x18\a \x01(\tR\bgasPrice\x12\x1b\n" +
+	"\tgas_token\x18\b \x01(\tR\bgasToken\x12'\n" +
+	"\x0frefund_receiver\x18\t \x01(\tR\x0erefundReceiver\x12\x14\n" +
+	"\x05nonce\x18\n" +
+	" \x01(\tR\x05nonce\"\x8b\x01\n" +
+	"\x1dProposeSafeTransactionRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04safe\x18\x02 \x01(\tR\x04safe\x12<\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x1a.wallet.v1.SafeTransactionR\vtransaction\"\x86\x01\n" +
+	"\rSafeSignature\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\tR\x05owner\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\x12$\n" +
+	"\x0esigned_at_unix\x18\x04 \x01(\x03R\fsignedAtUnix\"\x8d\x05\n" +
+	"\fSafeProposal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x18\n" +
+	"\anetwork\x18\x03 \x01(\tR\anetwork\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\x03R\achainId\x12\x12\n" +
+	"\x04safe\x18\x05 \x01(\tR\x04safe\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\tR\aversion\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x05R\tthreshold\x12\x16\n" +
+	"\x06owners\x18\b \x03(\tR\x06owners\x12<\n" +
+	"\vtransaction\x18\t \x01(\v2\x1a.wallet.v1.SafeTransactionR\vtransaction\x12 \n" +
+	"\fsafe_tx_hash\x18\n" +
+	" \x01(\tR\n" +
+	"safeTxHash\x12\x1d\n" +
+	"\n" +
+	"typed_data\x18\v \x01(\tR\ttypedData\x128\n" +
+	"\n" +
+	"signatures\x18\f \x03(\v2\x18.wallet.v1.SafeSignatureR\n" +
+	"signatures\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x12%\n" +
+	"\x0eexecution_data\x18\x0e \x01(\tR\rexecutionData\x12-\n" +
+	"\x12signed_transaction\x18\x0f \x01(\tR\x11signedTransaction\x12\x17\n" +
+	"\atx_hash\x18\x10 \x01(\tR\x06txHash\x12'\n" +
+	"\x0fbroadcast_error\x18\x11 \x01(\tR\x0ebroadcastError\x12&\n" +
+	"\x0fcreated_at_unix\x18\x12 \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0fupdated_at_unix\x18\x13 \x01(\x03R\rupdatedAtUnix\"9\n" +
+	"\x16GetSafeProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\"2\n" +
+	"\x18ListSafeProposalsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"R\n" +
+	"\x19ListSafeProposalsResponse\x125\n" +
+	"\tproposals\x18\x01 \x03(\v2\x17.wallet.v1.SafeProposalR\tproposals\"W\n" +
+	"\x17SignSafeProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\"\x8f\x01\n" +
+	"\x1aExecuteSafeProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\tR\bwalletId\x123\n" +
+	"\aoptions\x18\x03 \x01(\v2\x19.wallet.v1.TokenTxOptionsR\aoptions\"\x8a\x01\n" +
+	"\x1bExecuteSafeProposalResponse\x123\n" +
+	"\bproposal\x18\x01 \x01(\v2\x17.wallet.v1.SafeProposalR\bproposal\x126\n" +
+	"\bapproval\x18\x02 \x01(\v2\x1a.wallet.v1.ApprovalRequestR\bapproval\"F\n" +
 	"\x11GetBalanceRequest\x12\x1b\n" +
-	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"B\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"B\n" +
 	"\x12GetBalanceResponse\x12,\n" +
 	"\abalance\x18\x01 \x01(\v2\x12.wallet.v1.BalanceR\abalance\"7\n" +
 	"\aBalance\x12\x14\n" +
 	"\x05asset\x18\x01 \x01(\tR\x05asset\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\tR\x06amount\"\xc6\x01\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"\x8d\x03\n" +
 	"\vTransaction\x12\x19\n" +
 	"\bchain_id\x18\x01 \x01(\x03R\achainId\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
@@ -760,15 +7504,303 @@ const file_internal_api_grpc_wallet_proto_rawDesc = "" +
 	"\x04data\x18\x05 \x01(\tR\x04data\x12\x1b\n" +
 	"\tgas_limit\x18\x06 \x01(\x04R\bgasLimit\x12\x1b\n" +
 	"\tgas_price\x18\a \x01(\tR\bgasPrice\x12\x14\n" +
-	"\x05nonce\x18\b \x01(\x04R\x05nonce2\xe0\x03\n" +
+	"\x05nonce\x18\b \x01(\x04R\x05nonce\x126\n" +
+	"\x18max_priority_fee_per_gas\x18\t \x01(\tR\x14maxPriorityFeePerGas\x12G\n" +
+	"\x12authorization_list\x18\n" +
+	" \x03(\v2\x18.wallet.v1.AuthorizationR\x11authorizationList\x12.\n" +
+	"\x14max_fee_per_blob_gas\x18\v \x01(\tR\x10maxFeePerBlobGas\x12\x14\n" +
+	"\x05blobs\x18\f \x03(\tR\x05blobs\"\x91\x01\n" +
+	"\rAuthorization\x12\x19\n" +
+	"\bchain_id\x18\x01 \x01(\x03R\achainId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05nonce\x18\x03 \x01(\x04R\x05nonce\x12\x19\n" +
+	"\by_parity\x18\x04 \x01(\rR\ayParity\x12\f\n" +
+	"\x01r\x18\x05 \x01(\tR\x01r\x12\f\n" +
+	"\x01s\x18\x06 \x01(\tR\x01s\"\x91\x01\n" +
+	"\x18SignAuthorizationRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x19\n" +
+	"\bchain_id\x18\x03 \x01(\x03R\achainId\x12\x19\n" +
+	"\x05nonce\x18\x04 \x01(\x04H\x00R\x05nonce\x88\x01\x01B\b\n" +
+	"\x06_nonce\"a\n" +
+	" SignSerializedTransactionRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12 \n" +
+	"\vtransaction\x18\x02 \x01(\tR\vtransaction\"w\n" +
+	"!SignSerializedTransactionResponse\x12 \n" +
+	"\vtransaction\x18\x01 \x01(\tR\vtransaction\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x12\n" +
+	"\x04txid\x18\x03 \x01(\tR\x04txid\"B\n" +
+	"\x0fSignPSBTRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x12\n" +
+	"\x04psbt\x18\x02 \x01(\tR\x04psbt\"\x9d\x01\n" +
+	"\x10SignPSBTResponse\x12\x12\n" +
+	"\x04psbt\x18\x01 \x01(\tR\x04psbt\x12\x12\n" +
+	"\x04txid\x18\x02 \x01(\tR\x04txid\x12#\n" +
+	"\rsigned_inputs\x18\x03 \x03(\x05R\fsignedInputs\x12\x1a\n" +
+	"\bcomplete\x18\x04 \x01(\bR\bcomplete\x12 \n" +
+	"\vtransaction\x18\x05 \x01(\tR\vtransaction\"3\n" +
+	"\x14DisableWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"2\n" +
+	"\x13EnableWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"3\n" +
+	"\x14ArchiveWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"2\n" +
+	"\x13DeleteWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"\xfe\x02\n" +
+	"\n" +
+	"AuditEntry\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12.\n" +
+	"\x13timestamp_unix_nano\x18\x02 \x01(\x03R\x11timestampUnixNano\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12\x1b\n" +
+	"\twallet_id\x18\x04 \x01(\tR\bwalletId\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12!\n" +
+	"\fpayload_hash\x18\x06 \x01(\tR\vpayloadHash\x12\x16\n" +
+	"\x06result\x18\a \x01(\tR\x06result\x12\x18\n" +
+	"\aoutcome\x18\b \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x12\x1b\n" +
+	"\tprev_hash\x18\n" +
+	" \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\v \x01(\tR\x04hash\x12\x1b\n" +
+	"\ttenant_id\x18\f \x01(\tR\btenantId\x12\x1a\n" +
+	"\bdelegate\x18\r \x01(\tR\bdelegate\"\xbe\x01\n" +
+	"\x17ListAuditEntriesRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\x12\x14\n" +
+	"\x05actor\x18\x02 \x01(\tR\x05actor\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x1d\n" +
+	"\n" +
+	"since_unix\x18\x04 \x01(\x03R\tsinceUnix\x12\x1d\n" +
+	"\n" +
+	"until_unix\x18\x05 \x01(\x03R\tuntilUnix\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"K\n" +
+	"\x18ListAuditEntriesResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.wallet.v1.AuditEntryR\aentries\"\x17\n" +
+	"\x15VerifyAuditLogRequest\"\xa2\x01\n" +
+	"\x16VerifyAuditLogResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\x03R\aentries\x12\x1b\n" +
+	"\thead_hash\x18\x03 \x01(\tR\bheadHash\x12#\n" +
+	"\rfirst_invalid\x18\x04 \x01(\x04R\ffirstInvalid\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xb1\x01\n" +
+	"\x06APIKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12&\n" +
+	"\x0fcreated_at_unix\x18\x04 \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0frevoked_at_unix\x18\x05 \x01(\x03R\rrevokedAtUnix\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\"^\n" +
+	"\x13CreateAPIKeyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1b\n" +
+	"\ttenant_id\x18\x03 \x01(\tR\btenantId\"Q\n" +
+	"\x14CreateAPIKeyResponse\x12#\n" +
+	"\x03key\x18\x01 \x01(\v2\x11.wallet.v1.APIKeyR\x03key\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x14\n" +
+	"\x12ListAPIKeysRequest\"<\n" +
+	"\x13ListAPIKeysResponse\x12%\n" +
+	"\x04keys\x18\x01 \x03(\v2\x11.wallet.v1.APIKeyR\x04keys\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"\xc8\x01\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10allowed_networks\x18\x03 \x03(\tR\x0fallowedNetworks\x12\x1f\n" +
+	"\vmax_wallets\x18\x04 \x01(\x05R\n" +
+	"maxWallets\x12&\n" +
+	"\x0fcreated_at_unix\x18\x05 \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0fupdated_at_unix\x18\x06 \x01(\x03R\rupdatedAtUnix\"/\n" +
+	"\x10GetTenantRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x14\n" +
+	"\x12ListTenantsRequest\"B\n" +
+	"\x13ListTenantsResponse\x12+\n" +
+	"\atenants\x18\x01 \x03(\v2\x11.wallet.v1.TenantR\atenants\"\xf5\x03\n" +
+	"\x11AddNetworkRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"short_name\x18\x03 \x01(\tR\tshortName\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\x03R\achainId\x12\x10\n" +
+	"\x03rpc\x18\x05 \x03(\tR\x03rpc\x12B\n" +
+	"\x0fnative_currency\x18\x06 \x01(\v2\x19.wallet.v1.NativeCurrencyR\x0enativeCurrency\x121\n" +
+	"\texplorers\x18\a \x03(\v2\x13.wallet.v1.ExplorerR\texplorers\x12\x16\n" +
+	"\x06family\x18\b \x01(\tR\x06family\x12&\n" +
+	"\x0fcosmos_chain_id\x18\t \x01(\tR\rcosmosChainId\x12#\n" +
+	"\rbech32_prefix\x18\n" +
+	" \x01(\tR\fbech32Prefix\x12\x1f\n" +
+	"\vbundler_url\x18\v \x01(\tR\n" +
+	"bundlerUrl\x12(\n" +
+	"\x06tokens\x18\f \x03(\v2\x10.wallet.v1.TokenR\x06tokens\x12\x1a\n" +
+	"\bdisabled\x18\r \x01(\bR\bdisabled\x12#\n" +
+	"\rbitcoin_chain\x18\x0e \x01(\tR\fbitcoinChain\"X\n" +
+	"\x0eNativeCurrency\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x02 \x01(\tR\x06symbol\x12\x1a\n" +
+	"\bdecimals\x18\x03 \x01(\rR\bdecimals\"L\n" +
+	"\bExplorer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bstandard\x18\x03 \x01(\tR\bstandard\"\xec\x01\n" +
+	"\vNetworkInfo\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06family\x18\x03 \x01(\tR\x06family\x12\x19\n" +
+	"\bchain_id\x18\x04 \x01(\x03R\achainId\x12!\n" +
+	"\fnative_asset\x18\x05 \x01(\tR\vnativeAsset\x12\x1a\n" +
+	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12!\n" +
+	"\fexplorer_url\x18\a \x01(\tR\vexplorerUrl\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\"*\n" +
+	"\x0eNetworkRequest\x12\x18\n" +
+	"\anetwork\x18\x01 \x01(\tR\anetwork\"\x15\n" +
+	"\x13ListNetworksRequest\"J\n" +
+	"\x14ListNetworksResponse\x122\n" +
+	"\bnetworks\x18\x01 \x03(\v2\x16.wallet.v1.NetworkInfoR\bnetworks\"\xd1\x02\n" +
+	"\vPolicyRules\x12\x1b\n" +
+	"\tmax_value\x18\x01 \x01(\tR\bmaxValue\x12!\n" +
+	"\fwindow_value\x18\x02 \x01(\tR\vwindowValue\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\tR\x06window\x121\n" +
+	"\x14allowed_destinations\x18\x04 \x03(\tR\x13allowedDestinations\x12/\n" +
+	"\x13denied_destinations\x18\x05 \x03(\tR\x12deniedDestinations\x12'\n" +
+	"\x0fallowed_methods\x18\x06 \x03(\tR\x0eallowedMethods\x12-\n" +
+	"\x06quorum\x18\a \x01(\v2\x15.wallet.v1.QuorumRuleR\x06quorum\x12.\n" +
+	"\x13allow_delegate_call\x18\b \x01(\bR\x11allowDelegateCall\"v\n" +
+	"\n" +
+	"QuorumRule\x12\x1a\n" +
+	"\brequired\x18\x01 \x01(\x05R\brequired\x12\x1c\n" +
+	"\tapprovers\x18\x02 \x03(\tR\tapprovers\x12\x10\n" +
+	"\x03ttl\x18\x03 \x01(\tR\x03ttl\x12\x1c\n" +
+	"\tbroadcast\x18\x04 \x01(\bR\tbroadcast\"\xba\x02\n" +
+	"\x06Policy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
+	"\twallet_id\x18\x03 \x01(\tR\bwalletId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bnetworks\x18\x05 \x03(\tR\bnetworks\x12,\n" +
+	"\x05rules\x18\x06 \x01(\v2\x16.wallet.v1.PolicyRulesR\x05rules\x12&\n" +
+	"\x0fcreated_at_unix\x18\a \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0fupdated_at_unix\x18\b \x01(\x03R\rupdatedAtUnix\x12\x1e\n" +
+	"\n" +
+	"expression\x18\t \x01(\tR\n" +
+	"expression\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\"/\n" +
+	"\x10GetPolicyRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\"2\n" +
+	"\x13ListPoliciesRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\tR\bwalletId\"E\n" +
+	"\x14ListPoliciesResponse\x12-\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x11.wallet.v1.PolicyR\bpolicies\"2\n" +
+	"\x13DeletePolicyRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\"\x16\n" +
+	"\x14DeletePolicyResponse\"\xce\x01\n" +
+	"\x13DryRunPolicyRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12)\n" +
+	"\x06policy\x18\x03 \x01(\v2\x11.wallet.v1.PolicyR\x06policy\x12\x1b\n" +
+	"\twallet_id\x18\x04 \x01(\tR\bwalletId\x128\n" +
+	"\vtransaction\x18\x05 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\"\xc0\x01\n" +
+	"\x14DryRunPolicyResponse\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\tR\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x18\n" +
+	"\aallowed\x18\x03 \x01(\bR\aallowed\x12\x12\n" +
+	"\x04rule\x18\x04 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12+\n" +
+	"\x11requires_approval\x18\x06 \x01(\bR\x10requiresApproval\"w\n" +
+	"\x10ApprovalDecision\x12\x14\n" +
+	"\x05actor\x18\x01 \x01(\tR\x05actor\x12\x1a\n" +
+	"\bapproved\x18\x02 \x01(\bR\bapproved\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x17\n" +
+	"\aat_unix\x18\x04 \x01(\x03R\x06atUnix\"\xa0\x06\n" +
+	"\x0fApprovalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x1b\n" +
+	"\twallet_id\x18\x03 \x01(\tR\bwalletId\x12\x1b\n" +
+	"\tpolicy_id\x18\x04 \x01(\tR\bpolicyId\x12\x1c\n" +
+	"\trequester\x18\x05 \x01(\tR\trequester\x128\n" +
+	"\vtransaction\x18\x06 \x01(\v2\x16.wallet.v1.TransactionR\vtransaction\x12\x1a\n" +
+	"\brequired\x18\a \x01(\x05R\brequired\x12\x1c\n" +
+	"\tapprovers\x18\b \x03(\tR\tapprovers\x12\x1c\n" +
+	"\tbroadcast\x18\t \x01(\bR\tbroadcast\x129\n" +
+	"\tdecisions\x18\n" +
+	" \x03(\v2\x1b.wallet.v1.ApprovalDecisionR\tdecisions\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12-\n" +
+	"\x12signed_transaction\x18\f \x01(\tR\x11signedTransaction\x12\x17\n" +
+	"\atx_hash\x18\r \x01(\tR\x06txHash\x12'\n" +
+	"\x0fbroadcast_error\x18\x0e \x01(\tR\x0ebroadcastError\x12&\n" +
+	"\x0fcreated_at_unix\x18\x0f \x01(\x03R\rcreatedAtUnix\x12&\n" +
+	"\x0fupdated_at_unix\x18\x10 \x01(\x03R\rupdatedAtUnix\x12&\n" +
+	"\x0fexpires_at_unix\x18\x11 \x01(\x03R\rexpiresAtUnix\x12\x14\n" +
+	"\x05error\x18\x12 \x01(\tR\x05error\x12J\n" +
+	"\x0euser_operation\x18\x13 \x01(\v2#.wallet.v1.SignUserOperationRequestR\ruserOperation\x12X\n" +
+	"\x15user_operation_result\x18\x14 \x01(\v2$.wallet.v1.SignUserOperationResponseR\x13userOperationResult\".\n" +
+	"\x14ListApprovalsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"Q\n" +
+	"\x15ListApprovalsResponse\x128\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x1a.wallet.v1.ApprovalRequestR\tapprovals\"5\n" +
+	"\x12GetApprovalRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\"R\n" +
+	"\x15DecideApprovalRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment2\xa4!\n" +
 	"\rWalletService\x12I\n" +
 	"\fCreateWallet\x12\x1e.wallet.v1.CreateWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12C\n" +
 	"\tGetWallet\x12\x1b.wallet.v1.GetWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12L\n" +
 	"\vListWallets\x12\x1d.wallet.v1.ListWalletsRequest\x1a\x1e.wallet.v1.ListWalletsResponse\x12L\n" +
 	"\vSignMessage\x12\x1d.wallet.v1.SignMessageRequest\x1a\x1e.wallet.v1.SignMessageResponse\x12X\n" +
-	"\x0fSignTransaction\x12!.wallet.v1.SignTransactionRequest\x1a\".wallet.v1.SignTransactionResponse\x12I\n" +
+	"\x0fSignTransaction\x12!.wallet.v1.SignTransactionRequest\x1a\".wallet.v1.SignTransactionResponse\x12v\n" +
+	"\x19SignSerializedTransaction\x12+.wallet.v1.SignSerializedTransactionRequest\x1a,.wallet.v1.SignSerializedTransactionResponse\x12C\n" +
+	"\bSignPSBT\x12\x1a.wallet.v1.SignPSBTRequest\x1a\x1b.wallet.v1.SignPSBTResponse\x12Y\n" +
+	"\x13SimulateTransaction\x12%.wallet.v1.SimulateTransactionRequest\x1a\x1b.wallet.v1.SimulationResult\x12O\n" +
+	"\fContractCall\x12\x1e.wallet.v1.ContractCallRequest\x1a\x1f.wallet.v1.ContractCallResponse\x12L\n" +
+	"\rTransferToken\x12\x1f.wallet.v1.TransferTokenRequest\x1a\x1a.wallet.v1.TokenTxResponse\x12J\n" +
+	"\fApproveToken\x12\x1e.wallet.v1.ApproveTokenRequest\x1a\x1a.wallet.v1.TokenTxResponse\x12I\n" +
+	"\n" +
+	"SignPermit\x12\x1c.wallet.v1.SignPermitRequest\x1a\x1d.wallet.v1.SignPermitResponse\x12^\n" +
+	"\x11SignUserOperation\x12#.wallet.v1.SignUserOperationRequest\x1a$.wallet.v1.SignUserOperationResponse\x12R\n" +
+	"\x11SignAuthorization\x12#.wallet.v1.SignAuthorizationRequest\x1a\x18.wallet.v1.Authorization\x12[\n" +
+	"\x16ProposeSafeTransaction\x12(.wallet.v1.ProposeSafeTransactionRequest\x1a\x17.wallet.v1.SafeProposal\x12M\n" +
+	"\x0fGetSafeProposal\x12!.wallet.v1.GetSafeProposalRequest\x1a\x17.wallet.v1.SafeProposal\x12^\n" +
+	"\x11ListSafeProposals\x12#.wallet.v1.ListSafeProposalsRequest\x1a$.wallet.v1.ListSafeProposalsResponse\x12O\n" +
+	"\x10SignSafeProposal\x12\".wallet.v1.SignSafeProposalRequest\x1a\x17.wallet.v1.SafeProposal\x12d\n" +
+	"\x13ExecuteSafeProposal\x12%.wallet.v1.ExecuteSafeProposalRequest\x1a&.wallet.v1.ExecuteSafeProposalResponse\x12W\n" +
+	"\x11DecodeTransaction\x12#.wallet.v1.DecodeTransactionRequest\x1a\x1d.wallet.v1.DecodedTransaction\x12J\n" +
+	"\x0eDecodeCalldata\x12 .wallet.v1.DecodeCalldataRequest\x1a\x16.wallet.v1.DecodedCall\x12H\n" +
+	"\x0eIssueSIWENonce\x12 .wallet.v1.IssueSIWENonceRequest\x1a\x14.wallet.v1.SIWENonce\x12a\n" +
+	"\x12SignInWithEthereum\x12$.wallet.v1.SignInWithEthereumRequest\x1a%.wallet.v1.SignInWithEthereumResponse\x12I\n" +
+	"\n" +
+	"VerifySIWE\x12\x1c.wallet.v1.VerifySIWERequest\x1a\x1d.wallet.v1.VerifySIWEResponse\x12X\n" +
+	"\x0fVerifySignature\x12!.wallet.v1.VerifySignatureRequest\x1a\".wallet.v1.VerifySignatureResponse\x12I\n" +
+	"\n" +
+	"GetBalance\x12\x1c.wallet.v1.GetBalanceRequest\x1a\x1d.wallet.v1.GetBalanceResponse\x12K\n" +
+	"\rDisableWallet\x12\x1f.wallet.v1.DisableWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12I\n" +
+	"\fEnableWallet\x12\x1e.wallet.v1.EnableWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12K\n" +
+	"\rArchiveWallet\x12\x1f.wallet.v1.ArchiveWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12I\n" +
+	"\fDeleteWallet\x12\x1e.wallet.v1.DeleteWalletRequest\x1a\x19.wallet.v1.WalletResponse\x12[\n" +
+	"\x10ListAuditEntries\x12\".wallet.v1.ListAuditEntriesRequest\x1a#.wallet.v1.ListAuditEntriesResponse\x12U\n" +
+	"\x0eVerifyAuditLog\x12 .wallet.v1.VerifyAuditLogRequest\x1a!.wallet.v1.VerifyAuditLogResponse\x12O\n" +
+	"\fCreateAPIKey\x12\x1e.wallet.v1.CreateAPIKeyRequest\x1a\x1f.wallet.v1.CreateAPIKeyResponse\x12L\n" +
+	"\vListAPIKeys\x12\x1d.wallet.v1.ListAPIKeysRequest\x1a\x1e.wallet.v1.ListAPIKeysResponse\x12A\n" +
+	"\fRevokeAPIKey\x12\x1e.wallet.v1.RevokeAPIKeyRequest\x1a\x11.wallet.v1.APIKey\x124\n" +
+	"\fCreateTenant\x12\x11.wallet.v1.Tenant\x1a\x11.wallet.v1.Tenant\x12;\n" +
+	"\tGetTenant\x12\x1b.wallet.v1.GetTenantRequest\x1a\x11.wallet.v1.Tenant\x12L\n" +
+	"\vListTenants\x12\x1d.wallet.v1.ListTenantsRequest\x1a\x1e.wallet.v1.ListTenantsResponse\x124\n" +
+	"\fUpdateTenant\x12\x11.wallet.v1.Tenant\x1a\x11.wallet.v1.Tenant\x12O\n" +
+	"\fListNetworks\x12\x1e.wallet.v1.ListNetworksRequest\x1a\x1f.wallet.v1.ListNetworksResponse\x12B\n" +
 	"\n" +
-	"GetBalance\x12\x1c.wallet.v1.GetBalanceRequest\x1a\x1d.wallet.v1.GetBalanceResponseB9Z7github.com/rickyreddygari/walletsdk/internal/api/grpcpbb\x06proto3"
+	"AddNetwork\x12\x1c.wallet.v1.AddNetworkRequest\x1a\x16.wallet.v1.NetworkInfo\x12C\n" +
+	"\x0eDisableNetwork\x12\x19.wallet.v1.NetworkRequest\x1a\x16.wallet.v1.NetworkInfo\x12B\n" +
+	"\rEnableNetwork\x12\x19.wallet.v1.NetworkRequest\x1a\x16.wallet.v1.NetworkInfo\x124\n" +
+	"\fCreatePolicy\x12\x11.wallet.v1.Policy\x1a\x11.wallet.v1.Policy\x12;\n" +
+	"\tGetPolicy\x12\x1b.wallet.v1.GetPolicyRequest\x1a\x11.wallet.v1.Policy\x12O\n" +
+	"\fListPolicies\x12\x1e.wallet.v1.ListPoliciesRequest\x1a\x1f.wallet.v1.ListPoliciesResponse\x124\n" +
+	"\fUpdatePolicy\x12\x11.wallet.v1.Policy\x1a\x11.wallet.v1.Policy\x12O\n" +
+	"\fDeletePolicy\x12\x1e.wallet.v1.DeletePolicyRequest\x1a\x1f.wallet.v1.DeletePolicyResponse\x12R\n" +
+	"\x12ListPolicyVersions\x12\x1b.wallet.v1.GetPolicyRequest\x1a\x1f.wallet.v1.ListPoliciesResponse\x12O\n" +
+	"\fDryRunPolicy\x12\x1e.wallet.v1.DryRunPolicyRequest\x1a\x1f.wallet.v1.DryRunPolicyResponse\x12R\n" +
+	"\rListApprovals\x12\x1f.wallet.v1.ListApprovalsRequest\x1a .wallet.v1.ListApprovalsResponse\x12H\n" +
+	"\vGetApproval\x12\x1d.wallet.v1.GetApprovalRequest\x1a\x1a.wallet.v1.ApprovalRequest\x12N\n" +
+	"\x0eApproveRequest\x12 .wallet.v1.DecideApprovalRequest\x1a\x1a.wallet.v1.ApprovalRequest\x12M\n" +
+	"\rRejectRequest\x12 .wallet.v1.DecideApprovalRequest\x1a\x1a.wallet.v1.ApprovalRequestB9Z7github.com/rickyreddygari/walletsdk/internal/api/grpcpbb\x06proto3"
 
 var (
 	file_internal_api_grpc_wallet_proto_rawDescOnce sync.Once
@@ -782,43 +7814,271 @@ func file_internal_api_grpc_wallet_proto_rawDescGZIP() []byte {
 	return file_internal_api_grpc_wallet_proto_rawDescData
 }
 
-var file_internal_api_grpc_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_internal_api_grpc_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 100)
 var file_internal_api_grpc_wallet_proto_goTypes = []any{
-	(*CreateWalletRequest)(nil),     // 0: wallet.v1.CreateWalletRequest
-	(*WalletResponse)(nil),          // 1: wallet.v1.WalletResponse
-	(*GetWalletRequest)(nil),        // 2: wallet.v1.GetWalletRequest
-	(*ListWalletsRequest)(nil),      // 3: wallet.v1.ListWalletsRequest
-	(*ListWalletsResponse)(nil),     // 4: wallet.v1.ListWalletsResponse
-	(*SignMessageRequest)(nil),      // 5: wallet.v1.SignMessageRequest
-	(*SignMessageResponse)(nil),     // 6: wallet.v1.SignMessageResponse
-	(*SignTransactionRequest)(nil),  // 7: wallet.v1.SignTransactionRequest
-	(*SignTransactionResponse)(nil), // 8: wallet.v1.SignTransactionResponse
-	(*GetBalanceRequest)(nil),       // 9: wallet.v1.GetBalanceRequest
-	(*GetBalanceResponse)(nil),      // 10: wallet.v1.GetBalanceResponse
-	(*Balance)(nil),                 // 11: wallet.v1.Balance
-	(*Transaction)(nil),             // 12: wallet.v1.Transaction
+	(*CreateWalletRequest)(nil),               // 0: wallet.v1.CreateWalletRequest
+	(*SmartAccount)(nil),                      // 1: wallet.v1.SmartAccount
+	(*WalletResponse)(nil),                    // 2: wallet.v1.WalletResponse
+	(*GetWalletRequest)(nil),                  // 3: wallet.v1.GetWalletRequest
+	(*ListWalletsRequest)(nil),                // 4: wallet.v1.ListWalletsRequest
+	(*ListWalletsResponse)(nil),               // 5: wallet.v1.ListWalletsResponse
+	(*SignMessageRequest)(nil),                // 6: wallet.v1.SignMessageRequest
+	(*SignMessageResponse)(nil),               // 7: wallet.v1.SignMessageResponse
+	(*SignTransactionRequest)(nil),            // 8: wallet.v1.SignTransactionRequest
+	(*SimulateTransactionRequest)(nil),        // 9: wallet.v1.SimulateTransactionRequest
+	(*SimulationResult)(nil),                  // 10: wallet.v1.SimulationResult
+	(*SignTransactionResponse)(nil),           // 11: wallet.v1.SignTransactionResponse
+	(*ContractCallRequest)(nil),               // 12: wallet.v1.ContractCallRequest
+	(*ContractOutput)(nil),                    // 13: wallet.v1.ContractOutput
+	(*ContractCallResponse)(nil),              // 14: wallet.v1.ContractCallResponse
+	(*TokenTxOptions)(nil),                    // 15: wallet.v1.TokenTxOptions
+	(*TransferTokenRequest)(nil),              // 16: wallet.v1.TransferTokenRequest
+	(*ApproveTokenRequest)(nil),               // 17: wallet.v1.ApproveTokenRequest
+	(*Token)(nil),                             // 18: wallet.v1.Token
+	(*TokenTxResponse)(nil),                   // 19: wallet.v1.TokenTxResponse
+	(*SignPermitRequest)(nil),                 // 20: wallet.v1.SignPermitRequest
+	(*SignPermitResponse)(nil),                // 21: wallet.v1.SignPermitResponse
+	(*UserOperation)(nil),                     // 22: wallet.v1.UserOperation
+	(*SignUserOperationRequest)(nil),          // 23: wallet.v1.SignUserOperationRequest
+	(*SignUserOperationResponse)(nil),         // 24: wallet.v1.SignUserOperationResponse
+	(*DecodeTransactionRequest)(nil),          // 25: wallet.v1.DecodeTransactionRequest
+	(*DecodeCalldataRequest)(nil),             // 26: wallet.v1.DecodeCalldataRequest
+	(*DecodedTransaction)(nil),                // 27: wallet.v1.DecodedTransaction
+	(*DecodedCall)(nil),                       // 28: wallet.v1.DecodedCall
+	(*IssueSIWENonceRequest)(nil),             // 29: wallet.v1.IssueSIWENonceRequest
+	(*SIWENonce)(nil),                         // 30: wallet.v1.SIWENonce
+	(*SignInWithEthereumRequest)(nil),         // 31: wallet.v1.SignInWithEthereumRequest
+	(*SIWEMessage)(nil),                       // 32: wallet.v1.SIWEMessage
+	(*SignInWithEthereumResponse)(nil),        // 33: wallet.v1.SignInWithEthereumResponse
+	(*VerifySIWERequest)(nil),                 // 34: wallet.v1.VerifySIWERequest
+	(*VerifySIWEResponse)(nil),                // 35: wallet.v1.VerifySIWEResponse
+	(*VerifySignatureRequest)(nil),            // 36: wallet.v1.VerifySignatureRequest
+	(*VerifySignatureResponse)(nil),           // 37: wallet.v1.VerifySignatureResponse
+	(*SafeTransaction)(nil),                   // 38: wallet.v1.SafeTransaction
+	(*ProposeSafeTransactionRequest)(nil),     // 39: wallet.v1.ProposeSafeTransactionRequest
+	(*SafeSignature)(nil),                     // 40: wallet.v1.SafeSignature
+	(*SafeProposal)(nil),                      // 41: wallet.v1.SafeProposal
+	(*GetSafeProposalRequest)(nil),            // 42: wallet.v1.GetSafeProposalRequest
+	(*ListSafeProposalsRequest)(nil),          // 43: wallet.v1.ListSafeProposalsRequest
+	(*ListSafeProposalsResponse)(nil),         // 44: wallet.v1.ListSafeProposalsResponse
+	(*SignSafeProposalRequest)(nil),           // 45: wallet.v1.SignSafeProposalRequest
+	(*ExecuteSafeProposalRequest)(nil),        // 46: wallet.v1.ExecuteSafeProposalRequest
+	(*ExecuteSafeProposalResponse)(nil),       // 47: wallet.v1.ExecuteSafeProposalResponse
+	(*GetBalanceRequest)(nil),                 // 48: wallet.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                // 49: wallet.v1.GetBalanceResponse
+	(*Balance)(nil),                           // 50: wallet.v1.Balance
+	(*Transaction)(nil),                       // 51: wallet.v1.Transaction
+	(*Authorization)(nil),                     // 52: wallet.v1.Authorization
+	(*SignAuthorizationRequest)(nil),          // 53: wallet.v1.SignAuthorizationRequest
+	(*SignSerializedTransactionRequest)(nil),  // 54: wallet.v1.SignSerializedTransactionRequest
+	(*SignSerializedTransactionResponse)(nil), // 55: wallet.v1.SignSerializedTransactionResponse
+	(*SignPSBTRequest)(nil),                   // 56: wallet.v1.SignPSBTRequest
+	(*SignPSBTResponse)(nil),                  // 57: wallet.v1.SignPSBTResponse
+	(*DisableWalletRequest)(nil),              // 58: wallet.v1.DisableWalletRequest
+	(*EnableWalletRequest)(nil),               // 59: wallet.v1.EnableWalletRequest
+	(*ArchiveWalletRequest)(nil),              // 60: wallet.v1.ArchiveWalletRequest
+	(*DeleteWalletRequest)(nil),               // 61: wallet.v1.DeleteWalletRequest
+	(*AuditEntry)(nil),                        // 62: wallet.v1.AuditEntry
+	(*ListAuditEntriesRequest)(nil),           // 63: wallet.v1.ListAuditEntriesRequest
+	(*ListAuditEntriesResponse)(nil),          // 64: wallet.v1.ListAuditEntriesResponse
+	(*VerifyAuditLogRequest)(nil),             // 65: wallet.v1.VerifyAuditLogRequest
+	(*VerifyAuditLogResponse)(nil),            // 66: wallet.v1.VerifyAuditLogResponse
+	(*APIKey)(nil),                            // 67: wallet.v1.APIKey
+	(*CreateAPIKeyRequest)(nil),               // 68: wallet.v1.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),              // 69: wallet.v1.CreateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                // 70: wallet.v1.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),               // 71: wallet.v1.ListAPIKeysResponse
+	(*RevokeAPIKeyRequest)(nil),               // 72: wallet.v1.RevokeAPIKeyRequest
+	(*Tenant)(nil),                            // 73: wallet.v1.Tenant
+	(*GetTenantRequest)(nil),                  // 74: wallet.v1.GetTenantRequest
+	(*ListTenantsRequest)(nil),                // 75: wallet.v1.ListTenantsRequest
+	(*ListTenantsResponse)(nil),               // 76: wallet.v1.ListTenantsResponse
+	(*AddNetworkRequest)(nil),                 // 77: wallet.v1.AddNetworkRequest
+	(*NativeCurrency)(nil),                    // 78: wallet.v1.NativeCurrency
+	(*Explorer)(nil),                          // 79: wallet.v1.Explorer
+	(*NetworkInfo)(nil),                       // 80: wallet.v1.NetworkInfo
+	(*NetworkRequest)(nil),                    // 81: wallet.v1.NetworkRequest
+	(*ListNetworksRequest)(nil),               // 82: wallet.v1.ListNetworksRequest
+	(*ListNetworksResponse)(nil),              // 83: wallet.v1.ListNetworksResponse
+	(*PolicyRules)(nil),                       // 84: wallet.v1.PolicyRules
+	(*QuorumRule)(nil),                        // 85: wallet.v1.QuorumRule
+	(*Policy)(nil),                            // 86: wallet.v1.Policy
+	(*GetPolicyRequest)(nil),                  // 87: wallet.v1.GetPolicyRequest
+	(*ListPoliciesRequest)(nil),               // 88: wallet.v1.ListPoliciesRequest
+	(*ListPoliciesResponse)(nil),              // 89: wallet.v1.ListPoliciesResponse
+	(*DeletePolicyRequest)(nil),               // 90: wallet.v1.DeletePolicyRequest
+	(*DeletePolicyResponse)(nil),              // 91: wallet.v1.DeletePolicyResponse
+	(*DryRunPolicyRequest)(nil),               // 92: wallet.v1.DryRunPolicyRequest
+	(*DryRunPolicyResponse)(nil),              // 93: wallet.v1.DryRunPolicyResponse
+	(*ApprovalDecision)(nil),                  // 94: wallet.v1.ApprovalDecision
+	(*ApprovalRequest)(nil),                   // 95: wallet.v1.ApprovalRequest
+	(*ListApprovalsRequest)(nil),              // 96: wallet.v1.ListApprovalsRequest
+	(*ListApprovalsResponse)(nil),             // 97: wallet.v1.ListApprovalsResponse
+	(*GetApprovalRequest)(nil),                // 98: wallet.v1.GetApprovalRequest
+	(*DecideApprovalRequest)(nil),             // 99: wallet.v1.DecideApprovalRequest
 }
 var file_internal_api_grpc_wallet_proto_depIdxs = []int32{
-	1,  // 0: wallet.v1.ListWalletsResponse.wallets:type_name -> wallet.v1.WalletResponse
-	12, // 1: wallet.v1.SignTransactionRequest.transaction:type_name -> wallet.v1.Transaction
-	11, // 2: wallet.v1.GetBalanceResponse.balance:type_name -> wallet.v1.Balance
-	0,  // 3: wallet.v1.WalletService.CreateWallet:input_type -> wallet.v1.CreateWalletRequest
-	2,  // 4: wallet.v1.WalletService.GetWallet:input_type -> wallet.v1.GetWalletRequest
-	3,  // 5: wallet.v1.WalletService.ListWallets:input_type -> wallet.v1.ListWalletsRequest
-	5,  // 6: wallet.v1.WalletService.SignMessage:input_type -> wallet.v1.SignMessageRequest
-	7,  // 7: wallet.v1.WalletService.SignTransaction:input_type -> wallet.v1.SignTransactionRequest
-	9,  // 8: wallet.v1.WalletService.GetBalance:input_type -> wallet.v1.GetBalanceRequest
-	1,  // 9: wallet.v1.WalletService.CreateWallet:output_type -> wallet.v1.WalletResponse
-	1,  // 10: wallet.v1.WalletService.GetWallet:output_type -> wallet.v1.WalletResponse
-	4,  // 11: wallet.v1.WalletService.ListWallets:output_type -> wallet.v1.ListWalletsResponse
-	6,  // 12: wallet.v1.WalletService.SignMessage:output_type -> wallet.v1.SignMessageResponse
-	8,  // 13: wallet.v1.WalletService.SignTransaction:output_type -> wallet.v1.SignTransactionResponse
-	10, // 14: wallet.v1.WalletService.GetBalance:output_type -> wallet.v1.GetBalanceResponse
-	9,  // [9:15] is the sub-list for method output_type
-	3,  // [3:9] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	1,   // 0: wallet.v1.WalletResponse.smart_account:type_name -> wallet.v1.SmartAccount
+	2,   // 1: wallet.v1.ListWalletsResponse.wallets:type_name -> wallet.v1.WalletResponse
+	51,  // 2: wallet.v1.SignTransactionRequest.transaction:type_name -> wallet.v1.Transaction
+	51,  // 3: wallet.v1.SimulateTransactionRequest.transaction:type_name -> wallet.v1.Transaction
+	95,  // 4: wallet.v1.SignTransactionResponse.approval:type_name -> wallet.v1.ApprovalRequest
+	13,  // 5: wallet.v1.ContractCallResponse.outputs:type_name -> wallet.v1.ContractOutput
+	95,  // 6: wallet.v1.ContractCallResponse.approval:type_name -> wallet.v1.ApprovalRequest
+	15,  // 7: wallet.v1.TransferTokenRequest.options:type_name -> wallet.v1.TokenTxOptions
+	15,  // 8: wallet.v1.ApproveTokenRequest.options:type_name -> wallet.v1.TokenTxOptions
+	18,  // 9: wallet.v1.TokenTxResponse.token:type_name -> wallet.v1.Token
+	51,  // 10: wallet.v1.TokenTxResponse.transaction:type_name -> wallet.v1.Transaction
+	95,  // 11: wallet.v1.TokenTxResponse.approval:type_name -> wallet.v1.ApprovalRequest
+	18,  // 12: wallet.v1.SignPermitResponse.token:type_name -> wallet.v1.Token
+	22,  // 13: wallet.v1.SignUserOperationRequest.user_operation:type_name -> wallet.v1.UserOperation
+	22,  // 14: wallet.v1.SignUserOperationResponse.user_operation:type_name -> wallet.v1.UserOperation
+	95,  // 15: wallet.v1.SignUserOperationResponse.approval:type_name -> wallet.v1.ApprovalRequest
+	28,  // 16: wallet.v1.DecodedTransaction.call:type_name -> wallet.v1.DecodedCall
+	13,  // 17: wallet.v1.DecodedCall.args:type_name -> wallet.v1.ContractOutput
+	28,  // 18: wallet.v1.DecodedCall.calls:type_name -> wallet.v1.DecodedCall
+	32,  // 19: wallet.v1.SignInWithEthereumResponse.fields:type_name -> wallet.v1.SIWEMessage
+	32,  // 20: wallet.v1.VerifySIWEResponse.fields:type_name -> wallet.v1.SIWEMessage
+	38,  // 21: wallet.v1.ProposeSafeTransactionRequest.transaction:type_name -> wallet.v1.SafeTransaction
+	38,  // 22: wallet.v1.SafeProposal.transaction:type_name -> wallet.v1.SafeTransaction
+	40,  // 23: wallet.v1.SafeProposal.signatures:type_name -> wallet.v1.SafeSignature
+	41,  // 24: wallet.v1.ListSafeProposalsResponse.proposals:type_name -> wallet.v1.SafeProposal
+	15,  // 25: wallet.v1.ExecuteSafeProposalRequest.options:type_name -> wallet.v1.TokenTxOptions
+	41,  // 26: wallet.v1.ExecuteSafeProposalResponse.proposal:type_name -> wallet.v1.SafeProposal
+	95,  // 27: wallet.v1.ExecuteSafeProposalResponse.approval:type_name -> wallet.v1.ApprovalRequest
+	50,  // 28: wallet.v1.GetBalanceResponse.balance:type_name -> wallet.v1.Balance
+	52,  // 29: wallet.v1.Transaction.authorization_list:type_name -> wallet.v1.Authorization
+	62,  // 30: wallet.v1.ListAuditEntriesResponse.entries:type_name -> wallet.v1.AuditEntry
+	67,  // 31: wallet.v1.CreateAPIKeyResponse.key:type_name -> wallet.v1.APIKey
+	67,  // 32: wallet.v1.ListAPIKeysResponse.keys:type_name -> wallet.v1.APIKey
+	73,  // 33: wallet.v1.ListTenantsResponse.tenants:type_name -> wallet.v1.Tenant
+	78,  // 34: wallet.v1.AddNetworkRequest.native_currency:type_name -> wallet.v1.NativeCurrency
+	79,  // 35: wallet.v1.AddNetworkRequest.explorers:type_name -> wallet.v1.Explorer
+	18,  // 36: wallet.v1.AddNetworkRequest.tokens:type_name -> wallet.v1.Token
+	80,  // 37: wallet.v1.ListNetworksResponse.networks:type_name -> wallet.v1.NetworkInfo
+	85,  // 38: wallet.v1.PolicyRules.quorum:type_name -> wallet.v1.QuorumRule
+	84,  // 39: wallet.v1.Policy.rules:type_name -> wallet.v1.PolicyRules
+	86,  // 40: wallet.v1.ListPoliciesResponse.policies:type_name -> wallet.v1.Policy
+	86,  // 41: wallet.v1.DryRunPolicyRequest.policy:type_name -> wallet.v1.Policy
+	51,  // 42: wallet.v1.DryRunPolicyRequest.transaction:type_name -> wallet.v1.Transaction
+	51,  // 43: wallet.v1.ApprovalRequest.transaction:type_name -> wallet.v1.Transaction
+	94,  // 44: wallet.v1.ApprovalRequest.decisions:type_name -> wallet.v1.ApprovalDecision
+	23,  // 45: wallet.v1.ApprovalRequest.user_operation:type_name -> wallet.v1.SignUserOperationRequest
+	24,  // 46: wallet.v1.ApprovalRequest.user_operation_result:type_name -> wallet.v1.SignUserOperationResponse
+	95,  // 47: wallet.v1.ListApprovalsResponse.approvals:type_name -> wallet.v1.ApprovalRequest
+	0,   // 48: wallet.v1.WalletService.CreateWallet:input_type -> wallet.v1.CreateWalletRequest
+	3,   // 49: wallet.v1.WalletService.GetWallet:input_type -> wallet.v1.GetWalletRequest
+	4,   // 50: wallet.v1.WalletService.ListWallets:input_type -> wallet.v1.ListWalletsRequest
+	6,   // 51: wallet.v1.WalletService.SignMessage:input_type -> wallet.v1.SignMessageRequest
+	8,   // 52: wallet.v1.WalletService.SignTransaction:input_type -> wallet.v1.SignTransactionRequest
+	54,  // 53: wallet.v1.WalletService.SignSerializedTransaction:input_type -> wallet.v1.SignSerializedTransactionRequest
+	56,  // 54: wallet.v1.WalletService.SignPSBT:input_type -> wallet.v1.SignPSBTRequest
+	9,   // 55: wallet.v1.WalletService.SimulateTransaction:input_type -> wallet.v1.SimulateTransactionRequest
+	12,  // 56: wallet.v1.WalletService.ContractCall:input_type -> wallet.v1.ContractCallRequest
+	16,  // 57: wallet.v1.WalletService.TransferToken:input_type -> wallet.v1.TransferTokenRequest
+	17,  // 58: wallet.v1.WalletService.ApproveToken:input_type -> wallet.v1.ApproveTokenRequest
+	20,  // 59: wallet.v1.WalletService.SignPermit:input_type -> wallet.v1.SignPermitRequest
+	23,  // 60: wallet.v1.WalletService.SignUserOperation:input_type -> wallet.v1.SignUserOperationRequest
+	53,  // 61: wallet.v1.WalletService.SignAuthorization:input_type -> wallet.v1.SignAuthorizationRequest
+	39,  // 62: wallet.v1.WalletService.ProposeSafeTransaction:input_type -> wallet.v1.ProposeSafeTransactionRequest
+	42,  // 63: wallet.v1.WalletService.GetSafeProposal:input_type -> wallet.v1.GetSafeProposalRequest
+	43,  // 64: wallet.v1.WalletService.ListSafeProposals:input_type -> wallet.v1.ListSafeProposalsRequest
+	45,  // 65: wallet.v1.WalletService.SignSafeProposal:input_type -> wallet.v1.SignSafeProposalRequest
+	46,  // 66: wallet.v1.WalletService.ExecuteSafeProposal:input_type -> wallet.v1.ExecuteSafeProposalRequest
+	25,  // 67: wallet.v1.WalletService.DecodeTransaction:input_type -> wallet.v1.DecodeTransactionRequest
+	26,  // 68: wallet.v1.WalletService.DecodeCalldata:input_type -> wallet.v1.DecodeCalldataRequest
+	29,  // 69: wallet.v1.WalletService.IssueSIWENonce:input_type -> wallet.v1.IssueSIWENonceRequest
+	31,  // 70: wallet.v1.WalletService.SignInWithEthereum:input_type -> wallet.v1.SignInWithEthereumRequest
+	34,  // 71: wallet.v1.WalletService.VerifySIWE:input_type -> wallet.v1.VerifySIWERequest
+	36,  // 72: wallet.v1.WalletService.VerifySignature:input_type -> wallet.v1.VerifySignatureRequest
+	48,  // 73: wallet.v1.WalletService.GetBalance:input_type -> wallet.v1.GetBalanceRequest
+	58,  // 74: wallet.v1.WalletService.DisableWallet:input_type -> wallet.v1.DisableWalletRequest
+	59,  // 75: wallet.v1.WalletService.EnableWallet:input_type -> wallet.v1.EnableWalletRequest
+	60,  // 76: wallet.v1.WalletService.ArchiveWallet:input_type -> wallet.v1.ArchiveWalletRequest
+	61,  // 77: wallet.v1.WalletService.DeleteWallet:input_type -> wallet.v1.DeleteWalletRequest
+	63,  // 78: wallet.v1.WalletService.ListAuditEntries:input_type -> wallet.v1.ListAuditEntriesRequest
+	65,  // 79: wallet.v1.WalletService.VerifyAuditLog:input_type -> wallet.v1.VerifyAuditLogRequest
+	68,  // 80: wallet.v1.WalletService.CreateAPIKey:input_type -> wallet.v1.CreateAPIKeyRequest
+	70,  // 81: wallet.v1.WalletService.ListAPIKeys:input_type -> wallet.v1.ListAPIKeysRequest
+	72,  // 82: wallet.v1.WalletService.RevokeAPIKey:input_type -> wallet.v1.RevokeAPIKeyRequest
+	73,  // 83: wallet.v1.WalletService.CreateTenant:input_type -> wallet.v1.Tenant
+	74,  // 84: wallet.v1.WalletService.GetTenant:input_type -> wallet.v1.GetTenantRequest
+	75,  // 85: wallet.v1.WalletService.ListTenants:input_type -> wallet.v1.ListTenantsRequest
+	73,  // 86: wallet.v1.WalletService.UpdateTenant:input_type -> wallet.v1.Tenant
+	82,  // 87: wallet.v1.WalletService.ListNetworks:input_type -> wallet.v1.ListNetworksRequest
+	77,  // 88: wallet.v1.WalletService.AddNetwork:input_type -> wallet.v1.AddNetworkRequest
+	81,  // 89: wallet.v1.WalletService.DisableNetwork:input_type -> wallet.v1.NetworkRequest
+	81,  // 90: wallet.v1.WalletService.EnableNetwork:input_type -> wallet.v1.NetworkRequest
+	86,  // 91: wallet.v1.WalletService.CreatePolicy:input_type -> wallet.v1.Policy
+	87,  // 92: wallet.v1.WalletService.GetPolicy:input_type -> wallet.v1.GetPolicyRequest
+	88,  // 93: wallet.v1.WalletService.ListPolicies:input_type -> wallet.v1.ListPoliciesRequest
+	86,  // 94: wallet.v1.WalletService.UpdatePolicy:input_type -> wallet.v1.Policy
+	90,  // 95: wallet.v1.WalletService.DeletePolicy:input_type -> wallet.v1.DeletePolicyRequest
+	87,  // 96: wallet.v1.WalletService.ListPolicyVersions:input_type -> wallet.v1.GetPolicyRequest
+	92,  // 97: wallet.v1.WalletService.DryRunPolicy:input_type -> wallet.v1.DryRunPolicyRequest
+	96,  // 98: wallet.v1.WalletService.ListApprovals:input_type -> wallet.v1.ListApprovalsRequest
+	98,  // 99: wallet.v1.WalletService.GetApproval:input_type -> wallet.v1.GetApprovalRequest
+	99,  // 100: wallet.v1.WalletService.ApproveRequest:input_type -> wallet.v1.DecideApprovalRequest
+	99,  // 101: wallet.v1.WalletService.RejectRequest:input_type -> wallet.v1.DecideApprovalRequest
+	2,   // 102: wallet.v1.WalletService.CreateWallet:output_type -> wallet.v1.WalletResponse
+	2,   // 103: wallet.v1.WalletService.GetWallet:output_type -> wallet.v1.WalletResponse
+	5,   // 104: wallet.v1.WalletService.ListWallets:output_type -> wallet.v1.ListWalletsResponse
+	7,   // 105: wallet.v1.WalletService.SignMessage:output_type -> wallet.v1.SignMessageResponse
+	11,  // 106: wallet.v1.WalletService.SignTransaction:output_type -> wallet.v1.SignTransactionResponse
+	55,  // 107: wallet.v1.WalletService.SignSerializedTransaction:output_type -> wallet.v1.SignSerializedTransactionResponse
+	57,  // 108: wallet.v1.WalletService.SignPSBT:output_type -> wallet.v1.SignPSBTResponse
+	10,  // 109: wallet.v1.WalletService.SimulateTransaction:output_type -> wallet.v1.SimulationResult
+	14,  // 110: wallet.v1.WalletService.ContractCall:output_type -> wallet.v1.ContractCallResponse
+	19,  // 111: wallet.v1.WalletService.TransferToken:output_type -> wallet.v1.TokenTxResponse
+	19,  // 112: wallet.v1.WalletService.ApproveToken:output_type -> wallet.v1.TokenTxResponse
+	21,  // 113: wallet.v1.WalletService.SignPermit:output_type -> wallet.v1.SignPermitResponse
+	24,  // 114: wallet.v1.WalletService.SignUserOperation:output_type -> wallet.v1.SignUserOperationResponse
+	52,  // 115: wallet.v1.WalletService.SignAuthorization:output_type -> wallet.v1.Authorization
+	41,  // 116: wallet.v1.WalletService.ProposeSafeTransaction:output_type -> wallet.v1.SafeProposal
+	41,  // 117: wallet.v1.WalletService.GetSafeProposal:output_type -> wallet.v1.SafeProposal
+	44,  // 118: wallet.v1.WalletService.ListSafeProposals:output_type -> wallet.v1.ListSafeProposalsResponse
+	41,  // 119: wallet.v1.WalletService.SignSafeProposal:output_type -> wallet.v1.SafeProposal
+	47,  // 120: wallet.v1.WalletService.ExecuteSafeProposal:output_type -> wallet.v1.ExecuteSafeProposalResponse
+	27,  // 121: wallet.v1.WalletService.DecodeTransaction:output_type -> wallet.v1.DecodedTransaction
+	28,  // 122: wallet.v1.WalletService.DecodeCalldata:output_type -> wallet.v1.DecodedCall
+	30,  // 123: wallet.v1.WalletService.IssueSIWENonce:output_type -> wallet.v1.SIWENonce
+	33,  // 124: wallet.v1.WalletService.SignInWithEthereum:output_type -> wallet.v1.SignInWithEthereumResponse
+	35,  // 125: wallet.v1.WalletService.VerifySIWE:output_type -> wallet.v1.VerifySIWEResponse
+	37,  // 126: wallet.v1.WalletService.VerifySignature:output_type -> wallet.v1.VerifySignatureResponse
+	49,  // 127: wallet.v1.WalletService.GetBalance:output_type -> wallet.v1.GetBalanceResponse
+	2,   // 128: wallet.v1.WalletService.DisableWallet:output_type -> wallet.v1.WalletResponse
+	2,   // 129: wallet.v1.WalletService.EnableWallet:output_type -> wallet.v1.WalletResponse
+	2,   // 130: wallet.v1.WalletService.ArchiveWallet:output_type -> wallet.v1.WalletResponse
+	2,   // 131: wallet.v1.WalletService.DeleteWallet:output_type -> wallet.v1.WalletResponse
+	64,  // 132: wallet.v1.WalletService.ListAuditEntries:output_type -> wallet.v1.ListAuditEntriesResponse
+	66,  // 133: wallet.v1.WalletService.VerifyAuditLog:output_type -> wallet.v1.VerifyAuditLogResponse
+	69,  // 134: wallet.v1.WalletService.CreateAPIKey:output_type -> wallet.v1.CreateAPIKeyResponse
+	71,  // 135: wallet.v1.WalletService.ListAPIKeys:output_type -> wallet.v1.ListAPIKeysResponse
+	67,  // 136: wallet.v1.WalletService.RevokeAPIKey:output_type -> wallet.v1.APIKey
+	73,  // 137: wallet.v1.WalletService.CreateTenant:output_type -> wallet.v1.Tenant
+	73,  // 138: wallet.v1.WalletService.GetTenant:output_type -> wallet.v1.Tenant
+	76,  // 139: wallet.v1.WalletService.ListTenants:output_type -> wallet.v1.ListTenantsResponse
+	73,  // 140: wallet.v1.WalletService.UpdateTenant:output_type -> wallet.v1.Tenant
+	83,  // 141: wallet.v1.WalletService.ListNetworks:output_type -> wallet.v1.ListNetworksResponse
+	80,  // 142: wallet.v1.WalletService.AddNetwork:output_type -> wallet.v1.NetworkInfo
+	80,  // 143: wallet.v1.WalletService.DisableNetwork:output_type -> wallet.v1.NetworkInfo
+	80,  // 144: wallet.v1.WalletService.EnableNetwork:output_type -> wallet.v1.NetworkInfo
+	86,  // 145: wallet.v1.WalletService.CreatePolicy:output_type -> wallet.v1.Policy
+	86,  // 146: wallet.v1.WalletService.GetPolicy:output_type -> wallet.v1.Policy
+	89,  // 147: wallet.v1.WalletService.ListPolicies:output_type -> wallet.v1.ListPoliciesResponse
+	86,  // 148: wallet.v1.WalletService.UpdatePolicy:output_type -> wallet.v1.Policy
+	91,  // 149: wallet.v1.WalletService.DeletePolicy:output_type -> wallet.v1.DeletePolicyResponse
+	89,  // 150: wallet.v1.WalletService.ListPolicyVersions:output_type -> wallet.v1.ListPoliciesResponse
+	93,  // 151: wallet.v1.WalletService.DryRunPolicy:output_type -> wallet.v1.DryRunPolicyResponse
+	97,  // 152: wallet.v1.WalletService.ListApprovals:output_type -> wallet.v1.ListApprovalsResponse
+	95,  // 153: wallet.v1.WalletService.GetApproval:output_type -> wallet.v1.ApprovalRequest
+	95,  // 154: wallet.v1.WalletService.ApproveRequest:output_type -> wallet.v1.ApprovalRequest
+	95,  // 155: wallet.v1.WalletService.RejectRequest:output_type -> wallet.v1.ApprovalRequest
+	102, // [102:156] is the sub-list for method output_type
+	48,  // [48:102] is the sub-list for method input_type
+	48,  // [48:48] is the sub-list for extension type_name
+	48,  // [48:48] is the sub-list for extension extendee
+	0,   // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_internal_api_grpc_wallet_proto_init() }
@@ -826,13 +8086,14 @@ func file_internal_api_grpc_wallet_proto_init() {
 	if File_internal_api_grpc_wallet_proto != nil {
 		return
 	}
+	file_internal_api_grpc_wallet_proto_msgTypes[53].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_api_grpc_wallet_proto_rawDesc), len(file_internal_api_grpc_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   100,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -13,12 +13,60 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WalletService_CreateWallet_FullMethodName    = "/wallet.v1.WalletService/CreateWallet"
-	WalletService_GetWallet_FullMethodName       = "/wallet.v1.WalletService/GetWallet"
-	WalletService_ListWallets_FullMethodName     = "/wallet.v1.WalletService/ListWallets"
-	WalletService_SignMessage_FullMethodName     = "/wallet.v1.WalletService/SignMessage"
-	WalletService_SignTransaction_FullMethodName = "/wallet.v1.WalletService/SignTransaction"
-	WalletService_GetBalance_FullMethodName      = "/wallet.v1.WalletService/GetBalance"
+	WalletService_CreateWallet_FullMethodName              = "/wallet.v1.WalletService/CreateWallet"
+	WalletService_GetWallet_FullMethodName                 = "/wallet.v1.WalletService/GetWallet"
+	WalletService_ListWallets_FullMethodName               = "/wallet.v1.WalletService/ListWallets"
+	WalletService_SignMessage_FullMethodName               = "/wallet.v1.WalletService/SignMessage"
+	WalletService_SignTransaction_FullMethodName           = "/wallet.v1.WalletService/SignTransaction"
+	WalletService_SignSerializedTransaction_FullMethodName = "/wallet.v1.WalletService/SignSerializedTransaction"
+	WalletService_SignPSBT_FullMethodName                  = "/wallet.v1.WalletService/SignPSBT"
+	WalletService_SimulateTransaction_FullMethodName       = "/wallet.v1.WalletService/SimulateTransaction"
+	WalletService_ContractCall_FullMethodName              = "/wallet.v1.WalletService/ContractCall"
+	WalletService_TransferToken_FullMethodName             = "/wallet.v1.WalletService/TransferToken"
+	WalletService_ApproveToken_FullMethodName              = "/wallet.v1.WalletService/ApproveToken"
+	WalletService_SignPermit_FullMethodName                = "/wallet.v1.WalletService/SignPermit"
+	WalletService_SignUserOperation_FullMethodName         = "/wallet.v1.WalletService/SignUserOperation"
+	WalletService_SignAuthorization_FullMethodName         = "/wallet.v1.WalletService/SignAuthorization"
+	WalletService_ProposeSafeTransaction_FullMethodName    = "/wallet.v1.WalletService/ProposeSafeTransaction"
+	WalletService_GetSafeProposal_FullMethodName           = "/wallet.v1.WalletService/GetSafeProposal"
+	WalletService_ListSafeProposals_FullMethodName         = "/wallet.v1.WalletService/ListSafeProposals"
+	WalletService_SignSafeProposal_FullMethodName          = "/wallet.v1.WalletService/SignSafeProposal"
+	WalletService_ExecuteSafeProposal_FullMethodName       = "/wallet.v1.WalletService/ExecuteSafeProposal"
+	WalletService_DecodeTransaction_FullMethodName         = "/wallet.v1.WalletService/DecodeTransaction"
+	WalletService_DecodeCalldata_FullMethodName            = "/wallet.v1.WalletService/DecodeCalldata"
+	WalletService_IssueSIWENonce_FullMethodName            = "/wallet.v1.WalletService/IssueSIWENonce"
+	WalletService_SignInWithEthereum_FullMethodName        = "/wallet.v1.WalletService/SignInWithEthereum"
+	WalletService_VerifySIWE_FullMethodName                = "/wallet.v1.WalletService/VerifySIWE"
+	WalletService_VerifySignature_FullMethodName           = "/wallet.v1.WalletService/VerifySignature"
+	WalletService_GetBalance_FullMethodName                = "/wallet.v1.WalletService/GetBalance"
+	WalletService_DisableWallet_FullMethodName             = "/wallet.v1.WalletService/DisableWallet"
+	WalletService_EnableWallet_FullMethodName              = "/wallet.v1.WalletService/EnableWallet"
+	WalletService_ArchiveWallet_FullMethodName             = "/wallet.v1.WalletService/ArchiveWallet"
+	WalletService_DeleteWallet_FullMethodName              = "/wallet.v1.WalletService/DeleteWallet"
+	WalletService_ListAuditEntries_FullMethodName          = "/wallet.v1.WalletService/ListAuditEntries"
+	WalletService_VerifyAuditLog_FullMethodName            = "/wallet.v1.WalletService/VerifyAuditLog"
+	WalletService_CreateAPIKey_FullMethodName              = "/wallet.v1.WalletService/CreateAPIKey"
+	WalletService_ListAPIKeys_FullMethodName               = "/wallet.v1.WalletService/ListAPIKeys"
+	WalletService_RevokeAPIKey_FullMethodName              = "/wallet.v1.WalletService/RevokeAPIKey"
+	WalletService_CreateTenant_FullMethodName              = "/wallet.v1.WalletService/CreateTenant"
+	WalletService_GetTenant_FullMethodName                 = "/wallet.v1.WalletService/GetTenant"
+	WalletService_ListTenants_FullMethodName               = "/wallet.v1.WalletService/ListTenants"
+	WalletService_UpdateTenant_FullMethodName              = "/wallet.v1.WalletService/UpdateTenant"
+	WalletService_ListNetworks_FullMethodName              = "/wallet.v1.WalletService/ListNetworks"
+	WalletService_AddNetwork_FullMethodName                = "/wallet.v1.WalletService/AddNetwork"
+	WalletService_DisableNetwork_FullMethodName            = "/wallet.v1.WalletService/DisableNetwork"
+	WalletService_EnableNetwork_FullMethodName             = "/wallet.v1.WalletService/EnableNetwork"
+	WalletService_CreatePolicy_FullMethodName              = "/wallet.v1.WalletService/CreatePolicy"
+	WalletService_GetPolicy_FullMethodName                 = "/wallet.v1.WalletService/GetPolicy"
+	WalletService_ListPolicies_FullMethodName              = "/wallet.v1.WalletService/ListPolicies"
+	WalletService_UpdatePolicy_FullMethodName              = "/wallet.v1.WalletService/UpdatePolicy"
+	WalletService_DeletePolicy_FullMethodName              = "/wallet.v1.WalletService/DeletePolicy"
+	WalletService_ListPolicyVersions_FullMethodName        = "/wallet.v1.WalletService/ListPolicyVersions"
+	WalletService_DryRunPolicy_FullMethodName              = "/wallet.v1.WalletService/DryRunPolicy"
+	WalletService_ListApprovals_FullMethodName             = "/wallet.v1.WalletService/ListApprovals"
+	WalletService_GetApproval_FullMethodName               = "/wallet.v1.WalletService/GetApproval"
+	WalletService_ApproveRequest_FullMethodName            = "/wallet.v1.WalletService/ApproveRequest"
+	WalletService_RejectRequest_FullMethodName             = "/wallet.v1.WalletService/RejectRequest"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -30,7 +78,55 @@ type WalletServiceClient interface {
 	ListWallets(ctx context.Context, in *ListWalletsRequest, opts ...grpc.CallOption) (*ListWalletsResponse, error)
 	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
 	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	SignSerializedTransaction(ctx context.Context, in *SignSerializedTransactionRequest, opts ...grpc.CallOption) (*SignSerializedTransactionResponse, error)
+	SignPSBT(ctx context.Context, in *SignPSBTRequest, opts ...grpc.CallOption) (*SignPSBTResponse, error)
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResult, error)
+	ContractCall(ctx context.Context, in *ContractCallRequest, opts ...grpc.CallOption) (*ContractCallResponse, error)
+	TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error)
+	ApproveToken(ctx context.Context, in *ApproveTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error)
+	SignPermit(ctx context.Context, in *SignPermitRequest, opts ...grpc.CallOption) (*SignPermitResponse, error)
+	SignUserOperation(ctx context.Context, in *SignUserOperationRequest, opts ...grpc.CallOption) (*SignUserOperationResponse, error)
+	SignAuthorization(ctx context.Context, in *SignAuthorizationRequest, opts ...grpc.CallOption) (*Authorization, error)
+	ProposeSafeTransaction(ctx context.Context, in *ProposeSafeTransactionRequest, opts ...grpc.CallOption) (*SafeProposal, error)
+	GetSafeProposal(ctx context.Context, in *GetSafeProposalRequest, opts ...grpc.CallOption) (*SafeProposal, error)
+	ListSafeProposals(ctx context.Context, in *ListSafeProposalsRequest, opts ...grpc.CallOption) (*ListSafeProposalsResponse, error)
+	SignSafeProposal(ctx context.Context, in *SignSafeProposalRequest, opts ...grpc.CallOption) (*SafeProposal, error)
+	ExecuteSafeProposal(ctx context.Context, in *ExecuteSafeProposalRequest, opts ...grpc.CallOption) (*ExecuteSafeProposalResponse, error)
+	DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodedTransaction, error)
+	DecodeCalldata(ctx context.Context, in *DecodeCalldataRequest, opts ...grpc.CallOption) (*DecodedCall, error)
+	IssueSIWENonce(ctx context.Context, in *IssueSIWENonceRequest, opts ...grpc.CallOption) (*SIWENonce, error)
+	SignInWithEthereum(ctx context.Context, in *SignInWithEthereumRequest, opts ...grpc.CallOption) (*SignInWithEthereumResponse, error)
+	VerifySIWE(ctx context.Context, in *VerifySIWERequest, opts ...grpc.CallOption) (*VerifySIWEResponse, error)
+	VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	DisableWallet(ctx context.Context, in *DisableWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	EnableWallet(ctx context.Context, in *EnableWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	ArchiveWallet(ctx context.Context, in *ArchiveWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error)
+	ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error)
+	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*Tenant, error)
+	ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error)
+	UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error)
+	ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error)
+	AddNetwork(ctx context.Context, in *AddNetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	DisableNetwork(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	EnableNetwork(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error)
+	CreatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error)
+	GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*Policy, error)
+	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error)
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	ListPolicyVersions(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	DryRunPolicy(ctx context.Context, in *DryRunPolicyRequest, opts ...grpc.CallOption) (*DryRunPolicyResponse, error)
+	ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error)
+	GetApproval(ctx context.Context, in *GetApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error)
+	ApproveRequest(ctx context.Context, in *DecideApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error)
+	RejectRequest(ctx context.Context, in *DecideApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error)
 }
 
 type walletServiceClient struct {
@@ -91,6 +187,206 @@ func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTrans
 	return out, nil
 }
 
+func (c *walletServiceClient) SignSerializedTransaction(ctx context.Context, in *SignSerializedTransactionRequest, opts ...grpc.CallOption) (*SignSerializedTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignSerializedTransactionResponse)
+	err := c.cc.Invoke(ctx, WalletService_SignSerializedTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignPSBT(ctx context.Context, in *SignPSBTRequest, opts ...grpc.CallOption) (*SignPSBTResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignPSBTResponse)
+	err := c.cc.Invoke(ctx, WalletService_SignPSBT_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulationResult)
+	err := c.cc.Invoke(ctx, WalletService_SimulateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ContractCall(ctx context.Context, in *ContractCallRequest, opts ...grpc.CallOption) (*ContractCallResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContractCallResponse)
+	err := c.cc.Invoke(ctx, WalletService_ContractCall_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenTxResponse)
+	err := c.cc.Invoke(ctx, WalletService_TransferToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ApproveToken(ctx context.Context, in *ApproveTokenRequest, opts ...grpc.CallOption) (*TokenTxResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenTxResponse)
+	err := c.cc.Invoke(ctx, WalletService_ApproveToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignPermit(ctx context.Context, in *SignPermitRequest, opts ...grpc.CallOption) (*SignPermitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignPermitResponse)
+	err := c.cc.Invoke(ctx, WalletService_SignPermit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignUserOperation(ctx context.Context, in *SignUserOperationRequest, opts ...grpc.CallOption) (*SignUserOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignUserOperationResponse)
+	err := c.cc.Invoke(ctx, WalletService_SignUserOperation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignAuthorization(ctx context.Context, in *SignAuthorizationRequest, opts ...grpc.CallOption) (*Authorization, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Authorization)
+	err := c.cc.Invoke(ctx, WalletService_SignAuthorization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ProposeSafeTransaction(ctx context.Context, in *ProposeSafeTransactionRequest, opts ...grpc.CallOption) (*SafeProposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SafeProposal)
+	err := c.cc.Invoke(ctx, WalletService_ProposeSafeTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetSafeProposal(ctx context.Context, in *GetSafeProposalRequest, opts ...grpc.CallOption) (*SafeProposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SafeProposal)
+	err := c.cc.Invoke(ctx, WalletService_GetSafeProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListSafeProposals(ctx context.Context, in *ListSafeProposalsRequest, opts ...grpc.CallOption) (*ListSafeProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSafeProposalsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListSafeProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignSafeProposal(ctx context.Context, in *SignSafeProposalRequest, opts ...grpc.CallOption) (*SafeProposal, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SafeProposal)
+	err := c.cc.Invoke(ctx, WalletService_SignSafeProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ExecuteSafeProposal(ctx context.Context, in *ExecuteSafeProposalRequest, opts ...grpc.CallOption) (*ExecuteSafeProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExecuteSafeProposalResponse)
+	err := c.cc.Invoke(ctx, WalletService_ExecuteSafeProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DecodeTransaction(ctx context.Context, in *DecodeTransactionRequest, opts ...grpc.CallOption) (*DecodedTransaction, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodedTransaction)
+	err := c.cc.Invoke(ctx, WalletService_DecodeTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DecodeCalldata(ctx context.Context, in *DecodeCalldataRequest, opts ...grpc.CallOption) (*DecodedCall, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodedCall)
+	err := c.cc.Invoke(ctx, WalletService_DecodeCalldata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) IssueSIWENonce(ctx context.Context, in *IssueSIWENonceRequest, opts ...grpc.CallOption) (*SIWENonce, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SIWENonce)
+	err := c.cc.Invoke(ctx, WalletService_IssueSIWENonce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignInWithEthereum(ctx context.Context, in *SignInWithEthereumRequest, opts ...grpc.CallOption) (*SignInWithEthereumResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignInWithEthereumResponse)
+	err := c.cc.Invoke(ctx, WalletService_SignInWithEthereum_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifySIWE(ctx context.Context, in *VerifySIWERequest, opts ...grpc.CallOption) (*VerifySIWEResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySIWEResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifySIWE_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifySignature(ctx context.Context, in *VerifySignatureRequest, opts ...grpc.CallOption) (*VerifySignatureResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifySignatureResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifySignature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBalanceResponse)
@@ -98,172 +394,1508 @@ func (c *walletServiceClient) GetBalance(ctx context.Context, in *GetBalanceRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *walletServiceClient) DisableWallet(ctx context.Context, in *DisableWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_DisableWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) EnableWallet(ctx context.Context, in *EnableWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_EnableWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ArchiveWallet(ctx context.Context, in *ArchiveWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_ArchiveWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeleteWallet(ctx context.Context, in *DeleteWalletRequest, opts ...grpc.CallOption) (*WalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletResponse)
+	err := c.cc.Invoke(ctx, WalletService_DeleteWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListAuditEntries(ctx context.Context, in *ListAuditEntriesRequest, opts ...grpc.CallOption) (*ListAuditEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditEntriesResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListAuditEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifyAuditLog(ctx context.Context, in *VerifyAuditLogRequest, opts ...grpc.CallOption) (*VerifyAuditLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyAuditLogResponse)
+	err := c.cc.Invoke(ctx, WalletService_VerifyAuditLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, WalletService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...grpc.CallOption) (*ListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, WalletService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, WalletService_CreateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, WalletService_GetTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListTenants(ctx context.Context, in *ListTenantsRequest, opts ...grpc.CallOption) (*ListTenantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListTenants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdateTenant(ctx context.Context, in *Tenant, opts ...grpc.CallOption) (*Tenant, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tenant)
+	err := c.cc.Invoke(ctx, WalletService_UpdateTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListNetworks(ctx context.Context, in *ListNetworksRequest, opts ...grpc.CallOption) (*ListNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNetworksResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddNetwork(ctx context.Context, in *AddNetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, WalletService_AddNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DisableNetwork(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, WalletService_DisableNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) EnableNetwork(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NetworkInfo)
+	err := c.cc.Invoke(ctx, WalletService_EnableNetwork_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, WalletService_CreatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*Policy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, WalletService_GetPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdatePolicy(ctx context.Context, in *Policy, opts ...grpc.CallOption) (*Policy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Policy)
+	err := c.cc.Invoke(ctx, WalletService_UpdatePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, WalletService_DeletePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListPolicyVersions(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListPolicyVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DryRunPolicy(ctx context.Context, in *DryRunPolicyRequest, opts ...grpc.CallOption) (*DryRunPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DryRunPolicyResponse)
+	err := c.cc.Invoke(ctx, WalletService_DryRunPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListApprovals(ctx context.Context, in *ListApprovalsRequest, opts ...grpc.CallOption) (*ListApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApprovalsResponse)
+	err := c.cc.Invoke(ctx, WalletService_ListApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetApproval(ctx context.Context, in *GetApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovalRequest)
+	err := c.cc.Invoke(ctx, WalletService_GetApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ApproveRequest(ctx context.Context, in *DecideApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovalRequest)
+	err := c.cc.Invoke(ctx, WalletService_ApproveRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RejectRequest(ctx context.Context, in *DecideApprovalRequest, opts ...grpc.CallOption) (*ApprovalRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApprovalRequest)
+	err := c.cc.Invoke(ctx, WalletService_RejectRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+// All implementations must embed UnimplementedWalletServiceServer
+// for forward compatibility.
+type WalletServiceServer interface {
+	CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error)
+	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+	SignSerializedTransaction(context.Context, *SignSerializedTransactionRequest) (*SignSerializedTransactionResponse, error)
+	SignPSBT(context.Context, *SignPSBTRequest) (*SignPSBTResponse, error)
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResult, error)
+	ContractCall(context.Context, *ContractCallRequest) (*ContractCallResponse, error)
+	TransferToken(context.Context, *TransferTokenRequest) (*TokenTxResponse, error)
+	ApproveToken(context.Context, *ApproveTokenRequest) (*TokenTxResponse, error)
+	SignPermit(context.Context, *SignPermitRequest) (*SignPermitResponse, error)
+	SignUserOperation(context.Context, *SignUserOperationRequest) (*SignUserOperationResponse, error)
+	SignAuthorization(context.Context, *SignAuthorizationRequest) (*Authorization, error)
+	ProposeSafeTransaction(context.Context, *ProposeSafeTransactionRequest) (*SafeProposal, error)
+	GetSafeProposal(context.Context, *GetSafeProposalRequest) (*SafeProposal, error)
+	ListSafeProposals(context.Context, *ListSafeProposalsRequest) (*ListSafeProposalsResponse, error)
+	SignSafeProposal(context.Context, *SignSafeProposalRequest) (*SafeProposal, error)
+	ExecuteSafeProposal(context.Context, *ExecuteSafeProposalRequest) (*ExecuteSafeProposalResponse, error)
+	DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodedTransaction, error)
+	DecodeCalldata(context.Context, *DecodeCalldataRequest) (*DecodedCall, error)
+	IssueSIWENonce(context.Context, *IssueSIWENonceRequest) (*SIWENonce, error)
+	SignInWithEthereum(context.Context, *SignInWithEthereumRequest) (*SignInWithEthereumResponse, error)
+	VerifySIWE(context.Context, *VerifySIWERequest) (*VerifySIWEResponse, error)
+	VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error)
+	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	DisableWallet(context.Context, *DisableWalletRequest) (*WalletResponse, error)
+	EnableWallet(context.Context, *EnableWalletRequest) (*WalletResponse, error)
+	ArchiveWallet(context.Context, *ArchiveWalletRequest) (*WalletResponse, error)
+	DeleteWallet(context.Context, *DeleteWalletRequest) (*WalletResponse, error)
+	ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error)
+	VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error)
+	CreateTenant(context.Context, *Tenant) (*Tenant, error)
+	GetTenant(context.Context, *GetTenantRequest) (*Tenant, error)
+	ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error)
+	UpdateTenant(context.Context, *Tenant) (*Tenant, error)
+	ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error)
+	AddNetwork(context.Context, *AddNetworkRequest) (*NetworkInfo, error)
+	DisableNetwork(context.Context, *NetworkRequest) (*NetworkInfo, error)
+	EnableNetwork(context.Context, *NetworkRequest) (*NetworkInfo, error)
+	CreatePolicy(context.Context, *Policy) (*Policy, error)
+	GetPolicy(context.Context, *GetPolicyRequest) (*Policy, error)
+	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
+	UpdatePolicy(context.Context, *Policy) (*Policy, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	ListPolicyVersions(context.Context, *GetPolicyRequest) (*ListPoliciesResponse, error)
+	DryRunPolicy(context.Context, *DryRunPolicyRequest) (*DryRunPolicyResponse, error)
+	ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error)
+	GetApproval(context.Context, *GetApprovalRequest) (*ApprovalRequest, error)
+	ApproveRequest(context.Context, *DecideApprovalRequest) (*ApprovalRequest, error)
+	RejectRequest(context.Context, *DecideApprovalRequest) (*ApprovalRequest, error)
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+// UnimplementedWalletServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletServiceServer struct{}
+
+func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+}
+func (UnimplementedWalletServiceServer) SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
+}
+func (UnimplementedWalletServiceServer) SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) SignSerializedTransaction(context.Context, *SignSerializedTransactionRequest) (*SignSerializedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSerializedTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) SignPSBT(context.Context, *SignPSBTRequest) (*SignPSBTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPSBT not implemented")
+}
+func (UnimplementedWalletServiceServer) SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) ContractCall(context.Context, *ContractCallRequest) (*ContractCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractCall not implemented")
+}
+func (UnimplementedWalletServiceServer) TransferToken(context.Context, *TransferTokenRequest) (*TokenTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferToken not implemented")
+}
+func (UnimplementedWalletServiceServer) ApproveToken(context.Context, *ApproveTokenRequest) (*TokenTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveToken not implemented")
+}
+func (UnimplementedWalletServiceServer) SignPermit(context.Context, *SignPermitRequest) (*SignPermitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPermit not implemented")
+}
+func (UnimplementedWalletServiceServer) SignUserOperation(context.Context, *SignUserOperationRequest) (*SignUserOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignUserOperation not implemented")
+}
+func (UnimplementedWalletServiceServer) SignAuthorization(context.Context, *SignAuthorizationRequest) (*Authorization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignAuthorization not implemented")
+}
+func (UnimplementedWalletServiceServer) ProposeSafeTransaction(context.Context, *ProposeSafeTransactionRequest) (*SafeProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSafeTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) GetSafeProposal(context.Context, *GetSafeProposalRequest) (*SafeProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSafeProposal not implemented")
+}
+func (UnimplementedWalletServiceServer) ListSafeProposals(context.Context, *ListSafeProposalsRequest) (*ListSafeProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSafeProposals not implemented")
+}
+func (UnimplementedWalletServiceServer) SignSafeProposal(context.Context, *SignSafeProposalRequest) (*SafeProposal, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignSafeProposal not implemented")
+}
+func (UnimplementedWalletServiceServer) ExecuteSafeProposal(context.Context, *ExecuteSafeProposalRequest) (*ExecuteSafeProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteSafeProposal not implemented")
+}
+func (UnimplementedWalletServiceServer) DecodeTransaction(context.Context, *DecodeTransactionRequest) (*DecodedTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) DecodeCalldata(context.Context, *DecodeCalldataRequest) (*DecodedCall, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeCalldata not implemented")
+}
+func (UnimplementedWalletServiceServer) IssueSIWENonce(context.Context, *IssueSIWENonceRequest) (*SIWENonce, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueSIWENonce not implemented")
+}
+func (UnimplementedWalletServiceServer) SignInWithEthereum(context.Context, *SignInWithEthereumRequest) (*SignInWithEthereumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignInWithEthereum not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifySIWE(context.Context, *VerifySIWERequest) (*VerifySIWEResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySIWE not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifySignature(context.Context, *VerifySignatureRequest) (*VerifySignatureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySignature not implemented")
+}
+func (UnimplementedWalletServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (UnimplementedWalletServiceServer) DisableWallet(context.Context, *DisableWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) EnableWallet(context.Context, *EnableWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) ArchiveWallet(context.Context, *ArchiveWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteWallet(context.Context, *DeleteWalletRequest) (*WalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) ListAuditEntries(context.Context, *ListAuditEntriesRequest) (*ListAuditEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEntries not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifyAuditLog(context.Context, *VerifyAuditLogRequest) (*VerifyAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditLog not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedWalletServiceServer) ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
+}
+func (UnimplementedWalletServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateTenant(context.Context, *Tenant) (*Tenant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenant not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTenant(context.Context, *GetTenantRequest) (*Tenant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenant not implemented")
+}
+func (UnimplementedWalletServiceServer) ListTenants(context.Context, *ListTenantsRequest) (*ListTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenants not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdateTenant(context.Context, *Tenant) (*Tenant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenant not implemented")
+}
+func (UnimplementedWalletServiceServer) ListNetworks(context.Context, *ListNetworksRequest) (*ListNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworks not implemented")
+}
+func (UnimplementedWalletServiceServer) AddNetwork(context.Context, *AddNetworkRequest) (*NetworkInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNetwork not implemented")
+}
+func (UnimplementedWalletServiceServer) DisableNetwork(context.Context, *NetworkRequest) (*NetworkInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableNetwork not implemented")
+}
+func (UnimplementedWalletServiceServer) EnableNetwork(context.Context, *NetworkRequest) (*NetworkInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableNetwork not implemented")
+}
+func (UnimplementedWalletServiceServer) CreatePolicy(context.Context, *Policy) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedWalletServiceServer) GetPolicy(context.Context, *GetPolicyRequest) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicy not implemented")
+}
+func (UnimplementedWalletServiceServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdatePolicy(context.Context, *Policy) (*Policy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+func (UnimplementedWalletServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedWalletServiceServer) ListPolicyVersions(context.Context, *GetPolicyRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicyVersions not implemented")
+}
+func (UnimplementedWalletServiceServer) DryRunPolicy(context.Context, *DryRunPolicyRequest) (*DryRunPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunPolicy not implemented")
+}
+func (UnimplementedWalletServiceServer) ListApprovals(context.Context, *ListApprovalsRequest) (*ListApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApprovals not implemented")
+}
+func (UnimplementedWalletServiceServer) GetApproval(context.Context, *GetApprovalRequest) (*ApprovalRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApproval not implemented")
+}
+func (UnimplementedWalletServiceServer) ApproveRequest(context.Context, *DecideApprovalRequest) (*ApprovalRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) RejectRequest(context.Context, *DecideApprovalRequest) (*ApprovalRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
+func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletServiceServer will
+// result in compilation errors.
+type UnsafeWalletServiceServer interface {
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWalletServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletService_ServiceDesc, srv)
+}
+
+func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CreateWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListWallets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListWallets(ctx, req.(*ListWalletsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignSerializedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSerializedTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignSerializedTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignSerializedTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignSerializedTransaction(ctx, req.(*SignSerializedTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignPSBT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPSBTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignPSBT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignPSBT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignPSBT(ctx, req.(*SignPSBTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SimulateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ContractCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ContractCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ContractCall_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ContractCall(ctx, req.(*ContractCallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TransferToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TransferToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_TransferToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TransferToken(ctx, req.(*TransferTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ApproveToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ApproveToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ApproveToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ApproveToken(ctx, req.(*ApproveTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignPermit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPermitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignPermit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignPermit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignPermit(ctx, req.(*SignPermitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignUserOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignUserOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignUserOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignUserOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignUserOperation(ctx, req.(*SignUserOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignAuthorization(ctx, req.(*SignAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ProposeSafeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposeSafeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ProposeSafeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ProposeSafeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ProposeSafeTransaction(ctx, req.(*ProposeSafeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetSafeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSafeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetSafeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetSafeProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetSafeProposal(ctx, req.(*GetSafeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListSafeProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSafeProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListSafeProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListSafeProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListSafeProposals(ctx, req.(*ListSafeProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignSafeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignSafeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignSafeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignSafeProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignSafeProposal(ctx, req.(*SignSafeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ExecuteSafeProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteSafeProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExecuteSafeProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ExecuteSafeProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExecuteSafeProposal(ctx, req.(*ExecuteSafeProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DecodeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DecodeTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DecodeTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DecodeTransaction(ctx, req.(*DecodeTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DecodeCalldata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeCalldataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DecodeCalldata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DecodeCalldata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DecodeCalldata(ctx, req.(*DecodeCalldataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_IssueSIWENonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueSIWENonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).IssueSIWENonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_IssueSIWENonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).IssueSIWENonce(ctx, req.(*IssueSIWENonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignInWithEthereum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignInWithEthereumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SignInWithEthereum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_SignInWithEthereum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SignInWithEthereum(ctx, req.(*SignInWithEthereumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifySIWE_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySIWERequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifySIWE(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifySIWE_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifySIWE(ctx, req.(*VerifySIWERequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifySignature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySignatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifySignature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifySignature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifySignature(ctx, req.(*VerifySignatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DisableWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DisableWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DisableWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DisableWallet(ctx, req.(*DisableWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_EnableWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).EnableWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_EnableWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).EnableWallet(ctx, req.(*EnableWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ArchiveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ArchiveWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ArchiveWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ArchiveWallet(ctx, req.(*ArchiveWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeleteWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeleteWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DeleteWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeleteWallet(ctx, req.(*DeleteWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListAuditEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListAuditEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListAuditEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListAuditEntries(ctx, req.(*ListAuditEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifyAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifyAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_VerifyAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifyAuditLog(ctx, req.(*VerifyAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CreateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTenant(ctx, req.(*GetTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListTenants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListTenants(ctx, req.(*ListTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_UpdateTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Tenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdateTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_UpdateTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdateTenant(ctx, req.(*Tenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListNetworks(ctx, req.(*ListNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AddNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_AddNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddNetwork(ctx, req.(*AddNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// WalletServiceServer is the server API for WalletService service.
-// All implementations must embed UnimplementedWalletServiceServer
-// for forward compatibility.
-type WalletServiceServer interface {
-	CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error)
-	GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error)
-	ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error)
-	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
-	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
-	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
-	mustEmbedUnimplementedWalletServiceServer()
+func _WalletService_DisableNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DisableNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DisableNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DisableNetwork(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedWalletServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedWalletServiceServer struct{}
-
-func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
-}
-func (UnimplementedWalletServiceServer) GetWallet(context.Context, *GetWalletRequest) (*WalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
-}
-func (UnimplementedWalletServiceServer) ListWallets(context.Context, *ListWalletsRequest) (*ListWalletsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+func _WalletService_EnableNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).EnableNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_EnableNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).EnableNetwork(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedWalletServiceServer) SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
+
+func _WalletService_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_CreatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreatePolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedWalletServiceServer) SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+
+func _WalletService_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetPolicy(ctx, req.(*GetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedWalletServiceServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+
+func _WalletService_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ListPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
-func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to WalletServiceServer will
-// result in compilation errors.
-type UnsafeWalletServiceServer interface {
-	mustEmbedUnimplementedWalletServiceServer()
+func _WalletService_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Policy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_UpdatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdatePolicy(ctx, req.(*Policy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
-	// If the following call pancis, it indicates UnimplementedWalletServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _WalletService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&WalletService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_DeletePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
+func _WalletService_ListPolicyVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateWallet(ctx, in)
+		return srv.(WalletServiceServer).ListPolicyVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_CreateWallet_FullMethodName,
+		FullMethod: WalletService_ListPolicyVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+		return srv.(WalletServiceServer).ListPolicyVersions(ctx, req.(*GetPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletRequest)
+func _WalletService_DryRunPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetWallet(ctx, in)
+		return srv.(WalletServiceServer).DryRunPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_GetWallet_FullMethodName,
+		FullMethod: WalletService_DryRunPolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetWallet(ctx, req.(*GetWalletRequest))
+		return srv.(WalletServiceServer).DryRunPolicy(ctx, req.(*DryRunPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWalletsRequest)
+func _WalletService_ListApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApprovalsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ListWallets(ctx, in)
+		return srv.(WalletServiceServer).ListApprovals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_ListWallets_FullMethodName,
+		FullMethod: WalletService_ListApprovals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ListWallets(ctx, req.(*ListWalletsRequest))
+		return srv.(WalletServiceServer).ListApprovals(ctx, req.(*ListApprovalsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignMessageRequest)
+func _WalletService_GetApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignMessage(ctx, in)
+		return srv.(WalletServiceServer).GetApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_SignMessage_FullMethodName,
+		FullMethod: WalletService_GetApproval_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
+		return srv.(WalletServiceServer).GetApproval(ctx, req.(*GetApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionRequest)
+func _WalletService_ApproveRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+		return srv.(WalletServiceServer).ApproveRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_SignTransaction_FullMethodName,
+		FullMethod: WalletService_ApproveRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+		return srv.(WalletServiceServer).ApproveRequest(ctx, req.(*DecideApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
+func _WalletService_RejectRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetBalance(ctx, in)
+		return srv.(WalletServiceServer).RejectRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WalletService_GetBalance_FullMethodName,
+		FullMethod: WalletService_RejectRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetBalance(ctx, req.(*GetBalanceRequest))
+		return srv.(WalletServiceServer).RejectRequest(ctx, req.(*DecideApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -295,10 +1927,202 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SignTransaction",
 			Handler:    _WalletService_SignTransaction_Handler,
 		},
+		{
+			MethodName: "SignSerializedTransaction",
+			Handler:    _WalletService_SignSerializedTransaction_Handler,
+		},
+		{
+			MethodName: "SignPSBT",
+			Handler:    _WalletService_SignPSBT_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _WalletService_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "ContractCall",
+			Handler:    _WalletService_ContractCall_Handler,
+		},
+		{
+			MethodName: "TransferToken",
+			Handler:    _WalletService_TransferToken_Handler,
+		},
+		{
+			MethodName: "ApproveToken",
+			Handler:    _WalletService_ApproveToken_Handler,
+		},
+		{
+			MethodName: "SignPermit",
+			Handler:    _WalletService_SignPermit_Handler,
+		},
+		{
+			MethodName: "SignUserOperation",
+			Handler:    _WalletService_SignUserOperation_Handler,
+		},
+		{
+			MethodName: "SignAuthorization",
+			Handler:    _WalletService_SignAuthorization_Handler,
+		},
+		{
+			MethodName: "ProposeSafeTransaction",
+			Handler:    _WalletService_ProposeSafeTransaction_Handler,
+		},
+		{
+			MethodName: "GetSafeProposal",
+			Handler:    _WalletService_GetSafeProposal_Handler,
+		},
+		{
+			MethodName: "ListSafeProposals",
+			Handler:    _WalletService_ListSafeProposals_Handler,
+		},
+		{
+			MethodName: "SignSafeProposal",
+			Handler:    _WalletService_SignSafeProposal_Handler,
+		},
+		{
+			MethodName: "ExecuteSafeProposal",
+			Handler:    _WalletService_ExecuteSafeProposal_Handler,
+		},
+		{
+			MethodName: "DecodeTransaction",
+			Handler:    _WalletService_DecodeTransaction_Handler,
+		},
+		{
+			MethodName: "DecodeCalldata",
+			Handler:    _WalletService_DecodeCalldata_Handler,
+		},
+		{
+			MethodName: "IssueSIWENonce",
+			Handler:    _WalletService_IssueSIWENonce_Handler,
+		},
+		{
+			MethodName: "SignInWithEthereum",
+			Handler:    _WalletService_SignInWithEthereum_Handler,
+		},
+		{
+			MethodName: "VerifySIWE",
+			Handler:    _WalletService_VerifySIWE_Handler,
+		},
+		{
+			MethodName: "VerifySignature",
+			Handler:    _WalletService_VerifySignature_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _WalletService_GetBalance_Handler,
 		},
+		{
+			MethodName: "DisableWallet",
+			Handler:    _WalletService_DisableWallet_Handler,
+		},
+		{
+			MethodName: "EnableWallet",
+			Handler:    _WalletService_EnableWallet_Handler,
+		},
+		{
+			MethodName: "ArchiveWallet",
+			Handler:    _WalletService_ArchiveWallet_Handler,
+		},
+		{
+			MethodName: "DeleteWallet",
+			Handler:    _WalletService_DeleteWallet_Handler,
+		},
+		{
+			MethodName: "ListAuditEntries",
+			Handler:    _WalletService_ListAuditEntries_Handler,
+		},
+		{
+			MethodName: "VerifyAuditLog",
+			Handler:    _WalletService_VerifyAuditLog_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _WalletService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "ListAPIKeys",
+			Handler:    _WalletService_ListAPIKeys_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _WalletService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "CreateTenant",
+			Handler:    _WalletService_CreateTenant_Handler,
+		},
+		{
+			MethodName: "GetTenant",
+			Handler:    _WalletService_GetTenant_Handler,
+		},
+		{
+			MethodName: "ListTenants",
+			Handler:    _WalletService_ListTenants_Handler,
+		},
+		{
+			MethodName: "UpdateTenant",
+			Handler:    _WalletService_UpdateTenant_Handler,
+		},
+		{
+			MethodName: "ListNetworks",
+			Handler:    _WalletService_ListNetworks_Handler,
+		},
+		{
+			MethodName: "AddNetwork",
+			Handler:    _WalletService_AddNetwork_Handler,
+		},
+		{
+			MethodName: "DisableNetwork",
+			Handler:    _WalletService_DisableNetwork_Handler,
+		},
+		{
+			MethodName: "EnableNetwork",
+			Handler:    _WalletService_EnableNetwork_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _WalletService_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "GetPolicy",
+			Handler:    _WalletService_GetPolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _WalletService_ListPolicies_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _WalletService_UpdatePolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _WalletService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicyVersions",
+			Handler:    _WalletService_ListPolicyVersions_Handler,
+		},
+		{
+			MethodName: "DryRunPolicy",
+			Handler:    _WalletService_DryRunPolicy_Handler,
+		},
+		{
+			MethodName: "ListApprovals",
+			Handler:    _WalletService_ListApprovals_Handler,
+		},
+		{
+			MethodName: "GetApproval",
+			Handler:    _WalletService_GetApproval_Handler,
+		},
+		{
+			MethodName: "ApproveRequest",
+			Handler:    _WalletService_ApproveRequest_Handler,
+		},
+		{
+			MethodName: "RejectRequest",
+			Handler:    _WalletService_RejectRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/api/grpc/wallet.proto",
@@ -0,0 +1,53 @@
+package http
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) listApprovals(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	status := service.ApprovalStatus(r.URL.Query().Get("status"))
+	requests, err := b.wallets.ListApprovals(r.Context(), status)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, requests)
+}
+
+func (b *RouteBuilder) getApproval(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	request, err := b.wallets.GetApproval(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, request)
+}
+
+func (b *RouteBuilder) approvalDecision(decide func(ctx context.Context, id string, comment string) (*service.ApprovalRequest, error)) stdhttp.HandlerFunc {
+	return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		var payload struct {
+			Comment string `json:"comment"`
+		}
+		if r.ContentLength != 0 {
+			if err := decodeJSON(r, &payload); err != nil {
+				writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+				return
+			}
+		}
+
+		request, err := decide(r.Context(), chi.URLParam(r, "id"), payload.Comment)
+		if err != nil {
+			handleServiceError(w, err)
+			return
+		}
+
+		writeJSON(w, stdhttp.StatusOK, request)
+	}
+}
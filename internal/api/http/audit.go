@@ -0,0 +1,59 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) listAuditEntries(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	query := r.URL.Query()
+	filter := service.AuditFilter{
+		WalletID:  query.Get("walletId"),
+		Actor:     query.Get("actor"),
+		Operation: query.Get("operation"),
+	}
+
+	var err error
+	if filter.Since, err = parseTimeParam(query.Get("since")); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid since timestamp")
+		return
+	}
+	if filter.Until, err = parseTimeParam(query.Get("until")); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid until timestamp")
+		return
+	}
+	if limit := query.Get("limit"); limit != "" {
+		if filter.Limit, err = strconv.Atoi(limit); err != nil || filter.Limit < 0 {
+			writeError(w, stdhttp.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+
+	entries, err := b.audits.ListEntries(r.Context(), filter)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, entries)
+}
+
+func (b *RouteBuilder) verifyAuditLog(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	result, err := b.audits.Verify(r.Context())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
+
+func parseTimeParam(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// authenticate resolves the bearer token to a principal and rejects the
+// request when it is missing or invalid.
+func (b *RouteBuilder) authenticate(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		principal, err := b.auth.Authenticate(r.Context(), bearerToken(r))
+		if err != nil {
+			handleServiceError(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(service.ContextWithPrincipal(r.Context(), principal)))
+	})
+}
+
+func (b *RouteBuilder) require(scope service.Scope) func(stdhttp.Handler) stdhttp.Handler {
+	return func(next stdhttp.Handler) stdhttp.Handler {
+		return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+			if err := b.auth.Authorize(r.Context(), scope); err != nil {
+				handleServiceError(w, err)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func bearerToken(r *stdhttp.Request) string {
+	header := r.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
+
+func (b *RouteBuilder) createAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload struct {
+		Name     string   `json:"name"`
+		TenantID string   `json:"tenantId"`
+		Scopes   []string `json:"scopes"`
+	}
+
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	scopes, err := service.ParseScopes(payload.Scopes)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	key, err := b.apiKeys.CreateAPIKey(r.Context(), payload.Name, payload.TenantID, scopes)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, key)
+}
+
+func (b *RouteBuilder) listAPIKeys(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	keys, err := b.apiKeys.ListAPIKeys(r.Context())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, keys)
+}
+
+func (b *RouteBuilder) revokeAPIKey(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, stdhttp.StatusBadRequest, "api key id is required")
+		return
+	}
+
+	key, err := b.apiKeys.RevokeAPIKey(r.Context(), id)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, key)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type contractCallPayload struct {
+	Contract string `json:"contract"`
+	// ABI is a JSON ABI or fragment, or a string holding one or a
+	// human-readable signature.
+	ABI      json.RawMessage          `json:"abi"`
+	Method   string                   `json:"method"`
+	Args     []json.RawMessage        `json:"args"`
+	Mode     service.ContractCallMode `json:"mode"`
+	ChainID  int64                    `json:"chainId"`
+	Value    string                   `json:"value"`
+	GasLimit uint64                   `json:"gasLimit"`
+	GasPrice string                   `json:"gasPrice"`
+	Nonce    uint64                   `json:"nonce"`
+	Force    bool                     `json:"force"`
+}
+
+func (p contractCallPayload) toRequest() service.ContractCallRequest {
+	return service.ContractCallRequest{
+		Contract: p.Contract,
+		ABI:      abiDefinition(p.ABI),
+		Method:   p.Method,
+		Args:     p.Args,
+		Mode:     p.Mode,
+		ChainID:  p.ChainID,
+		Value:    p.Value,
+		GasLimit: p.GasLimit,
+		GasPrice: p.GasPrice,
+		Nonce:    p.Nonce,
+		Force:    p.Force,
+	}
+}
+
+// abiDefinition accepts an ABI embedded as JSON or as a JSON string.
+func abiDefinition(raw json.RawMessage) string {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+	return string(raw)
+}
+
+func (b *RouteBuilder) contractCall(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload contractCallPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.contracts.ContractCall(r.Context(), chi.URLParam(r, "id"), payload.toRequest())
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
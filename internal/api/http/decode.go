@@ -0,0 +1,44 @@
+package http
+
+import (
+	"encoding/json"
+	stdhttp "net/http"
+)
+
+func (b *RouteBuilder) decodeTransaction(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload struct {
+		RawTransaction string          `json:"rawTransaction"`
+		ABI            json.RawMessage `json:"abi"`
+	}
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	decoded, err := b.decoder.DecodeTransaction(r.Context(), payload.RawTransaction, abiDefinition(payload.ABI))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, decoded)
+}
+
+func (b *RouteBuilder) decodeCalldata(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload struct {
+		Data string          `json:"data"`
+		ABI  json.RawMessage `json:"abi"`
+	}
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	call, err := b.decoder.DecodeCalldata(r.Context(), payload.Data, abiDefinition(payload.ABI))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, call)
+}
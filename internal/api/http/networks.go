@@ -0,0 +1,50 @@
+package http
+
+import (
+	"context"
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/config"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) listNetworks(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	networks, err := b.networks.ListNetworks(r.Context())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, networks)
+}
+
+// addNetwork takes a network in chainlist form, as in the networks file.
+func (b *RouteBuilder) addNetwork(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload config.ChainlistNetwork
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	network, err := b.networks.AddNetwork(r.Context(), payload)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, network)
+}
+
+func (b *RouteBuilder) networkTransition(apply func(ctx context.Context, key string) (*service.NetworkInfo, error)) stdhttp.HandlerFunc {
+	return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		network, err := apply(r.Context(), chi.URLParam(r, "network"))
+		if err != nil {
+			handleServiceError(w, err)
+			return
+		}
+
+		writeJSON(w, stdhttp.StatusOK, network)
+	}
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type policyPayload struct {
+	Name       string              `json:"name"`
+	WalletID   string              `json:"walletId"`
+	Networks   []string            `json:"networks"`
+	Rules      service.PolicyRules `json:"rules"`
+	Expression string              `json:"expression"`
+}
+
+func (p policyPayload) toPolicy() service.Policy {
+	return service.Policy{
+		Name:       p.Name,
+		WalletID:   p.WalletID,
+		Networks:   p.Networks,
+		Rules:      p.Rules,
+		Expression: p.Expression,
+	}
+}
+
+type dryRunPayload struct {
+	Version     int                 `json:"version"`
+	Policy      *policyPayload      `json:"policy"`
+	WalletID    string              `json:"walletId"`
+	Transaction service.Transaction `json:"transaction"`
+}
+
+func (b *RouteBuilder) createPolicy(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload policyPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	policy, err := b.policies.CreatePolicy(r.Context(), payload.toPolicy())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, policy)
+}
+
+func (b *RouteBuilder) listPolicies(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	policies, err := b.policies.ListPolicies(r.Context(), r.URL.Query().Get("walletId"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, policies)
+}
+
+func (b *RouteBuilder) getPolicy(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	policy, err := b.policies.GetPolicy(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, policy)
+}
+
+func (b *RouteBuilder) updatePolicy(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload policyPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+	policy := payload.toPolicy()
+	policy.ID = chi.URLParam(r, "id")
+
+	updated, err := b.policies.UpdatePolicy(r.Context(), policy)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, updated)
+}
+
+func (b *RouteBuilder) deletePolicy(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	if err := b.policies.DeletePolicy(r.Context(), chi.URLParam(r, "id")); err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	w.WriteHeader(stdhttp.StatusNoContent)
+}
+
+func (b *RouteBuilder) listPolicyVersions(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	versions, err := b.policies.ListPolicyVersions(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, versions)
+}
+
+// dryRunPolicy evaluates a transaction against the stored policy named in the
+// path, or against the candidate policy in the body when there is none.
+func (b *RouteBuilder) dryRunPolicy(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload dryRunPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	req := service.PolicyDryRunRequest{
+		PolicyID:    chi.URLParam(r, "id"),
+		Version:     payload.Version,
+		WalletID:    payload.WalletID,
+		Transaction: payload.Transaction,
+	}
+	if req.PolicyID == "" {
+		if payload.Policy == nil {
+			writeError(w, stdhttp.StatusBadRequest, "policy is required")
+			return
+		}
+		candidate := payload.Policy.toPolicy()
+		req.Policy = &candidate
+	}
+
+	result, err := b.policies.DryRunPolicy(r.Context(), req)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
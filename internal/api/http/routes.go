@@ -1,7 +1,9 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	stdhttp "net/http"
 
 	"github.com/go-chi/chi/v5"
@@ -9,29 +11,139 @@ import (
 	"github.com/rickyreddygari/walletsdk/internal/service"
 )
 
+// Services groups the application services exposed over HTTP.
+type Services struct {
+	Wallets    service.WalletService
+	Balances   service.BalanceService
+	Audits     service.AuditService
+	APIKeys    service.APIKeyService
+	Tenants    service.TenantService
+	Policies   service.PolicyService
+	Contracts  service.ContractService
+	Decoder    service.DecoderService
+	SIWE       service.SIWEService
+	Signatures service.SignatureService
+	Networks   service.NetworkService
+	Authorizer *service.Authorizer
+}
+
 type RouteBuilder struct {
-	wallets  service.WalletService
-	balances service.BalanceService
+	wallets    service.WalletService
+	balances   service.BalanceService
+	audits     service.AuditService
+	apiKeys    service.APIKeyService
+	tenants    service.TenantService
+	policies   service.PolicyService
+	contracts  service.ContractService
+	decoder    service.DecoderService
+	siwe       service.SIWEService
+	signatures service.SignatureService
+	networks   service.NetworkService
+	auth       *service.Authorizer
 }
 
-func NewRouteBuilder(wallets service.WalletService, balances service.BalanceService) *RouteBuilder {
-	return &RouteBuilder{wallets: wallets, balances: balances}
+func NewRouteBuilder(svc Services) *RouteBuilder {
+	return &RouteBuilder{
+		wallets:    svc.Wallets,
+		balances:   svc.Balances,
+		audits:     svc.Audits,
+		apiKeys:    svc.APIKeys,
+		tenants:    svc.Tenants,
+		policies:   svc.Policies,
+		contracts:  svc.Contracts,
+		decoder:    svc.Decoder,
+		siwe:       svc.SIWE,
+		signatures: svc.Signatures,
+		networks:   svc.Networks,
+		auth:       svc.Authorizer,
+	}
 }
 
 func (b *RouteBuilder) Register(r *chi.Mux) {
 	r.Route("/v1", func(r chi.Router) {
-		r.Post("/wallets", b.createWallet)
-		r.Get("/wallets/{id}", b.getWallet)
-		r.Get("/wallets", b.listWallets)
-		r.Post("/wallets/{id}/sign-message", b.signMessage)
-		r.Post("/wallets/{id}/sign-transaction", b.signTransaction)
-		r.Get("/wallets/{id}/balance", b.getBalance)
+		r.Use(b.authenticate)
+
+		r.Group(func(r chi.Router) {
+			r.Use(b.require(service.ScopeWalletsRead))
+			r.Get("/wallets/{id}", b.getWallet)
+			r.Get("/wallets", b.listWallets)
+			r.Get("/wallets/{id}/balance", b.getBalance)
+			r.Get("/approvals", b.listApprovals)
+			r.Get("/approvals/{id}", b.getApproval)
+			r.Post("/decode/transaction", b.decodeTransaction)
+			r.Post("/decode/calldata", b.decodeCalldata)
+			r.Post("/siwe/nonce", b.issueSIWENonce)
+			r.Post("/siwe/verify", b.verifySIWE)
+			r.Post("/signatures/verify", b.verifySignature)
+			r.Get("/safe-transactions", b.listSafeProposals)
+			r.Get("/safe-transactions/{id}", b.getSafeProposal)
+			r.Get("/networks", b.listNetworks)
+		})
+
+		r.With(b.require(service.ScopeWalletsCreate)).Post("/wallets", b.createWallet)
+
+		r.Group(func(r chi.Router) {
+			r.Use(b.require(service.ScopeSign))
+			r.Post("/wallets/{id}/sign-message", b.signMessage)
+			r.Post("/wallets/{id}/sign-transaction", b.signTransaction)
+			r.Post("/wallets/{id}/sign-serialized-transaction", b.signSerializedTransaction)
+			r.Post("/wallets/{id}/sign-psbt", b.signPSBT)
+			r.Post("/wallets/{id}/simulate", b.simulateTransaction)
+			r.Post("/wallets/{id}/contract-call", b.contractCall)
+			r.Post("/wallets/{id}/transfer-token", b.transferToken)
+			r.Post("/wallets/{id}/approve-token", b.approveToken)
+			r.Post("/wallets/{id}/permit", b.signPermit)
+			r.Post("/wallets/{id}/siwe", b.signInWithEthereum)
+			r.Post("/wallets/{id}/user-operation", b.signUserOperation)
+			r.Post("/wallets/{id}/authorization", b.signAuthorization)
+			r.Post("/safe-transactions", b.proposeSafeTransaction)
+			r.Post("/wallets/{id}/safe-transactions/{proposalId}/sign", b.signSafeProposal)
+			r.Post("/wallets/{id}/safe-transactions/{proposalId}/execute", b.executeSafeProposal)
+			r.Post("/approvals/{id}/approve", b.approvalDecision(b.wallets.ApproveRequest))
+			r.Post("/approvals/{id}/reject", b.approvalDecision(b.wallets.RejectRequest))
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(b.require(service.ScopeAdmin))
+			r.Post("/wallets/{id}/disable", b.walletTransition(b.wallets.DisableWallet))
+			r.Post("/wallets/{id}/enable", b.walletTransition(b.wallets.EnableWallet))
+			r.Post("/wallets/{id}/archive", b.walletTransition(b.wallets.ArchiveWallet))
+			r.Delete("/wallets/{id}", b.walletTransition(b.wallets.DeleteWallet))
+			r.Get("/audit", b.listAuditEntries)
+			r.Get("/audit/verify", b.verifyAuditLog)
+			r.Post("/api-keys", b.createAPIKey)
+			r.Get("/api-keys", b.listAPIKeys)
+			r.Delete("/api-keys/{id}", b.revokeAPIKey)
+			r.Post("/policies", b.createPolicy)
+			r.Get("/policies", b.listPolicies)
+			r.Get("/policies/{id}", b.getPolicy)
+			r.Put("/policies/{id}", b.updatePolicy)
+			r.Delete("/policies/{id}", b.deletePolicy)
+			r.Get("/policies/{id}/versions", b.listPolicyVersions)
+			r.Post("/policies/dry-run", b.dryRunPolicy)
+			r.Post("/policies/{id}/dry-run", b.dryRunPolicy)
+		})
+
+		r.Group(func(r chi.Router) {
+			r.Use(b.require(service.ScopePlatform))
+			r.Post("/tenants", b.createTenant)
+			r.Get("/tenants", b.listTenants)
+			r.Get("/tenants/{id}", b.getTenant)
+			r.Put("/tenants/{id}", b.updateTenant)
+			r.Post("/networks", b.addNetwork)
+			r.Post("/networks/{network}/disable", b.networkTransition(b.networks.DisableNetwork))
+			r.Post("/networks/{network}/enable", b.networkTransition(b.networks.EnableNetwork))
+		})
 	})
 }
 
 func (b *RouteBuilder) createWallet(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 	var payload struct {
-		Network string `json:"network"`
+		Network     string              `json:"network"`
+		AccountType service.AccountType `json:"accountType"`
+		Factory     string              `json:"factory"`
+		Salt        string              `json:"salt"`
+		AddressType service.AddressType `json:"addressType"`
 	}
 
 	if err := decodeJSON(r, &payload); err != nil {
@@ -39,9 +151,23 @@ func (b *RouteBuilder) createWallet(w stdhttp.ResponseWriter, r *stdhttp.Request
 		return
 	}
 
-	wallet, err := b.wallets.CreateWallet(r.Context(), payload.Network)
+	var opts []service.CreateWalletOption
+	if payload.AccountType != "" {
+		opts = append(opts, service.WithAccountType(payload.AccountType))
+	}
+	if payload.Factory != "" {
+		opts = append(opts, service.WithAccountFactory(payload.Factory))
+	}
+	if payload.Salt != "" {
+		opts = append(opts, service.WithAccountSalt(payload.Salt))
+	}
+	if payload.AddressType != "" {
+		opts = append(opts, service.WithAddressType(payload.AddressType))
+	}
+
+	wallet, err := b.wallets.CreateWallet(r.Context(), payload.Network, opts...)
 	if err != nil {
-		writeError(w, stdhttp.StatusInternalServerError, err.Error())
+		handleServiceError(w, err)
 		return
 	}
 
@@ -68,7 +194,7 @@ func (b *RouteBuilder) listWallets(w stdhttp.ResponseWriter, r *stdhttp.Request)
 	network := r.URL.Query().Get("network")
 	wallets, err := b.wallets.ListWallets(r.Context(), network)
 	if err != nil {
-		writeError(w, stdhttp.StatusInternalServerError, err.Error())
+		handleServiceError(w, err)
 		return
 	}
 
@@ -105,16 +231,24 @@ func (b *RouteBuilder) signTransaction(w stdhttp.ResponseWriter, r *stdhttp.Requ
 		writeError(w, stdhttp.StatusBadRequest, "wallet id is required")
 		return
 	}
-	var payload service.Transaction
+	var payload struct {
+		service.Transaction
+		// Force signs even when simulation shows the transaction reverting.
+		Force bool `json:"force"`
+	}
 
 	if err := decodeJSON(r, &payload); err != nil {
 		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
 		return
 	}
 
-	signed, err := b.wallets.SignTransaction(r.Context(), id, &payload)
+	var opts []service.SignOption
+	if payload.Force {
+		opts = append(opts, service.ForceSign())
+	}
+	signed, err := b.wallets.SignTransaction(r.Context(), id, &payload.Transaction, opts...)
 	if err != nil {
-		handleServiceError(w, err)
+		handleSigningError(w, err)
 		return
 	}
 
@@ -127,7 +261,11 @@ func (b *RouteBuilder) getBalance(w stdhttp.ResponseWriter, r *stdhttp.Request)
 		writeError(w, stdhttp.StatusBadRequest, "wallet id is required")
 		return
 	}
-	balance, err := b.balances.GetBalance(r.Context(), id)
+	var opts []service.BalanceOption
+	if token := r.URL.Query().Get("token"); token != "" {
+		opts = append(opts, service.WithBalanceToken(token))
+	}
+	balance, err := b.balances.GetBalance(r.Context(), id, opts...)
 	if err != nil {
 		handleServiceError(w, err)
 		return
@@ -136,14 +274,61 @@ func (b *RouteBuilder) getBalance(w stdhttp.ResponseWriter, r *stdhttp.Request)
 	writeJSON(w, stdhttp.StatusOK, balance)
 }
 
+func (b *RouteBuilder) walletTransition(apply func(ctx context.Context, id string) (*service.Wallet, error)) stdhttp.HandlerFunc {
+	return func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		id := chi.URLParam(r, "id")
+		if id == "" {
+			writeError(w, stdhttp.StatusBadRequest, "wallet id is required")
+			return
+		}
+
+		wallet, err := apply(r.Context(), id)
+		if err != nil {
+			handleServiceError(w, err)
+			return
+		}
+
+		writeJSON(w, stdhttp.StatusOK, wallet)
+	}
+}
+
+// handleSigningError reports a queued transaction as 202 with its approval
+// request, and a reverted simulation as 422 with the simulation attached.
+func handleSigningError(w stdhttp.ResponseWriter, err error) {
+	var pending *service.ApprovalPendingError
+	if errors.As(err, &pending) {
+		writeJSON(w, stdhttp.StatusAccepted, pending.Request)
+		return
+	}
+	var reverted *service.SimulationRevertedError
+	if errors.As(err, &reverted) {
+		writeJSON(w, stdhttp.StatusUnprocessableEntity, map[string]interface{}{
+			"error":      err.Error(),
+			"simulation": reverted.Result,
+		})
+		return
+	}
+	handleServiceError(w, err)
+}
+
 func handleServiceError(w stdhttp.ResponseWriter, err error) {
-	switch err {
-	case service.ErrNotFound:
+	switch {
+	case errors.Is(err, service.ErrNotFound):
 		writeError(w, stdhttp.StatusNotFound, "resource not found")
-	case service.ErrValidation:
+	case errors.Is(err, service.ErrValidation):
 		writeError(w, stdhttp.StatusBadRequest, err.Error())
-	case service.ErrNotImplemented:
+	case errors.Is(err, service.ErrNotImplemented):
 		writeError(w, stdhttp.StatusNotImplemented, err.Error())
+	case errors.Is(err, service.ErrWalletInactive), errors.Is(err, service.ErrConflict):
+		writeError(w, stdhttp.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrUnauthenticated):
+		writeError(w, stdhttp.StatusUnauthorized, err.Error())
+	case errors.Is(err, service.ErrForbidden):
+		writeError(w, stdhttp.StatusForbidden, err.Error())
+	case errors.Is(err, service.ErrQuotaExceeded):
+		writeError(w, stdhttp.StatusTooManyRequests, err.Error())
+	case errors.Is(err, service.ErrPolicyViolation), errors.Is(err, service.ErrSimulationReverted):
+		writeError(w, stdhttp.StatusUnprocessableEntity, err.Error())
 	default:
 		writeError(w, stdhttp.StatusInternalServerError, err.Error())
 	}
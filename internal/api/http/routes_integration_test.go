@@ -6,8 +6,13 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/service"
 	"github.com/rickyreddygari/walletsdk/internal/testutil"
 )
 
@@ -77,5 +82,568 @@ func mustRequest(t *testing.T, method, url string, body io.Reader) *http.Request
 		t.Fatalf("create request: %v", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+testutil.AdminAPIKey)
 	return req
 }
+
+func TestDisabledWalletCannotSign(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "base-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID string `json:"id"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/disable", server.URL, wallet.ID), nil))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var disabled struct {
+		Status string `json:"status"`
+	}
+	testutil.DecodeJSON(t, resp, &disabled)
+	if disabled.Status != "disabled" {
+		t.Fatalf("expected disabled status, got %s", disabled.Status)
+	}
+
+	msgBody, _ := json.Marshal(map[string]string{"message": "gm"})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/sign-message", server.URL, wallet.ID), bytes.NewReader(msgBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusConflict)
+
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodDelete, fmt.Sprintf("%s/v1/wallets/%s", server.URL, wallet.ID), nil))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+}
+
+func TestAPIKeyScopesAreEnforced(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+
+	client := server.Client()
+
+	req := mustRequest(t, http.MethodGet, server.URL+"/v1/wallets", nil)
+	req.Header.Del("Authorization")
+	resp := testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusUnauthorized)
+
+	keyBody, _ := json.Marshal(map[string]interface{}{"name": "reader", "scopes": []string{"wallets:read"}})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/api-keys", bytes.NewReader(keyBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var key struct {
+		ID    string `json:"id"`
+		Token string `json:"token"`
+	}
+	testutil.DecodeJSON(t, resp, &key)
+	if key.Token == "" {
+		t.Fatal("expected token in create response")
+	}
+
+	req = mustRequest(t, http.MethodGet, server.URL+"/v1/wallets", nil)
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	resp = testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	walletBody, _ := json.Marshal(map[string]string{"network": "base-sepolia"})
+	req = mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(walletBody))
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	resp = testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusForbidden)
+
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodDelete, fmt.Sprintf("%s/v1/api-keys/%s", server.URL, key.ID), nil))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	req = mustRequest(t, http.MethodGet, server.URL+"/v1/wallets", nil)
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	resp = testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusUnauthorized)
+}
+
+func TestTenantsCannotSeeEachOthersWallets(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+
+	client := server.Client()
+
+	tenantBody, _ := json.Marshal(map[string]interface{}{"id": "team-a", "allowedNetworks": []string{"base-sepolia"}})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/tenants", bytes.NewReader(tenantBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var tenant map[string]interface{}
+	testutil.DecodeJSON(t, resp, &tenant)
+	if tenant["id"] != "team-a" || tenant["allowedNetworks"] == nil {
+		t.Fatalf("expected camelCase tenant fields, got %v", tenant)
+	}
+
+	keyBody, _ := json.Marshal(map[string]interface{}{"name": "team-a-admin", "tenantId": "team-a", "scopes": []string{"admin"}})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/api-keys", bytes.NewReader(keyBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var key struct {
+		TenantID string `json:"tenantId"`
+		Token    string `json:"token"`
+	}
+	testutil.DecodeJSON(t, resp, &key)
+	if key.TenantID != "team-a" {
+		t.Fatalf("expected key in tenant team-a, got %q", key.TenantID)
+	}
+
+	walletBody, _ := json.Marshal(map[string]string{"network": "base-sepolia"})
+	req := mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(walletBody))
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	resp = testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID       string `json:"id"`
+		TenantID string `json:"tenantId"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+	if wallet.TenantID != "team-a" {
+		t.Fatalf("expected tenant team-a, got %s", wallet.TenantID)
+	}
+
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodGet, fmt.Sprintf("%s/v1/wallets/%s", server.URL, wallet.ID), nil))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusNotFound)
+
+	otherBody, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	req = mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(otherBody))
+	req.Header.Set("Authorization", "Bearer "+key.Token)
+	resp = testutil.MustDo(t, client, req)
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusForbidden)
+}
+
+func TestPolicyViolationReturnsUnprocessable(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID string `json:"id"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	policyBody, _ := json.Marshal(map[string]interface{}{
+		"name":     "cap",
+		"walletId": wallet.ID,
+		"rules":    map[string]string{"maxValue": "1000"},
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/policies", bytes.NewReader(policyBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	txBody, _ := json.Marshal(map[string]interface{}{
+		"chainId":  11155111,
+		"to":       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"value":    "0x2710",
+		"gasLimit": 21000,
+		"gasPrice": "0x1",
+		"nonce":    1,
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/sign-transaction", server.URL, wallet.ID), bytes.NewReader(txBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusUnprocessableEntity)
+}
+
+func TestExpressionPolicyDryRun(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID string `json:"id"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	policyBody, _ := json.Marshal(map[string]interface{}{
+		"name":       "cap",
+		"expression": "tx.value <= 1000.0",
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/policies", bytes.NewReader(policyBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var policy struct {
+		ID      string `json:"id"`
+		Version int    `json:"version"`
+	}
+	testutil.DecodeJSON(t, resp, &policy)
+
+	dryRunBody, _ := json.Marshal(map[string]interface{}{
+		"walletId": wallet.ID,
+		"transaction": map[string]interface{}{
+			"chainId":  11155111,
+			"to":       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"value":    "0x2710",
+			"gasLimit": 21000,
+			"gasPrice": "0x1",
+			"nonce":    1,
+		},
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/policies/%s/dry-run", server.URL, policy.ID), bytes.NewReader(dryRunBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var result struct {
+		Allowed bool   `json:"allowed"`
+		Version int    `json:"version"`
+		Rule    string `json:"rule"`
+	}
+	testutil.DecodeJSON(t, resp, &result)
+	if result.Allowed || result.Version != 1 || result.Rule != "expression" {
+		t.Fatalf("unexpected dry run result %+v", result)
+	}
+
+	invalidBody, _ := json.Marshal(map[string]interface{}{"name": "bad", "expression": "tx.value"})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/policies", bytes.NewReader(invalidBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusBadRequest)
+}
+
+func TestContractCallSignsEncodedCalldata(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID string `json:"id"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	callBody, _ := json.Marshal(map[string]interface{}{
+		"contract": "0xcccccccccccccccccccccccccccccccccccccccc",
+		"abi":      "transfer(address,uint256)",
+		"args":     []interface{}{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "1000"},
+		"gasLimit": 60000,
+		"gasPrice": "0x1",
+		"nonce":    1,
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/contract-call", server.URL, wallet.ID), bytes.NewReader(callBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var result struct {
+		Data              string `json:"data"`
+		SignedTransaction string `json:"signedTransaction"`
+	}
+	testutil.DecodeJSON(t, resp, &result)
+	want := "0xa9059cbb" +
+		"000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" +
+		"00000000000000000000000000000000000000000000000000000000000003e8"
+	if result.Data != want || result.SignedTransaction == "" {
+		t.Fatalf("unexpected contract call result %+v", result)
+	}
+
+	badBody, _ := json.Marshal(map[string]interface{}{
+		"contract": "0xcccccccccccccccccccccccccccccccccccccccc",
+		"abi":      "transfer(address,uint256)",
+		"args":     []interface{}{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/contract-call", server.URL, wallet.ID), bytes.NewReader(badBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusBadRequest)
+}
+
+func TestDecodeSignedContractCall(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID      string `json:"id"`
+		Address string `json:"address"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	callBody, _ := json.Marshal(map[string]interface{}{
+		"contract": "0xcccccccccccccccccccccccccccccccccccccccc",
+		"abi":      "approve(address,uint256)",
+		"args":     []interface{}{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "1000"},
+		"gasLimit": 60000,
+		"gasPrice": "0x1",
+		"nonce":    1,
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/contract-call", server.URL, wallet.ID), bytes.NewReader(callBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var signed struct {
+		SignedTransaction string `json:"signedTransaction"`
+	}
+	testutil.DecodeJSON(t, resp, &signed)
+
+	decodeBody, _ := json.Marshal(map[string]string{"rawTransaction": signed.SignedTransaction})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/decode/transaction", bytes.NewReader(decodeBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var decoded struct {
+		From  string `json:"from"`
+		Nonce uint64 `json:"nonce"`
+		Call  struct {
+			Method    string   `json:"method"`
+			Standards []string `json:"standards"`
+			Args      []struct {
+				Name  string      `json:"name"`
+				Value interface{} `json:"value"`
+			} `json:"args"`
+		} `json:"call"`
+	}
+	testutil.DecodeJSON(t, resp, &decoded)
+	if !strings.EqualFold(decoded.From, wallet.Address) || decoded.Nonce != 1 {
+		t.Fatalf("unexpected decoded transaction %+v", decoded)
+	}
+	if decoded.Call.Method != "approve" || len(decoded.Call.Args) != 2 || decoded.Call.Args[1].Value != "1000" {
+		t.Fatalf("unexpected decoded call %+v", decoded.Call)
+	}
+
+	badBody, _ := json.Marshal(map[string]string{"data": "0x12"})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/decode/calldata", bytes.NewReader(badBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusBadRequest)
+}
+
+func TestTransferTokenSignsWithRegisteredToken(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID string `json:"id"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	transferBody, _ := json.Marshal(map[string]interface{}{
+		"token":    "USDC",
+		"to":       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		"amount":   "2.5",
+		"gasLimit": 65000,
+		"gasPrice": "0x1",
+		"nonce":    1,
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/transfer-token", server.URL, wallet.ID), bytes.NewReader(transferBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var result struct {
+		Token struct {
+			Decimals int `json:"decimals"`
+		} `json:"token"`
+		Amount            string `json:"amount"`
+		SignedTransaction string `json:"signedTransaction"`
+	}
+	testutil.DecodeJSON(t, resp, &result)
+	if result.Token.Decimals != 6 || result.Amount != "2500000" || result.SignedTransaction == "" {
+		t.Fatalf("unexpected transfer result %+v", result)
+	}
+
+	badBody, _ := json.Marshal(map[string]interface{}{"token": "NOPE", "to": "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "amount": "1"})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/transfer-token", server.URL, wallet.ID), bytes.NewReader(badBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusBadRequest)
+}
+
+func TestSignInWithEthereumRejectsReplayedNonce(t *testing.T) {
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID      string `json:"id"`
+		Address string `json:"address"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/siwe/nonce", nil))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+	var nonce struct {
+		Nonce string `json:"nonce"`
+	}
+	testutil.DecodeJSON(t, resp, &nonce)
+
+	signBody, _ := json.Marshal(map[string]string{
+		"domain":    "app.example.com",
+		"uri":       "https://app.example.com/login",
+		"statement": "Sign in to Example.",
+		"nonce":     nonce.Nonce,
+		"expiresIn": "5m",
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/siwe", server.URL, wallet.ID), bytes.NewReader(signBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+	var signed struct {
+		Message   string `json:"message"`
+		Signature string `json:"signature"`
+	}
+	testutil.DecodeJSON(t, resp, &signed)
+	if !strings.Contains(signed.Message, "Chain ID: 11155111\n") || !strings.Contains(signed.Message, "Nonce: "+nonce.Nonce+"\n") {
+		t.Fatalf("unexpected SIWE message:\n%s", signed.Message)
+	}
+
+	verifyBody, _ := json.Marshal(map[string]string{"message": signed.Message, "signature": signed.Signature, "domain": "app.example.com"})
+	for i, wantValid := range []bool{true, false} {
+		resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/siwe/verify", bytes.NewReader(verifyBody)))
+		defer resp.Body.Close()
+		testutil.AssertStatus(t, resp, http.StatusOK)
+		var verification struct {
+			Valid   bool   `json:"valid"`
+			Address string `json:"address"`
+			Reason  string `json:"reason"`
+		}
+		testutil.DecodeJSON(t, resp, &verification)
+		if verification.Valid != wantValid || !strings.EqualFold(verification.Address, wallet.Address) {
+			t.Fatalf("verification %d: unexpected result %+v", i, verification)
+		}
+	}
+}
+
+func TestSignUserOperationSubmitsToBundler(t *testing.T) {
+	bundler := testutil.NewBundlerStub(t, 11155111)
+	t.Setenv("ETH_SEPOLIA_BUNDLER_URL", bundler.URL)
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID      string `json:"id"`
+		Address string `json:"address"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+
+	opBody, _ := json.Marshal(map[string]interface{}{
+		"version": "v0.6",
+		"submit":  true,
+		"userOperation": map[string]string{
+			"sender":               "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"nonce":                "0x0",
+			"callData":             "0xb61d27f6" + strings.Repeat("0", 24) + strings.Repeat("a", 40) + strings.Repeat("0", 64) + fmt.Sprintf("%064x", 96) + strings.Repeat("0", 64),
+			"callGasLimit":         "0x30d40",
+			"verificationGasLimit": "0x186a0",
+			"preVerificationGas":   "0xc350",
+			"maxFeePerGas":         "0x3b9aca00",
+			"maxPriorityFeePerGas": "0x5f5e100",
+		},
+	})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/user-operation", server.URL, wallet.ID), bytes.NewReader(opBody)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusOK)
+
+	var result struct {
+		UserOpHash    string `json:"userOpHash"`
+		Submitted     bool   `json:"submitted"`
+		SubmitError   string `json:"submitError"`
+		UserOperation struct {
+			Signature string `json:"signature"`
+		} `json:"userOperation"`
+	}
+	testutil.DecodeJSON(t, resp, &result)
+	if !result.Submitted {
+		t.Fatalf("expected the bundler to accept the operation: %s", result.SubmitError)
+	}
+	if ops := bundler.Operations(); len(ops) != 1 || ops[0].Signature != result.UserOperation.Signature {
+		t.Fatalf("bundler received %+v", ops)
+	}
+
+	signer, err := ethereum.RecoverAddress(common.FromHex(result.UserOpHash), common.FromHex(result.UserOperation.Signature))
+	if err != nil || signer.Hex() != wallet.Address {
+		t.Fatalf("user operation signature recovers to %s (%v), want %s", signer.Hex(), err, wallet.Address)
+	}
+}
+
+func TestCreateSmartAccountWallet(t *testing.T) {
+	factory := testutil.NewAccountFactoryStub(t, service.AccountFactory{
+		Address:      "0x9406Cc6185a346906296840746125a0E44976454",
+		InitCodeHash: "0x" + strings.Repeat("ab", 32),
+	})
+	t.Setenv("ETH_SEPOLIA_RPC_URL", factory.URL)
+	t.Setenv("ETH_SEPOLIA_ACCOUNT_FACTORY", factory.Factory.Address)
+	t.Setenv("ETH_SEPOLIA_ACCOUNT_FACTORY_INIT_CODE_HASH", factory.Factory.InitCodeHash)
+	server, _, cleanup := testutil.NewTestServer(t)
+	defer cleanup()
+	client := server.Client()
+
+	body, _ := json.Marshal(map[string]string{"network": "eth-sepolia", "accountType": "smart", "salt": "1"})
+	resp := testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusCreated)
+
+	var wallet struct {
+		ID           string `json:"id"`
+		Address      string `json:"address"`
+		AccountType  string `json:"accountType"`
+		SmartAccount struct {
+			Owner    string `json:"owner"`
+			Salt     string `json:"salt"`
+			Deployed bool   `json:"deployed"`
+		} `json:"smartAccount"`
+	}
+	testutil.DecodeJSON(t, resp, &wallet)
+	if wallet.AccountType != "smart" || wallet.SmartAccount.Salt != "0x1" || wallet.SmartAccount.Deployed {
+		t.Fatalf("unexpected smart account wallet %+v", wallet)
+	}
+	if wallet.Address == wallet.SmartAccount.Owner || !common.IsHexAddress(wallet.Address) {
+		t.Fatalf("expected a counterfactual account address, got %+v", wallet)
+	}
+
+	body, _ = json.Marshal(map[string]string{"network": "eth-sepolia", "accountType": "smart", "factory": "kernel"})
+	resp = testutil.MustDo(t, client, mustRequest(t, http.MethodPost, server.URL+"/v1/wallets", bytes.NewReader(body)))
+	defer resp.Body.Close()
+	testutil.AssertStatus(t, resp, http.StatusBadRequest)
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) proposeSafeTransaction(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.SafeProposalRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	proposal, err := b.wallets.ProposeSafeTransaction(r.Context(), payload)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, proposal)
+}
+
+func (b *RouteBuilder) listSafeProposals(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	status := service.SafeProposalStatus(r.URL.Query().Get("status"))
+	proposals, err := b.wallets.ListSafeProposals(r.Context(), status)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, proposals)
+}
+
+func (b *RouteBuilder) getSafeProposal(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	proposal, err := b.wallets.GetSafeProposal(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, proposal)
+}
+
+func (b *RouteBuilder) signSafeProposal(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	proposal, err := b.wallets.SignSafeProposal(r.Context(), chi.URLParam(r, "proposalId"), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, proposal)
+}
+
+func (b *RouteBuilder) executeSafeProposal(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.SafeExecuteRequest
+	if r.ContentLength != 0 {
+		if err := decodeJSON(r, &payload); err != nil {
+			writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+
+	proposal, err := b.wallets.ExecuteSafeProposal(r.Context(), chi.URLParam(r, "proposalId"), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, proposal)
+}
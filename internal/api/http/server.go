@@ -1,10 +1,13 @@
 package http
 
 import (
+	"net"
 	stdhttp "net/http"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
 )
 
 type Server struct {
@@ -16,6 +19,7 @@ func NewServer() *Server {
 	router.Use(middleware.RequestID)
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer)
+	router.Use(remoteActor)
 
 	return &Server{router: router}
 }
@@ -27,3 +31,16 @@ func (s *Server) Router() *chi.Mux {
 func (s *Server) ServeHTTP(w stdhttp.ResponseWriter, r *stdhttp.Request) {
 	s.router.ServeHTTP(w, r)
 }
+
+// remoteActor tags the request context with the client address so audit
+// entries identify unauthenticated callers.
+func remoteActor(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+		host := r.RemoteAddr
+		if h, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			host = h
+		}
+		ctx := service.ContextWithActor(r.Context(), "remote:"+host)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) verifySignature(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.SignatureVerifyRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.signatures.VerifySignature(r.Context(), payload)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) issueSIWENonce(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	nonce, err := b.siwe.IssueNonce(r.Context())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, nonce)
+}
+
+func (b *RouteBuilder) signInWithEthereum(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.SIWESignRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.siwe.SignIn(r.Context(), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
+
+func (b *RouteBuilder) verifySIWE(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.SIWEVerifyRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.siwe.Verify(r.Context(), payload)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type tenantPayload struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	AllowedNetworks []string `json:"allowedNetworks"`
+	MaxWallets      int      `json:"maxWallets"`
+}
+
+func (p tenantPayload) toTenant() service.Tenant {
+	return service.Tenant{
+		ID:              p.ID,
+		Name:            p.Name,
+		AllowedNetworks: p.AllowedNetworks,
+		MaxWallets:      p.MaxWallets,
+	}
+}
+
+func (b *RouteBuilder) createTenant(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload tenantPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	tenant, err := b.tenants.CreateTenant(r.Context(), payload.toTenant())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusCreated, tenant)
+}
+
+func (b *RouteBuilder) listTenants(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	tenants, err := b.tenants.ListTenants(r.Context())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, tenants)
+}
+
+func (b *RouteBuilder) getTenant(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	tenant, err := b.tenants.GetTenant(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, tenant)
+}
+
+func (b *RouteBuilder) updateTenant(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload tenantPayload
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+	payload.ID = chi.URLParam(r, "id")
+
+	tenant, err := b.tenants.UpdateTenant(r.Context(), payload.toTenant())
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, tenant)
+}
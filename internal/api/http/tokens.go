@@ -0,0 +1,57 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) transferToken(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.TokenTransferRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.wallets.TransferToken(r.Context(), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
+
+func (b *RouteBuilder) approveToken(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.TokenApprovalRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.wallets.ApproveToken(r.Context(), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
+
+func (b *RouteBuilder) signPermit(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.PermitRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.wallets.SignPermit(r.Context(), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleServiceError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
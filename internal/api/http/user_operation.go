@@ -0,0 +1,25 @@
+package http
+
+import (
+	stdhttp "net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (b *RouteBuilder) signUserOperation(w stdhttp.ResponseWriter, r *stdhttp.Request) {
+	var payload service.UserOperationRequest
+	if err := decodeJSON(r, &payload); err != nil {
+		writeError(w, stdhttp.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	result, err := b.wallets.SignUserOperation(r.Context(), chi.URLParam(r, "id"), payload)
+	if err != nil {
+		handleSigningError(w, err)
+		return
+	}
+
+	writeJSON(w, stdhttp.StatusOK, result)
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 
 	"google.golang.org/grpc"
@@ -8,18 +9,34 @@ import (
 	grpcserver "github.com/rickyreddygari/walletsdk/internal/api/grpc"
 	grpcpb "github.com/rickyreddygari/walletsdk/internal/api/grpcpb"
 	httprouter "github.com/rickyreddygari/walletsdk/internal/api/http"
+	"github.com/rickyreddygari/walletsdk/internal/auth/jwt"
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/bitcoin"
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/cosmos"
 	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/solana"
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/tron"
 	"github.com/rickyreddygari/walletsdk/internal/config"
+	"github.com/rickyreddygari/walletsdk/internal/policy/cel"
 	"github.com/rickyreddygari/walletsdk/internal/service"
+	"github.com/rickyreddygari/walletsdk/internal/storage/file"
 	"github.com/rickyreddygari/walletsdk/internal/storage/memory"
 )
 
 type Container struct {
-	Config         *config.AppConfig
-	WalletService  service.WalletService
-	BalanceService service.BalanceService
-	HTTPServer     *httprouter.Server
-	GRPCServer     *grpc.Server
+	Config           *config.AppConfig
+	WalletService    service.WalletService
+	BalanceService   service.BalanceService
+	AuditService     service.AuditService
+	APIKeyService    service.APIKeyService
+	TenantService    service.TenantService
+	PolicyService    service.PolicyService
+	ContractService  service.ContractService
+	DecoderService   service.DecoderService
+	SIWEService      service.SIWEService
+	SignatureService service.SignatureService
+	NetworkService   service.NetworkService
+	HTTPServer       *httprouter.Server
+	GRPCServer       *grpc.Server
 }
 
 func NewContainer() (*Container, error) {
@@ -33,22 +50,158 @@ func NewContainer() (*Container, error) {
 	fetcher := ethereum.NewBalanceFetcher()
 	registry := service.NewConfigRegistry(cfg)
 
-	walletService := service.NewWalletService(repo, signer)
-	balanceService := service.NewBalanceService(repo, fetcher, registry)
+	var networkStore service.NetworkStore = memory.NewNetworkStore()
+	if cfg.NetworkStorePath != "" {
+		networkStore = file.NewNetworkStore(cfg.NetworkStorePath)
+	}
+	networkService, err := service.NewNetworkService(context.Background(), registry, networkStore)
+	if err != nil {
+		return nil, err
+	}
+
+	var auditLog service.AuditLog = memory.NewAuditLog()
+	if cfg.AuditLogPath != "" {
+		fileLog, err := file.OpenAuditLog(cfg.AuditLogPath)
+		if err != nil {
+			return nil, fmt.Errorf("open audit log: %w", err)
+		}
+		auditLog = fileLog
+	}
+
+	tenantRepo := memory.NewTenantRepository()
+	tenantService := service.NewTenantService(tenantRepo)
+	if _, err := tenantService.CreateTenant(context.Background(), service.Tenant{ID: service.DefaultTenantID, Name: "Default"}); err != nil {
+		return nil, fmt.Errorf("seed default tenant: %w", err)
+	}
+
+	evaluator, err := cel.NewEvaluator()
+	if err != nil {
+		return nil, fmt.Errorf("create policy evaluator: %w", err)
+	}
+	decoder := ethereum.NewDecoder()
+	policyEngine := service.NewPolicyEngine(memory.NewPolicyRepository(), memory.NewSpendLedger(),
+		service.WithExpressionEvaluator(evaluator),
+		service.WithPolicyTenants(tenantRepo),
+		service.WithCalldataDecoder(decoder),
+	)
+	simulator := ethereum.NewSimulator()
+	bundler := ethereum.NewBundler()
+	walletService := service.NewWalletService(repo, signer,
+		service.WithDeletionGracePeriod(cfg.DeletionGracePeriod),
+		service.WithAuditLog(auditLog),
+		service.WithTenants(tenantRepo),
+		service.WithPolicies(policyEngine),
+		service.WithApprovals(memory.NewApprovalRepository()),
+		service.WithBroadcaster(ethereum.NewBroadcaster(), registry),
+		service.WithSimulator(simulator, registry),
+		service.WithTokenChain(simulator, registry),
+		service.WithPermits(ethereum.NewPermitBuilder(simulator)),
+		service.WithBundler(bundler, registry),
+		service.WithSmartAccounts(bundler, registry),
+		service.WithSafes(ethereum.NewSafeClient(simulator), memory.NewSafeProposalRepository(), registry),
+		service.WithChainFamily(service.ChainFamilySolana, solana.NewSigner(), registry),
+		service.WithChainFamily(service.ChainFamilyBitcoin, bitcoin.NewSigner(registry), registry),
+		service.WithChainFamily(service.ChainFamilyCosmos, cosmos.NewSigner(signer, registry), registry),
+		service.WithChainFamily(service.ChainFamilyTron, tron.NewSigner(signer), registry),
+		service.WithSimulateBeforeSigning(cfg.SimulateBeforeSigning),
+	)
+	policyService := service.NewPolicyService(policyEngine, repo, registry)
+	contractService := service.NewContractService(walletService, ethereum.NewABICodec(), simulator, registry)
+	decoderService := service.NewDecoderService(decoder)
+	siweService := service.NewSIWEService(walletService, memory.NewNonceStore(), signer, registry)
+	signatureService := service.NewSignatureService(ethereum.NewSignatureVerifier(), registry)
+	tronBalances := tron.NewBalanceFetcher()
+	balanceService := service.NewBalanceService(repo, fetcher, registry,
+		service.WithFamilyBalanceFetcher(service.ChainFamilySolana, solana.NewBalanceFetcher()),
+		service.WithFamilyBalanceFetcher(service.ChainFamilyBitcoin, bitcoin.NewBalanceFetcher()),
+		service.WithFamilyDenomBalanceFetcher(service.ChainFamilyCosmos, cosmos.NewBalanceFetcher()),
+		service.WithFamilyBalanceFetcher(service.ChainFamilyTron, tronBalances),
+		service.WithFamilyTokenBalanceFetcher(service.ChainFamilyTron, tronBalances),
+	)
+	auditService := service.NewAuditService(auditLog)
+
+	keyRepo := memory.NewAPIKeyRepository()
+	if cfg.BootstrapAdminKey != "" {
+		scopes := []service.Scope{service.ScopeAdmin, service.ScopePlatform}
+		if err := service.SeedAPIKey(context.Background(), keyRepo, "bootstrap-admin", service.DefaultTenantID, cfg.BootstrapAdminKey, scopes); err != nil {
+			return nil, fmt.Errorf("seed admin key: %w", err)
+		}
+	}
+	apiKeyService := service.NewAPIKeyService(keyRepo, tenantRepo)
+	var authOpts []service.AuthorizerOption
+	if cfg.JWT.Enabled() {
+		validator, err := newJWTValidator(cfg.JWT)
+		if err != nil {
+			return nil, fmt.Errorf("configure jwt: %w", err)
+		}
+		authOpts = append(authOpts, service.WithTokenValidator(validator))
+	}
+	authorizer := service.NewAuthorizer(keyRepo, authOpts...)
 
 	httpServer := httprouter.NewServer()
-	routes := httprouter.NewRouteBuilder(walletService, balanceService)
+	routes := httprouter.NewRouteBuilder(httprouter.Services{
+		Wallets:    walletService,
+		Balances:   balanceService,
+		Audits:     auditService,
+		APIKeys:    apiKeyService,
+		Tenants:    tenantService,
+		Policies:   policyService,
+		Contracts:  contractService,
+		Decoder:    decoderService,
+		SIWE:       siweService,
+		Signatures: signatureService,
+		Networks:   networkService,
+		Authorizer: authorizer,
+	})
 	routes.Register(httpServer.Router())
 
-	grpcSrv := grpc.NewServer()
-	grpcService := grpcserver.NewServer(walletService, balanceService)
+	grpcSrv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcserver.ActorInterceptor(),
+		grpcserver.AuthInterceptor(authorizer),
+	))
+	grpcService := grpcserver.NewServer(grpcserver.Services{
+		Wallets:    walletService,
+		Balances:   balanceService,
+		Audits:     auditService,
+		APIKeys:    apiKeyService,
+		Tenants:    tenantService,
+		Policies:   policyService,
+		Contracts:  contractService,
+		Decoder:    decoderService,
+		SIWE:       siweService,
+		Signatures: signatureService,
+		Networks:   networkService,
+	})
 	grpcpb.RegisterWalletServiceServer(grpcSrv, grpcService)
 
 	return &Container{
-		Config:         cfg,
-		WalletService:  walletService,
-		BalanceService: balanceService,
-		HTTPServer:     httpServer,
-		GRPCServer:     grpcSrv,
+		Config:           cfg,
+		WalletService:    walletService,
+		BalanceService:   balanceService,
+		AuditService:     auditService,
+		APIKeyService:    apiKeyService,
+		TenantService:    tenantService,
+		PolicyService:    policyService,
+		ContractService:  contractService,
+		DecoderService:   decoderService,
+		SIWEService:      siweService,
+		SignatureService: signatureService,
+		NetworkService:   networkService,
+		HTTPServer:       httpServer,
+		GRPCServer:       grpcSrv,
 	}, nil
 }
+
+func newJWTValidator(cfg config.JWTConfig) (*jwt.Validator, error) {
+	keys := jwt.NewFileKeySet(cfg.JWKSFile)
+	if cfg.JWKSURL != "" {
+		keys = jwt.NewURLKeySet(cfg.JWKSURL, nil)
+	}
+	return jwt.NewValidator(keys, jwt.Config{
+		Issuer:          cfg.Issuer,
+		Audience:        cfg.Audience,
+		TenantClaim:     cfg.TenantClaim,
+		WalletsClaim:    cfg.WalletsClaim,
+		AllowAdminScope: cfg.AllowAdminScope,
+	})
+}
@@ -0,0 +1,293 @@
+// Package jwt validates end-user bearer tokens signed by an OIDC provider and
+// maps their claims onto service principals.
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+const defaultLeeway = time.Minute
+
+// Config controls which tokens are accepted and how claims map to principals.
+type Config struct {
+	Issuer   string
+	Audience string
+	// TenantClaim names the claim carrying the tenant ID. Defaults to "tenant".
+	TenantClaim string
+	// WalletsClaim names the claim listing wallet IDs the user may act on.
+	// Defaults to "wallet_ids".
+	WalletsClaim string
+	// DefaultScopes apply when the token has no "scope" claim.
+	DefaultScopes []service.Scope
+	// AllowAdminScope accepts the admin scope from tokens. Without it admin
+	// is dropped, so that whoever can edit users at the identity provider
+	// cannot make them tenant admins here.
+	AllowAdminScope bool
+	Leeway          time.Duration
+}
+
+type Validator struct {
+	keys *KeySet
+	cfg  Config
+	now  func() time.Time
+}
+
+func NewValidator(keys *KeySet, cfg Config) (*Validator, error) {
+	if cfg.Issuer == "" {
+		return nil, errors.New("jwt issuer is required")
+	}
+	if cfg.Audience == "" {
+		return nil, errors.New("jwt audience is required")
+	}
+	if cfg.TenantClaim == "" {
+		cfg.TenantClaim = "tenant"
+	}
+	if cfg.WalletsClaim == "" {
+		cfg.WalletsClaim = "wallet_ids"
+	}
+	if cfg.DefaultScopes == nil {
+		cfg.DefaultScopes = []service.Scope{service.ScopeWalletsRead, service.ScopeSign}
+	}
+	if cfg.Leeway == 0 {
+		cfg.Leeway = defaultLeeway
+	}
+	return &Validator{keys: keys, cfg: cfg, now: time.Now}, nil
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// Validate verifies the token signature and registered claims and returns the
+// principal described by its claims.
+func (v *Validator) Validate(ctx context.Context, token string) (*service.Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var hdr header
+	if err := decodeSegment(parts[0], &hdr); err != nil {
+		return nil, fmt.Errorf("decode header: %w", err)
+	}
+	key, err := v.keys.Key(ctx, hdr.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+	if err := verifySignature(hdr.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("decode claims: %w", err)
+	}
+	if err := v.checkRegisteredClaims(claims); err != nil {
+		return nil, err
+	}
+	return v.principal(claims)
+}
+
+func (v *Validator) checkRegisteredClaims(claims map[string]interface{}) error {
+	now := v.now()
+
+	if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+		return fmt.Errorf("unexpected issuer %q", iss)
+	}
+	if !audienceMatches(claims["aud"], v.cfg.Audience) {
+		return errors.New("token audience mismatch")
+	}
+
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return errors.New("token has no expiry")
+	}
+	if now.After(exp.Add(v.cfg.Leeway)) {
+		return errors.New("token expired")
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(v.cfg.Leeway).Before(nbf) {
+		return errors.New("token not yet valid")
+	}
+	return nil
+}
+
+func (v *Validator) principal(claims map[string]interface{}) (*service.Principal, error) {
+	sub, _ := claims["sub"].(string)
+	if sub == "" {
+		return nil, errors.New("token has no subject")
+	}
+	tenant, _ := claims[v.cfg.TenantClaim].(string)
+	if tenant == "" {
+		return nil, fmt.Errorf("token has no %s claim", v.cfg.TenantClaim)
+	}
+
+	scopes := v.cfg.DefaultScopes
+	if raw, ok := claims["scope"].(string); ok {
+		parsed, err := v.scopes(strings.Fields(raw))
+		if err != nil {
+			return nil, err
+		}
+		scopes = parsed
+	}
+
+	walletIDs := make([]string, 0)
+	if raw, ok := claims[v.cfg.WalletsClaim].([]interface{}); ok {
+		for _, item := range raw {
+			if id, ok := item.(string); ok && id != "" {
+				walletIDs = append(walletIDs, id)
+			}
+		}
+	}
+
+	name, _ := claims["email"].(string)
+	if name == "" {
+		name = sub
+	}
+
+	return &service.Principal{
+		ID:              sub,
+		Type:            service.PrincipalUser,
+		TenantID:        tenant,
+		Name:            name,
+		Scopes:          scopes,
+		WalletIDs:       walletIDs,
+		RestrictWallets: true,
+	}, nil
+}
+
+// scopes keeps the scopes of a token's scope claim that this service knows.
+// Others, such as OIDC's openid and profile, are meant for other services.
+func (v *Validator) scopes(names []string) ([]service.Scope, error) {
+	scopes := make([]service.Scope, 0, len(names))
+	for _, name := range names {
+		scope, ok := service.KnownScope(name)
+		switch {
+		case !ok:
+			continue
+		case scope == service.ScopePlatform:
+			return nil, errors.New("user tokens cannot carry the platform scope")
+		case scope == service.ScopeAdmin && !v.cfg.AllowAdminScope:
+			continue
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signingInput []byte, signature []byte) error {
+	switch alg {
+	case "RS256", "RS384", "RS512":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type does not match %s", alg)
+		}
+		h, digest := hashFor(alg, signingInput)
+		if err := rsa.VerifyPKCS1v15(pub, h, digest, signature); err != nil {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	case "ES256", "ES384":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok || pub.Curve != curveFor(alg) {
+			return fmt.Errorf("key type does not match %s", alg)
+		}
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return errors.New("invalid token signature")
+		}
+		_, digest := hashFor(alg, signingInput)
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type does not match %s", alg)
+		}
+		if !ed25519.Verify(pub, signingInput, signature) {
+			return errors.New("invalid token signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}
+
+// curveFor returns the curve RFC 7518 pairs with an ECDSA algorithm.
+func curveFor(alg string) elliptic.Curve {
+	if alg == "ES384" {
+		return elliptic.P384()
+	}
+	return elliptic.P256()
+}
+
+func hashFor(alg string, input []byte) (crypto.Hash, []byte) {
+	var h hash.Hash
+	var id crypto.Hash
+	switch alg[2:] {
+	case "384":
+		h, id = sha512.New384(), crypto.SHA384
+	case "512":
+		h, id = sha512.New(), crypto.SHA512
+	default:
+		h, id = sha256.New(), crypto.SHA256
+	}
+	h.Write(input)
+	return id, h.Sum(nil)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func audienceMatches(aud interface{}, expected string) bool {
+	switch v := aud.(type) {
+	case string:
+		return v == expected
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func numericDate(v interface{}) (time.Time, bool) {
+	f, ok := v.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(f), 0), true
+}
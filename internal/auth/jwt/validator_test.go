@@ -0,0 +1,186 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func jwksDocument(t *testing.T, kid string, key *rsa.PublicKey) []byte {
+	t.Helper()
+	doc, err := json.Marshal(jsonWebKeySet{Keys: []jsonWebKey{{
+		Kty: "RSA",
+		Kid: kid,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatalf("marshal jwks: %v", err)
+	}
+	return doc
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	hdr, _ := json.Marshal(header{Alg: "RS256", Kid: kid, Typ: "JWT"})
+	body, _ := json.Marshal(claims)
+	input := base64.RawURLEncoding.EncodeToString(hdr) + "." + base64.RawURLEncoding.EncodeToString(body)
+	digest := sha256.Sum256([]byte(input))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":        "https://issuer.test",
+		"aud":        []string{"walletsdk"},
+		"sub":        "user-1",
+		"exp":        time.Now().Add(time.Hour).Unix(),
+		"tenant":     "acme",
+		"wallet_ids": []string{"w-1", "w-2"},
+	}
+}
+
+func TestValidatorAcceptsTokenFromURLKeySet(t *testing.T) {
+	key := newRSAKey(t)
+	doc := jwksDocument(t, "k1", &key.PublicKey)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(doc)
+	}))
+	defer srv.Close()
+
+	validator, err := NewValidator(NewURLKeySet(srv.URL, srv.Client()), Config{Issuer: "https://issuer.test", Audience: "walletsdk"})
+	if err != nil {
+		t.Fatalf("NewValidator returned error: %v", err)
+	}
+
+	principal, err := validator.Validate(context.Background(), signToken(t, key, "k1", validClaims()))
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if principal.TenantID != "acme" || principal.Type != service.PrincipalUser {
+		t.Fatalf("unexpected principal: %+v", principal)
+	}
+	if !principal.AllowsWallet("w-2") || principal.AllowsWallet("w-3") {
+		t.Fatalf("unexpected wallet restriction: %v", principal.WalletIDs)
+	}
+	if !principal.Allows(service.ScopeSign) || principal.Allows(service.ScopeAdmin) {
+		t.Fatalf("unexpected default scopes: %v", principal.Scopes)
+	}
+}
+
+func TestValidatorRejectsInvalidTokens(t *testing.T) {
+	key := newRSAKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksDocument(t, "k1", &key.PublicKey), 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+	validator, err := NewValidator(NewFileKeySet(path), Config{Issuer: "https://issuer.test", Audience: "walletsdk"})
+	if err != nil {
+		t.Fatalf("NewValidator returned error: %v", err)
+	}
+
+	cases := map[string]func(map[string]interface{}){
+		"expired":       func(c map[string]interface{}) { c["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"wrong issuer":  func(c map[string]interface{}) { c["iss"] = "https://evil.test" },
+		"wrong aud":     func(c map[string]interface{}) { c["aud"] = "other" },
+		"no tenant":     func(c map[string]interface{}) { delete(c, "tenant") },
+		"platform":      func(c map[string]interface{}) { c["scope"] = "platform" },
+		"not yet valid": func(c map[string]interface{}) { c["nbf"] = time.Now().Add(time.Hour).Unix() },
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			claims := validClaims()
+			mutate(claims)
+			if _, err := validator.Validate(context.Background(), signToken(t, key, "k1", claims)); err == nil {
+				t.Fatal("expected validation error")
+			}
+		})
+	}
+
+	t.Run("foreign key", func(t *testing.T) {
+		if _, err := validator.Validate(context.Background(), signToken(t, newRSAKey(t), "k1", validClaims())); err == nil {
+			t.Fatal("expected signature error")
+		}
+	})
+}
+
+func TestValidatorFiltersScopes(t *testing.T) {
+	key := newRSAKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksDocument(t, "k1", &key.PublicKey), 0o600); err != nil {
+		t.Fatalf("write jwks: %v", err)
+	}
+	claims := validClaims()
+	claims["scope"] = "openid profile email wallets:read admin"
+
+	validator, err := NewValidator(NewFileKeySet(path), Config{Issuer: "https://issuer.test", Audience: "walletsdk"})
+	if err != nil {
+		t.Fatalf("NewValidator returned error: %v", err)
+	}
+	principal, err := validator.Validate(context.Background(), signToken(t, key, "k1", claims))
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if len(principal.Scopes) != 1 || principal.Scopes[0] != service.ScopeWalletsRead {
+		t.Fatalf("expected only wallets:read, got %v", principal.Scopes)
+	}
+
+	validator, err = NewValidator(NewFileKeySet(path), Config{Issuer: "https://issuer.test", Audience: "walletsdk", AllowAdminScope: true})
+	if err != nil {
+		t.Fatalf("NewValidator returned error: %v", err)
+	}
+	principal, err = validator.Validate(context.Background(), signToken(t, key, "k1", claims))
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if !principal.Allows(service.ScopeAdmin) {
+		t.Fatalf("expected admin once allowed, got %v", principal.Scopes)
+	}
+}
+
+func TestVerifySignatureChecksCurve(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	input := []byte("header.claims")
+	digest := sha256.Sum256(input)
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	signature := append(r.FillBytes(make([]byte, 48)), s.FillBytes(make([]byte, 48))...)
+
+	if err := verifySignature("ES256", &key.PublicKey, input, signature); err == nil {
+		t.Fatal("expected ES256 to reject a P-384 key")
+	}
+}
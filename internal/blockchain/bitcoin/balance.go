@@ -0,0 +1,48 @@
+package bitcoin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// BalanceFetcher reads balances from an Esplora API, such as the one
+// mempool.space serves for testnet and signet.
+type BalanceFetcher struct {
+	client *http.Client
+}
+
+func NewBalanceFetcher() *BalanceFetcher {
+	return &BalanceFetcher{client: http.DefaultClient}
+}
+
+// FetchBalance returns the confirmed balance of address in satoshis.
+func (f *BalanceFetcher) FetchBalance(ctx context.Context, apiURL string, address string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(apiURL, "/")+"/address/"+address, nil)
+	if err != nil {
+		return "", fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get address: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get address: unexpected status %d", resp.StatusCode)
+	}
+
+	var stats struct {
+		ChainStats struct {
+			Funded int64 `json:"funded_txo_sum"`
+			Spent  int64 `json:"spent_txo_sum"`
+		} `json:"chain_stats"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&stats); err != nil {
+		return "", fmt.Errorf("decode address: %w", err)
+	}
+	return strconv.FormatInt(stats.ChainStats.Funded-stats.ChainStats.Spent, 10), nil
+}
@@ -0,0 +1,37 @@
+package bitcoin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchBalanceReadsChainStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/address/tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"address":"tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v","chain_stats":{"funded_txo_sum":150000,"spent_txo_sum":20000},"mempool_stats":{"funded_txo_sum":5000,"spent_txo_sum":0}}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL+"/api/", "tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v")
+	if err != nil {
+		t.Fatalf("FetchBalance returned error: %v", err)
+	}
+	if balance != "130000" {
+		t.Fatalf("expected 130000 sats, got %s", balance)
+	}
+}
+
+func TestFetchBalanceReturnsHTTPErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Invalid Bitcoin address", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	if _, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL, "bad"); err == nil {
+		t.Fatal("expected an error for a bad request")
+	}
+}
@@ -0,0 +1,46 @@
+package bitcoin
+
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// bip322Tag domain-separates message hashes from other tagged hashes.
+var bip322Tag = []byte("BIP0322-signed-message")
+
+func messageHash(message []byte) *chainhash.Hash {
+	return chainhash.TaggedHash(bip322Tag, message)
+}
+
+// messageTransaction builds the BIP-322 to_sign transaction, whose only
+// input spends the to_spend output paying pkScript that commits to message.
+func messageTransaction(message []byte, pkScript []byte) *wire.MsgTx {
+	hash := messageHash(message)
+	scriptSig := append([]byte{txscript.OP_0, txscript.OP_DATA_32}, hash[:]...)
+
+	toSpend := wire.NewMsgTx(0)
+	toSpend.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+		SignatureScript:  scriptSig,
+	})
+	toSpend.AddTxOut(wire.NewTxOut(0, pkScript))
+
+	toSign := wire.NewMsgTx(0)
+	toSign.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Hash: toSpend.TxHash()}})
+	toSign.AddTxOut(wire.NewTxOut(0, []byte{txscript.OP_RETURN}))
+	return toSign
+}
+
+// encodeWitness serializes a witness stack as a transaction does: the item
+// count followed by each length-prefixed item.
+func encodeWitness(witness wire.TxWitness) []byte {
+	var buf bytes.Buffer
+	_ = wire.WriteVarInt(&buf, 0, uint64(len(witness)))
+	for _, item := range witness {
+		_ = wire.WriteVarBytes(&buf, 0, item)
+	}
+	return buf.Bytes()
+}
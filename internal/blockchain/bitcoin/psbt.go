@@ -0,0 +1,170 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// DecodePSBT lists the outputs of a base64 PSBT with their addresses on
+// network, and its fee when the PSBT carries every spent output.
+func (s *Signer) DecodePSBT(network string, encoded string) (*service.DecodedPSBT, error) {
+	params, err := s.networkParams(network)
+	if err != nil {
+		return nil, err
+	}
+	packet, err := parsePSBT(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded := &service.DecodedPSBT{
+		TxID:    packet.UnsignedTx.TxHash().String(),
+		Outputs: make([]service.PSBTOutput, len(packet.UnsignedTx.TxOut)),
+	}
+	for i, out := range packet.UnsignedTx.TxOut {
+		output := service.PSBTOutput{Script: hex.EncodeToString(out.PkScript), Value: out.Value}
+		if _, addresses, _, err := txscript.ExtractPkScriptAddrs(out.PkScript, params); err == nil && len(addresses) == 1 {
+			output.Address = addresses[0].EncodeAddress()
+		}
+		decoded.Outputs[i] = output
+	}
+
+	var fee int64
+	for i := range packet.UnsignedTx.TxIn {
+		spent := spentOutput(packet, i)
+		if spent == nil {
+			return decoded, nil
+		}
+		fee += spent.Value
+	}
+	for _, out := range packet.UnsignedTx.TxOut {
+		fee -= out.Value
+	}
+	if fee < 0 {
+		return nil, fmt.Errorf("%w: psbt outputs exceed its inputs", service.ErrValidation)
+	}
+	decoded.Fee = &fee
+	return decoded, nil
+}
+
+// SignPSBT signs every input that spends the wallet's script and finalizes
+// it, since a single key is all these scripts need. Inputs already
+// finalized or owned by others are left as they are.
+func (s *Signer) SignPSBT(record *service.WalletRecord, encoded string) (*service.PSBTResult, error) {
+	key, err := s.loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+	packet, err := parsePSBT(encoded)
+	if err != nil {
+		return nil, err
+	}
+	tx := packet.UnsignedTx
+
+	// Segwit v0 signatures only commit to the output being spent, so
+	// outputs the PSBT does not carry are stood in for by empty ones.
+	// Taproot signatures commit to every spent output.
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	var owned []int
+	missing := false
+	for i, in := range tx.TxIn {
+		spent := spentOutput(packet, i)
+		if spent == nil {
+			missing = true
+			spent = &wire.TxOut{}
+		} else if bytes.Equal(spent.PkScript, key.pkScript) && packet.Inputs[i].FinalScriptWitness == nil {
+			owned = append(owned, i)
+		}
+		prevOuts.AddPrevOut(in.PreviousOutPoint, spent)
+	}
+	if key.addressType == service.AddressTypeP2TR && missing && len(owned) > 0 {
+		return nil, fmt.Errorf("%w: taproot inputs need the previous output of every input", service.ErrValidation)
+	}
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+
+	result := &service.PSBTResult{TxID: tx.TxHash().String(), SignedInputs: []int{}}
+	for _, i := range owned {
+		input := &packet.Inputs[i]
+		spent := spentOutput(packet, i)
+
+		// Policies approved the outputs, so every signature must commit to
+		// all of them and to every input.
+		hashType := key.defaultHashType()
+		switch input.SighashType {
+		case txscript.SigHashDefault:
+		case txscript.SigHashAll:
+			hashType = txscript.SigHashAll
+		default:
+			return nil, fmt.Errorf("%w: input %d asks for sighash type %#x; only SIGHASH_ALL is signed", service.ErrValidation, i, uint32(input.SighashType))
+		}
+		sig, err := key.signature(tx, sigHashes, i, spent.Value, hashType)
+		if err != nil {
+			return nil, err
+		}
+		if key.addressType == service.AddressTypeP2TR {
+			input.TaprootKeySpendSig = sig
+		} else {
+			input.PartialSigs = append(input.PartialSigs, &psbt.PartialSig{
+				PubKey:    key.priv.PubKey().SerializeCompressed(),
+				Signature: sig,
+			})
+		}
+		input.WitnessUtxo = spent
+		if err := psbt.Finalize(packet, i); err != nil {
+			return nil, fmt.Errorf("finalize input %d: %w", i, err)
+		}
+		result.SignedInputs = append(result.SignedInputs, i)
+	}
+
+	if packet.IsComplete() {
+		final, err := psbt.Extract(packet)
+		if err != nil {
+			return nil, fmt.Errorf("extract transaction: %w", err)
+		}
+		var raw bytes.Buffer
+		if err := final.Serialize(&raw); err != nil {
+			return nil, fmt.Errorf("serialize transaction: %w", err)
+		}
+		result.Complete = true
+		result.Transaction = hex.EncodeToString(raw.Bytes())
+	}
+
+	result.PSBT, err = packet.B64Encode()
+	if err != nil {
+		return nil, fmt.Errorf("encode psbt: %w", err)
+	}
+	return result, nil
+}
+
+func parsePSBT(encoded string) (*psbt.Packet, error) {
+	packet, err := psbt.NewFromRawBytes(strings.NewReader(strings.TrimSpace(encoded)), true)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid psbt: %v", service.ErrValidation, err)
+	}
+	return packet, nil
+}
+
+// spentOutput returns the output input i spends, from its witness UTXO or
+// from the full previous transaction. It is nil when the PSBT has neither.
+func spentOutput(packet *psbt.Packet, i int) *wire.TxOut {
+	input := packet.Inputs[i]
+	if input.WitnessUtxo != nil {
+		return input.WitnessUtxo
+	}
+	if input.NonWitnessUtxo == nil {
+		return nil
+	}
+	outpoint := packet.UnsignedTx.TxIn[i].PreviousOutPoint
+	if input.NonWitnessUtxo.TxHash() != outpoint.Hash || int(outpoint.Index) >= len(input.NonWitnessUtxo.TxOut) {
+		return nil
+	}
+	return input.NonWitnessUtxo.TxOut[outpoint.Index]
+}
@@ -0,0 +1,160 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// otherScript is a P2WPKH output owned by someone else.
+var otherScript, _ = hex.DecodeString("0014751e76e8199196d454941c45d1b3a323f1433bd6")
+
+// testPSBT spends one output of each script in spent, paying 9000 sats to
+// otherScript and returning the change to change.
+func testPSBT(t *testing.T, spent [][]byte, change []byte) *psbt.Packet {
+	t.Helper()
+	var outpoints []*wire.OutPoint
+	for i := range spent {
+		outpoints = append(outpoints, &wire.OutPoint{Hash: chainhash.Hash{byte(i + 1)}, Index: uint32(i)})
+	}
+	outputs := []*wire.TxOut{wire.NewTxOut(9000, otherScript), wire.NewTxOut(800, change)}
+	packet, err := psbt.New(outpoints, outputs, 2, 0, make([]uint32, len(spent)))
+	if err != nil {
+		t.Fatalf("psbt.New returned error: %v", err)
+	}
+	for i, script := range spent {
+		packet.Inputs[i].WitnessUtxo = wire.NewTxOut(10000, script)
+	}
+	return packet
+}
+
+func encodePSBT(t *testing.T, packet *psbt.Packet) string {
+	t.Helper()
+	encoded, err := packet.B64Encode()
+	if err != nil {
+		t.Fatalf("B64Encode returned error: %v", err)
+	}
+	return encoded
+}
+
+func TestDecodePSBT(t *testing.T) {
+	record := testRecord(t, service.AddressTypeP2WPKH)
+	key, _ := testSigner().loadKey(record)
+	packet := testPSBT(t, [][]byte{key.pkScript}, key.pkScript)
+
+	decoded, err := testSigner().DecodePSBT("bitcoin-testnet", encodePSBT(t, packet))
+	if err != nil {
+		t.Fatalf("DecodePSBT returned error: %v", err)
+	}
+	if decoded.TxID != packet.UnsignedTx.TxHash().String() || len(decoded.Outputs) != 2 {
+		t.Fatalf("unexpected decoded psbt: %+v", decoded)
+	}
+	if out := decoded.Outputs[0]; out.Address != "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx" || out.Value != 9000 {
+		t.Fatalf("unexpected payment output: %+v", out)
+	}
+	if out := decoded.Outputs[1]; out.Address != record.Address || out.Value != 800 {
+		t.Fatalf("unexpected change output: %+v", out)
+	}
+	if decoded.Fee == nil || *decoded.Fee != 200 {
+		t.Fatalf("expected a fee of 200 sats, got %v", decoded.Fee)
+	}
+
+	packet.Inputs[0].WitnessUtxo = nil
+	if decoded, err := testSigner().DecodePSBT("bitcoin-testnet", encodePSBT(t, packet)); err != nil || decoded.Fee != nil {
+		t.Fatalf("expected an unknown fee without the spent output, got %+v (%v)", decoded, err)
+	}
+
+	if _, err := testSigner().DecodePSBT("bitcoin-testnet", "not a psbt"); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestSignPSBTCompletesOwnedInputs(t *testing.T) {
+	for _, addressType := range []service.AddressType{service.AddressTypeP2WPKH, service.AddressTypeP2TR} {
+		record := testRecord(t, addressType)
+		key, _ := testSigner().loadKey(record)
+		packet := testPSBT(t, [][]byte{key.pkScript, key.pkScript}, key.pkScript)
+
+		result, err := testSigner().SignPSBT(record, encodePSBT(t, packet))
+		if err != nil {
+			t.Fatalf("%s: SignPSBT returned error: %v", addressType, err)
+		}
+		if !result.Complete || len(result.SignedInputs) != 2 || result.TxID != packet.UnsignedTx.TxHash().String() {
+			t.Fatalf("%s: unexpected result: %+v", addressType, result)
+		}
+
+		raw, _ := hex.DecodeString(result.Transaction)
+		tx := wire.NewMsgTx(2)
+		if err := tx.Deserialize(bytes.NewReader(raw)); err != nil {
+			t.Fatalf("%s: decode transaction: %v", addressType, err)
+		}
+		prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+		for i, in := range tx.TxIn {
+			prevOuts.AddPrevOut(in.PreviousOutPoint, packet.Inputs[i].WitnessUtxo)
+		}
+		for i := range tx.TxIn {
+			verifyInput(t, tx, i, key.pkScript, 10000, prevOuts)
+		}
+	}
+}
+
+func TestSignPSBTLeavesOtherInputs(t *testing.T) {
+	record := testRecord(t, service.AddressTypeP2WPKH)
+	key, _ := testSigner().loadKey(record)
+	packet := testPSBT(t, [][]byte{otherScript, key.pkScript}, key.pkScript)
+
+	result, err := testSigner().SignPSBT(record, encodePSBT(t, packet))
+	if err != nil {
+		t.Fatalf("SignPSBT returned error: %v", err)
+	}
+	if result.Complete || result.Transaction != "" || len(result.SignedInputs) != 1 || result.SignedInputs[0] != 1 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	signed, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(result.PSBT)), true)
+	if err != nil {
+		t.Fatalf("decode signed psbt: %v", err)
+	}
+	if signed.Inputs[0].FinalScriptWitness != nil || signed.Inputs[1].FinalScriptWitness == nil {
+		t.Fatal("expected only the wallet's input to be finalized")
+	}
+}
+
+func TestSignPSBTRequiresAllPrevOutsForTaproot(t *testing.T) {
+	record := testRecord(t, service.AddressTypeP2TR)
+	key, _ := testSigner().loadKey(record)
+	packet := testPSBT(t, [][]byte{otherScript, key.pkScript}, key.pkScript)
+	packet.Inputs[0].WitnessUtxo = nil
+
+	if _, err := testSigner().SignPSBT(record, encodePSBT(t, packet)); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func TestSignPSBTRefusesPartialSighashTypes(t *testing.T) {
+	for _, addressType := range []service.AddressType{service.AddressTypeP2WPKH, service.AddressTypeP2TR} {
+		record := testRecord(t, addressType)
+		key, _ := testSigner().loadKey(record)
+		for _, hashType := range []txscript.SigHashType{txscript.SigHashNone, txscript.SigHashSingle, txscript.SigHashAll | txscript.SigHashAnyOneCanPay} {
+			packet := testPSBT(t, [][]byte{key.pkScript}, key.pkScript)
+			packet.Inputs[0].SighashType = hashType
+			if _, err := testSigner().SignPSBT(record, encodePSBT(t, packet)); !errors.Is(err, service.ErrValidation) {
+				t.Fatalf("%s: expected sighash %#x to be refused, got %v", addressType, uint32(hashType), err)
+			}
+		}
+
+		packet := testPSBT(t, [][]byte{key.pkScript}, key.pkScript)
+		packet.Inputs[0].SighashType = txscript.SigHashAll
+		if _, err := testSigner().SignPSBT(record, encodePSBT(t, packet)); err != nil {
+			t.Fatalf("%s: expected SIGHASH_ALL to be signed, got %v", addressType, err)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package bitcoin
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// chains maps the chains a Bitcoin network may declare to their
+// parameters.
+var chains = map[string]*chaincfg.Params{
+	"mainnet":  &chaincfg.MainNetParams,
+	"testnet3": &chaincfg.TestNet3Params,
+	"signet":   &chaincfg.SigNetParams,
+	"regtest":  &chaincfg.RegressionNetParams,
+}
+
+// Signer holds secp256k1 keys. The private key is stored as 32 hex bytes
+// and the public key as the hex compressed point. P2TR addresses commit to
+// the key with no script tree, as BIP-86 does. Chain parameters come from
+// the chain each network in registry declares.
+type Signer struct {
+	registry service.NetworkRegistry
+}
+
+func NewSigner(registry service.NetworkRegistry) *Signer {
+	return &Signer{registry: registry}
+}
+
+func (s *Signer) NewWallet(network string, addressType service.AddressType) (*service.WalletRecord, error) {
+	params, err := s.networkParams(network)
+	if err != nil {
+		return nil, err
+	}
+	if addressType == "" {
+		addressType = service.AddressTypeP2WPKH
+	}
+
+	key, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+	address, err := encodeAddress(key.PubKey(), addressType, params)
+	if err != nil {
+		return nil, err
+	}
+	return &service.WalletRecord{
+		Network:     network,
+		Address:     address.EncodeAddress(),
+		PublicKey:   hex.EncodeToString(key.PubKey().SerializeCompressed()),
+		PrivKey:     hex.EncodeToString(key.Serialize()),
+		AddressType: addressType,
+	}, nil
+}
+
+// SignMessage produces a BIP-322 simple signature: the base64 witness that
+// spends the wallet's address in the message's virtual transaction.
+func (s *Signer) SignMessage(record *service.WalletRecord, payload []byte) (*service.SignatureOutput, error) {
+	key, err := s.loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+
+	toSign := messageTransaction(payload, key.pkScript)
+	prevOuts := txscript.NewCannedPrevOutputFetcher(key.pkScript, 0)
+	witness, err := key.witness(toSign, txscript.NewTxSigHashes(toSign, prevOuts), 0, 0, key.defaultHashType())
+	if err != nil {
+		return nil, err
+	}
+	return &service.SignatureOutput{
+		Signature: base64.StdEncoding.EncodeToString(encodeWitness(witness)),
+		PublicKey: record.PublicKey,
+	}, nil
+}
+
+// SignSerializedTransaction is not supported: Bitcoin transactions are
+// signed as PSBTs so their outputs can be checked against policies.
+func (s *Signer) SignSerializedTransaction(_ *service.WalletRecord, _ string) (*service.SerializedTransactionResult, error) {
+	return nil, fmt.Errorf("%w: bitcoin wallets sign transactions as PSBTs", service.ErrValidation)
+}
+
+func (s *Signer) networkParams(network string) (*chaincfg.Params, error) {
+	resolved, err := s.registry.Lookup(network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	if resolved.Family != service.ChainFamilyBitcoin {
+		return nil, fmt.Errorf("%w: %s is not a bitcoin network", service.ErrValidation, network)
+	}
+	params, ok := chains[resolved.BitcoinChain]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported bitcoin chain %q on %s", service.ErrValidation, resolved.BitcoinChain, network)
+	}
+	return params, nil
+}
+
+func encodeAddress(pub *btcec.PublicKey, addressType service.AddressType, params *chaincfg.Params) (btcutil.Address, error) {
+	switch addressType {
+	case service.AddressTypeP2WPKH:
+		return btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(pub.SerializeCompressed()), params)
+	case service.AddressTypeP2TR:
+		outputKey := txscript.ComputeTaprootKeyNoScript(pub)
+		return btcutil.NewAddressTaproot(schnorr.SerializePubKey(outputKey), params)
+	default:
+		return nil, fmt.Errorf("%w: unsupported address type %q", service.ErrValidation, addressType)
+	}
+}
+
+// walletKey is a wallet's private key with the script its address pays to.
+type walletKey struct {
+	priv        *btcec.PrivateKey
+	addressType service.AddressType
+	pkScript    []byte
+}
+
+func (s *Signer) loadKey(record *service.WalletRecord) (*walletKey, error) {
+	raw, err := hex.DecodeString(record.PrivKey)
+	if err != nil || len(raw) != btcec.PrivKeyBytesLen {
+		return nil, fmt.Errorf("decode private key: invalid secp256k1 key")
+	}
+	params, err := s.networkParams(record.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, _ := btcec.PrivKeyFromBytes(raw)
+	address, err := encodeAddress(priv.PubKey(), record.AddressType, params)
+	if err != nil {
+		return nil, err
+	}
+	if address.EncodeAddress() != record.Address {
+		return nil, fmt.Errorf("private key does not match address %s", record.Address)
+	}
+	pkScript, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return nil, fmt.Errorf("build output script: %w", err)
+	}
+	return &walletKey{priv: priv, addressType: record.AddressType, pkScript: pkScript}, nil
+}
+
+// defaultHashType is the sighash used when none is requested: SIGHASH_ALL,
+// which taproot spells as the implicit SIGHASH_DEFAULT.
+func (k *walletKey) defaultHashType() txscript.SigHashType {
+	if k.addressType == service.AddressTypeP2TR {
+		return txscript.SigHashDefault
+	}
+	return txscript.SigHashAll
+}
+
+// signature signs input idx of tx, which spends amount satoshis from the
+// wallet's script.
+func (k *walletKey) signature(tx *wire.MsgTx, sigHashes *txscript.TxSigHashes, idx int, amount int64, hashType txscript.SigHashType) ([]byte, error) {
+	var (
+		sig []byte
+		err error
+	)
+	if k.addressType == service.AddressTypeP2TR {
+		sig, err = txscript.RawTxInTaprootSignature(tx, sigHashes, idx, amount, k.pkScript, nil, hashType, k.priv)
+	} else {
+		sig, err = txscript.RawTxInWitnessSignature(tx, sigHashes, idx, amount, k.pkScript, hashType, k.priv)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("sign input %d: %w", idx, err)
+	}
+	return sig, nil
+}
+
+// witness returns the witness stack that spends the wallet's script in
+// input idx of tx.
+func (k *walletKey) witness(tx *wire.MsgTx, sigHashes *txscript.TxSigHashes, idx int, amount int64, hashType txscript.SigHashType) (wire.TxWitness, error) {
+	sig, err := k.signature(tx, sigHashes, idx, amount, hashType)
+	if err != nil {
+		return nil, err
+	}
+	if k.addressType == service.AddressTypeP2TR {
+		return wire.TxWitness{sig}, nil
+	}
+	return wire.TxWitness{sig, k.priv.PubKey().SerializeCompressed()}, nil
+}
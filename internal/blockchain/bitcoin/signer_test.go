@@ -0,0 +1,178 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// testRegistry declares bitcoin-testnet and bitcoin-signet like the built-in
+// networks, and regtest under a key the signer has never seen.
+type testRegistry struct{}
+
+func (testRegistry) Lookup(network string) (*service.Network, error) {
+	chains := map[string]string{"bitcoin-testnet": "testnet3", "bitcoin-signet": "signet", "local-regtest": "regtest"}
+	if chain, ok := chains[network]; ok {
+		return &service.Network{Name: network, Family: service.ChainFamilyBitcoin, BitcoinChain: chain}, nil
+	}
+	return &service.Network{Name: network, Family: service.ChainFamilyEthereum, ChainID: 11155111}, nil
+}
+
+func testSigner() *Signer {
+	return NewSigner(testRegistry{})
+}
+
+// The key and addresses are the BIP-322 test vectors.
+const testWIF = "L3VFeEujGtevx9w18HD1fhRbCH67Az2dpCymeRE1SoPK6XQtaN2k"
+
+func testKey(t *testing.T) *btcec.PrivateKey {
+	t.Helper()
+	wif, err := btcutil.DecodeWIF(testWIF)
+	if err != nil {
+		t.Fatalf("decode WIF: %v", err)
+	}
+	return wif.PrivKey
+}
+
+// testRecord is a wallet on bitcoin-testnet holding the test vector key.
+func testRecord(t *testing.T, addressType service.AddressType) *service.WalletRecord {
+	t.Helper()
+	key := testKey(t)
+	address, err := encodeAddress(key.PubKey(), addressType, &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatalf("encodeAddress returned error: %v", err)
+	}
+	return &service.WalletRecord{
+		Network:     "bitcoin-testnet",
+		Address:     address.EncodeAddress(),
+		PublicKey:   hex.EncodeToString(key.PubKey().SerializeCompressed()),
+		PrivKey:     hex.EncodeToString(key.Serialize()),
+		AddressType: addressType,
+	}
+}
+
+func TestEncodeAddress(t *testing.T) {
+	key := testKey(t)
+	cases := map[service.AddressType]string{
+		service.AddressTypeP2WPKH: "bc1q9vza2e8x573nczrlzms0wvx3gsqjx7vavgkx0l",
+		service.AddressTypeP2TR:   "bc1ppv609nr0vr25u07u95waq5lucwfm6tde4nydujnu8npg4q75mr5sxq8lt3",
+	}
+	for addressType, want := range cases {
+		address, err := encodeAddress(key.PubKey(), addressType, &chaincfg.MainNetParams)
+		if err != nil {
+			t.Fatalf("%s: encodeAddress returned error: %v", addressType, err)
+		}
+		if address.EncodeAddress() != want {
+			t.Errorf("%s: got %s, want %s", addressType, address.EncodeAddress(), want)
+		}
+	}
+	if _, err := encodeAddress(key.PubKey(), "p2pkh", &chaincfg.MainNetParams); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for an unsupported type, got %v", err)
+	}
+}
+
+func TestNewWallet(t *testing.T) {
+	signer := testSigner()
+
+	record, err := signer.NewWallet("bitcoin-signet", "")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	if record.AddressType != service.AddressTypeP2WPKH || record.Address[:4] != "tb1q" {
+		t.Fatalf("expected a P2WPKH signet address, got %+v", record)
+	}
+	if _, err := signer.loadKey(record); err != nil {
+		t.Fatalf("loadKey returned error: %v", err)
+	}
+
+	taproot, err := signer.NewWallet("bitcoin-signet", service.AddressTypeP2TR)
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	if taproot.Address[:4] != "tb1p" {
+		t.Fatalf("expected a P2TR signet address, got %s", taproot.Address)
+	}
+
+	if _, err := signer.NewWallet("eth-sepolia", ""); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for a non-bitcoin network, got %v", err)
+	}
+
+	regtest, err := signer.NewWallet("local-regtest", "")
+	if err != nil || regtest.Address[:6] != "bcrt1q" {
+		t.Fatalf("expected a regtest address from the declared chain, got %+v (%v)", regtest, err)
+	}
+}
+
+func TestMessageHash(t *testing.T) {
+	cases := map[string]string{
+		"":            "c90c269c4f8fcbe6880f72a721ddfbf1914268a794cbb21cfafee13770ae19f1",
+		"Hello World": "f0eb03b1a75ac6d9847f55c624a99169b5dccba2a31f5b23bea77ba270de0a7a",
+	}
+	for message, want := range cases {
+		if got := hex.EncodeToString(messageHash([]byte(message))[:]); got != want {
+			t.Errorf("messageHash(%q) = %s, want %s", message, got, want)
+		}
+	}
+}
+
+func TestSignMessageVerifies(t *testing.T) {
+	for _, addressType := range []service.AddressType{service.AddressTypeP2WPKH, service.AddressTypeP2TR} {
+		record := testRecord(t, addressType)
+		output, err := testSigner().SignMessage(record, []byte("Hello World"))
+		if err != nil {
+			t.Fatalf("%s: SignMessage returned error: %v", addressType, err)
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(output.Signature)
+		if err != nil {
+			t.Fatalf("%s: signature is not base64: %v", addressType, err)
+		}
+		key, _ := testSigner().loadKey(record)
+		toSign := messageTransaction([]byte("Hello World"), key.pkScript)
+		toSign.TxIn[0].Witness = decodeWitness(t, raw)
+		verifyInput(t, toSign, 0, key.pkScript, 0, txscript.NewCannedPrevOutputFetcher(key.pkScript, 0))
+	}
+}
+
+func TestSignSerializedTransactionIsRejected(t *testing.T) {
+	if _, err := testSigner().SignSerializedTransaction(testRecord(t, service.AddressTypeP2WPKH), "AQID"); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+}
+
+func decodeWitness(t *testing.T, raw []byte) wire.TxWitness {
+	t.Helper()
+	r := bytes.NewReader(raw)
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		t.Fatalf("read witness count: %v", err)
+	}
+	witness := make(wire.TxWitness, count)
+	for i := range witness {
+		if witness[i], err = wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "witness item"); err != nil {
+			t.Fatalf("read witness item %d: %v", i, err)
+		}
+	}
+	return witness
+}
+
+func verifyInput(t *testing.T, tx *wire.MsgTx, idx int, pkScript []byte, amount int64, prevOuts txscript.PrevOutputFetcher) {
+	t.Helper()
+	engine, err := txscript.NewEngine(pkScript, tx, idx, txscript.StandardVerifyFlags, nil, txscript.NewTxSigHashes(tx, prevOuts), amount, prevOuts)
+	if err != nil {
+		t.Fatalf("NewEngine returned error: %v", err)
+	}
+	if err := engine.Execute(); err != nil {
+		t.Fatalf("input %d does not verify: %v", idx, err)
+	}
+}
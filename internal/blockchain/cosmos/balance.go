@@ -0,0 +1,53 @@
+package cosmos
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// BalanceFetcher reads bank balances from a Cosmos SDK REST endpoint, the
+// gRPC gateway nodes serve alongside gRPC.
+type BalanceFetcher struct {
+	client *http.Client
+}
+
+func NewBalanceFetcher() *BalanceFetcher {
+	return &BalanceFetcher{client: http.DefaultClient}
+}
+
+// FetchDenomBalance returns the bank balance of address in denom, in its
+// base units.
+func (f *BalanceFetcher) FetchDenomBalance(ctx context.Context, apiURL string, address string, denom string) (string, error) {
+	endpoint := strings.TrimRight(apiURL, "/") + "/cosmos/bank/v1beta1/balances/" + url.PathEscape(address) + "/by_denom?denom=" + url.QueryEscape(denom)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("build request: %w", err)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("get balance: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get balance: unexpected status %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Balance struct {
+			Denom  string `json:"denom"`
+			Amount string `json:"amount"`
+		} `json:"balance"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("decode balance: %w", err)
+	}
+	if body.Balance.Amount == "" {
+		return "0", nil
+	}
+	return body.Balance.Amount, nil
+}
@@ -0,0 +1,37 @@
+package cosmos
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchDenomBalanceReadsBank(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/cosmos/bank/v1beta1/balances/osmo1abc/by_denom" || r.URL.Query().Get("denom") != "uosmo" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"balance":{"denom":"uosmo","amount":"2500000"}}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchDenomBalance(context.Background(), server.URL+"/", "osmo1abc", "uosmo")
+	if err != nil {
+		t.Fatalf("FetchDenomBalance returned error: %v", err)
+	}
+	if balance != "2500000" {
+		t.Fatalf("expected 2500000 uosmo, got %s", balance)
+	}
+}
+
+func TestFetchDenomBalanceReturnsHTTPErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"code":3,"message":"invalid address"}`, http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	if _, err := NewBalanceFetcher().FetchDenomBalance(context.Background(), server.URL, "bad", "uosmo"); err == nil {
+		t.Fatal("expected an error for a bad request")
+	}
+}
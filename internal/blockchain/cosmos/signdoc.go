@@ -0,0 +1,69 @@
+package cosmos
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// signDoc holds the fields of a cosmos.tx.v1beta1.SignDoc that signing
+// needs. The body and auth info stay encoded, as they are signed, and the
+// account number is only checked to be well formed.
+type signDoc struct {
+	bodyBytes     []byte
+	authInfoBytes []byte
+	chainID       string
+}
+
+func parseSignDoc(raw []byte) (*signDoc, error) {
+	doc := &signDoc{}
+	for len(raw) > 0 {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return nil, fmt.Errorf("decode sign doc: %w", protowire.ParseError(n))
+		}
+		raw = raw[n:]
+
+		switch {
+		case num >= 1 && num <= 3 && typ == protowire.BytesType:
+			value, n := protowire.ConsumeBytes(raw)
+			if n < 0 {
+				return nil, fmt.Errorf("decode sign doc field %d: %w", num, protowire.ParseError(n))
+			}
+			switch num {
+			case 1:
+				doc.bodyBytes = value
+			case 2:
+				doc.authInfoBytes = value
+			case 3:
+				doc.chainID = string(value)
+			}
+			raw = raw[n:]
+		case num == 4 && typ == protowire.VarintType:
+			_, n := protowire.ConsumeVarint(raw)
+			if n < 0 {
+				return nil, fmt.Errorf("decode sign doc field %d: %w", num, protowire.ParseError(n))
+			}
+			raw = raw[n:]
+		default:
+			return nil, fmt.Errorf("decode sign doc: unexpected field %d", num)
+		}
+	}
+	if len(doc.bodyBytes) == 0 || len(doc.authInfoBytes) == 0 {
+		return nil, errors.New("sign doc has no body or auth info")
+	}
+	return doc, nil
+}
+
+// txRaw encodes the cosmos.tx.v1beta1.TxRaw that carries the doc's body and
+// auth info with signature.
+func (d *signDoc) txRaw(signature []byte) []byte {
+	var raw []byte
+	raw = protowire.AppendTag(raw, 1, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, d.bodyBytes)
+	raw = protowire.AppendTag(raw, 2, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, d.authInfoBytes)
+	raw = protowire.AppendTag(raw, 3, protowire.BytesType)
+	return protowire.AppendBytes(raw, signature)
+}
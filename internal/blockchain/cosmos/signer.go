@@ -0,0 +1,202 @@
+package cosmos
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// KeyGenerator creates secp256k1 keys, stored as hex like the Ethereum
+// signer stores them.
+type KeyGenerator interface {
+	NewWallet(network string) (*service.WalletRecord, error)
+}
+
+// Signer holds secp256k1 keys for Cosmos SDK chains. Addresses are the
+// bech32 RIPEMD160(SHA256) of the compressed public key under the network's
+// prefix, public keys are the base64 compressed point, and signatures are
+// the base64 64-byte r||s over the SHA-256 of the signed bytes.
+type Signer struct {
+	keys     KeyGenerator
+	registry service.NetworkRegistry
+}
+
+func NewSigner(keys KeyGenerator, registry service.NetworkRegistry) *Signer {
+	return &Signer{keys: keys, registry: registry}
+}
+
+func (s *Signer) NewWallet(network string, addressType service.AddressType) (*service.WalletRecord, error) {
+	if addressType != "" {
+		return nil, fmt.Errorf("%w: cosmos wallets do not have address types", service.ErrValidation)
+	}
+	resolved, err := s.network(network)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.keys.NewWallet(network)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.HexToECDSA(record.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	address, err := encodeAddress(resolved.Bech32Prefix, &key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	record.Address = address
+	record.PublicKey = base64.StdEncoding.EncodeToString(crypto.CompressPubkey(&key.PublicKey))
+	return record, nil
+}
+
+// SignMessage signs payload as an ADR-036 MsgSignData, the offline sign doc
+// Keplr's signArbitrary produces and verifyArbitrary checks.
+func (s *Signer) SignMessage(record *service.WalletRecord, payload []byte) (*service.SignatureOutput, error) {
+	key, err := loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := arbitraryDoc(record.Address, payload)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := sign(key, doc)
+	if err != nil {
+		return nil, err
+	}
+	return &service.SignatureOutput{
+		Signature: base64.StdEncoding.EncodeToString(signature),
+		PublicKey: base64.StdEncoding.EncodeToString(crypto.CompressPubkey(&key.PublicKey)),
+	}, nil
+}
+
+// SignSerializedTransaction signs a base64 SignDoc in SIGN_MODE_DIRECT and
+// returns the base64 TxRaw ready to broadcast. The wallet must be the
+// transaction's only signer, since TxRaw carries every signature.
+func (s *Signer) SignSerializedTransaction(record *service.WalletRecord, tx string) (*service.SerializedTransactionResult, error) {
+	key, err := loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+	resolved, err := s.network(record.Network)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.StdEncoding.DecodeString(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: sign doc is not base64", service.ErrValidation)
+	}
+	doc, err := parseSignDoc(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+	}
+	if doc.chainID != resolved.CosmosChainID {
+		return nil, fmt.Errorf("%w: sign doc is for chain %q, not %q", service.ErrValidation, doc.chainID, resolved.CosmosChainID)
+	}
+
+	signature, err := sign(key, raw)
+	if err != nil {
+		return nil, err
+	}
+	return &service.SerializedTransactionResult{
+		Transaction: base64.StdEncoding.EncodeToString(doc.txRaw(signature)),
+		Signature:   base64.StdEncoding.EncodeToString(signature),
+	}, nil
+}
+
+func (s *Signer) network(network string) (*service.Network, error) {
+	resolved, err := s.registry.Lookup(network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	if resolved.Family != service.ChainFamilyCosmos || resolved.Bech32Prefix == "" {
+		return nil, fmt.Errorf("%w: %s is not a cosmos network", service.ErrValidation, network)
+	}
+	return resolved, nil
+}
+
+func encodeAddress(prefix string, pub *ecdsa.PublicKey) (string, error) {
+	data, err := bech32.ConvertBits(btcutil.Hash160(crypto.CompressPubkey(pub)), 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("encode address: %w", err)
+	}
+	address, err := bech32.Encode(prefix, data)
+	if err != nil {
+		return "", fmt.Errorf("encode address: %w", err)
+	}
+	return address, nil
+}
+
+func loadKey(record *service.WalletRecord) (*ecdsa.PrivateKey, error) {
+	key, err := crypto.HexToECDSA(record.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	prefix, _, err := bech32.Decode(record.Address)
+	if err != nil {
+		return nil, fmt.Errorf("decode address: %w", err)
+	}
+	if address, err := encodeAddress(prefix, &key.PublicKey); err != nil || address != record.Address {
+		return nil, fmt.Errorf("private key does not match address %s", record.Address)
+	}
+	return key, nil
+}
+
+// sign returns the low-S r||s signature Cosmos SDK secp256k1 keys verify.
+func sign(key *ecdsa.PrivateKey, message []byte) ([]byte, error) {
+	digest := sha256.Sum256(message)
+	sig, err := crypto.Sign(digest[:], key)
+	if err != nil {
+		return nil, fmt.Errorf("sign digest: %w", err)
+	}
+	return sig[:64], nil
+}
+
+// arbitraryDoc is the amino JSON sign doc of ADR-036: a MsgSignData with
+// an empty chain ID, fee, memo, account number and sequence. Fields are in
+// sorted order and the JSON is compact, as amino's canonical form requires.
+func arbitraryDoc(signer string, data []byte) ([]byte, error) {
+	type msgValue struct {
+		Data   string `json:"data"`
+		Signer string `json:"signer"`
+	}
+	type msg struct {
+		Type  string   `json:"type"`
+		Value msgValue `json:"value"`
+	}
+	type fee struct {
+		Amount []struct{} `json:"amount"`
+		Gas    string     `json:"gas"`
+	}
+	doc := struct {
+		AccountNumber string `json:"account_number"`
+		ChainID       string `json:"chain_id"`
+		Fee           fee    `json:"fee"`
+		Memo          string `json:"memo"`
+		Msgs          []msg  `json:"msgs"`
+		Sequence      string `json:"sequence"`
+	}{
+		AccountNumber: "0",
+		Fee:           fee{Amount: []struct{}{}, Gas: "0"},
+		Msgs: []msg{{
+			Type:  "sign/MsgSignData",
+			Value: msgValue{Data: base64.StdEncoding.EncodeToString(data), Signer: signer},
+		}},
+		Sequence: "0",
+	}
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode sign doc: %w", err)
+	}
+	return encoded, nil
+}
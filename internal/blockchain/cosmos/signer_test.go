@@ -0,0 +1,161 @@
+package cosmos
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type testRegistry struct{}
+
+func (testRegistry) Lookup(network string) (*service.Network, error) {
+	if network == "osmosis-testnet" {
+		return &service.Network{Name: network, Family: service.ChainFamilyCosmos, CosmosChainID: "osmo-test-5", Bech32Prefix: "osmo"}, nil
+	}
+	return &service.Network{Name: network, Family: service.ChainFamilyEthereum, ChainID: 11155111}, nil
+}
+
+func testSigner() *Signer {
+	return NewSigner(ethereum.NewSigner(), testRegistry{})
+}
+
+func testRecord(t *testing.T) *service.WalletRecord {
+	t.Helper()
+	record, err := testSigner().NewWallet("osmosis-testnet", "")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	return record
+}
+
+func TestNewWalletDerivesBech32Address(t *testing.T) {
+	record := testRecord(t)
+
+	prefix, data, err := bech32.Decode(record.Address)
+	if err != nil {
+		t.Fatalf("address %s is not bech32: %v", record.Address, err)
+	}
+	hash, _ := bech32.ConvertBits(data, 5, 8, false)
+	pub, err := base64.StdEncoding.DecodeString(record.PublicKey)
+	if err != nil || len(pub) != 33 {
+		t.Fatalf("expected a base64 compressed public key, got %q", record.PublicKey)
+	}
+	if prefix != "osmo" || !bytes.Equal(hash, btcutil.Hash160(pub)) {
+		t.Fatalf("address %s does not commit to the public key", record.Address)
+	}
+
+	if _, err := testSigner().NewWallet("eth-sepolia", ""); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for a non-cosmos network, got %v", err)
+	}
+	if _, err := testSigner().NewWallet("osmosis-testnet", service.AddressTypeP2TR); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for an address type, got %v", err)
+	}
+}
+
+func TestArbitraryDoc(t *testing.T) {
+	doc, err := arbitraryDoc("osmo1abc", []byte("hello"))
+	if err != nil {
+		t.Fatalf("arbitraryDoc returned error: %v", err)
+	}
+	want := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"","msgs":[{"type":"sign/MsgSignData","value":{"data":"aGVsbG8=","signer":"osmo1abc"}}],"sequence":"0"}`
+	if string(doc) != want {
+		t.Fatalf("got %s\nwant %s", doc, want)
+	}
+}
+
+func TestSignMessageVerifies(t *testing.T) {
+	record := testRecord(t)
+	output, err := testSigner().SignMessage(record, []byte("hello"))
+	if err != nil {
+		t.Fatalf("SignMessage returned error: %v", err)
+	}
+
+	doc, _ := arbitraryDoc(record.Address, []byte("hello"))
+	verify(t, output.PublicKey, output.Signature, doc)
+	if output.PublicKey != record.PublicKey {
+		t.Fatalf("unexpected public key %s", output.PublicKey)
+	}
+}
+
+func TestSignSerializedTransactionReturnsTxRaw(t *testing.T) {
+	record := testRecord(t)
+	doc := encodeSignDoc([]byte("body"), []byte("auth"), "osmo-test-5", 42)
+
+	result, err := testSigner().SignSerializedTransaction(record, base64.StdEncoding.EncodeToString(doc))
+	if err != nil {
+		t.Fatalf("SignSerializedTransaction returned error: %v", err)
+	}
+	verify(t, record.PublicKey, result.Signature, doc)
+
+	raw, _ := base64.StdEncoding.DecodeString(result.Transaction)
+	signature, _ := base64.StdEncoding.DecodeString(result.Signature)
+	var want []byte
+	for i, field := range [][]byte{[]byte("body"), []byte("auth"), signature} {
+		want = protowire.AppendTag(want, protowire.Number(i+1), protowire.BytesType)
+		want = protowire.AppendBytes(want, field)
+	}
+	if !bytes.Equal(raw, want) {
+		t.Fatalf("unexpected TxRaw %x", raw)
+	}
+}
+
+func TestSignSerializedTransactionChecksSignDoc(t *testing.T) {
+	record := testRecord(t)
+	cases := map[string]string{
+		"other chain": base64.StdEncoding.EncodeToString(encodeSignDoc([]byte("body"), []byte("auth"), "osmosis-1", 42)),
+		"no body":     base64.StdEncoding.EncodeToString(encodeSignDoc(nil, []byte("auth"), "osmo-test-5", 42)),
+		"not base64":  "not base64!",
+		"not a doc":   base64.StdEncoding.EncodeToString([]byte{0xff}),
+	}
+	for name, tx := range cases {
+		if _, err := testSigner().SignSerializedTransaction(record, tx); !errors.Is(err, service.ErrValidation) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestLoadKeyChecksAddress(t *testing.T) {
+	record := testRecord(t)
+	record.Address = testRecord(t).Address
+	if _, err := testSigner().SignMessage(record, []byte("hello")); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected a key mismatch, got %v", err)
+	}
+}
+
+func encodeSignDoc(body, authInfo []byte, chainID string, accountNumber uint64) []byte {
+	var raw []byte
+	if body != nil {
+		raw = protowire.AppendTag(raw, 1, protowire.BytesType)
+		raw = protowire.AppendBytes(raw, body)
+	}
+	raw = protowire.AppendTag(raw, 2, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, authInfo)
+	raw = protowire.AppendTag(raw, 3, protowire.BytesType)
+	raw = protowire.AppendString(raw, chainID)
+	raw = protowire.AppendTag(raw, 4, protowire.VarintType)
+	return protowire.AppendVarint(raw, accountNumber)
+}
+
+func verify(t *testing.T, publicKey, signature string, message []byte) {
+	t.Helper()
+	pub, _ := base64.StdEncoding.DecodeString(publicKey)
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(sig) != 64 {
+		t.Fatalf("expected a base64 64-byte signature, got %q", signature)
+	}
+	digest := sha256.Sum256(message)
+	if !crypto.VerifySignature(pub, digest[:], sig) {
+		t.Fatal("signature does not verify")
+	}
+}
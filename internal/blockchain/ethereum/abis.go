@@ -0,0 +1,93 @@
+package ethereum
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Standards in the built-in ABI registry.
+const (
+	StandardERC20     = "erc20"
+	StandardERC721    = "erc721"
+	StandardERC1155   = "erc1155"
+	StandardMulticall = "multicall"
+	StandardPermit2   = "permit2"
+)
+
+// knownSignatures lists the state-changing and common view functions of each
+// standard. Parameter names are kept so decoded arguments are labelled.
+var knownSignatures = map[string][]string{
+	StandardERC20: {
+		"transfer(address to, uint256 amount)",
+		"transferFrom(address from, address to, uint256 amount)",
+		"approve(address spender, uint256 amount)",
+		"increaseAllowance(address spender, uint256 addedValue)",
+		"decreaseAllowance(address spender, uint256 subtractedValue)",
+		"permit(address owner, address spender, uint256 value, uint256 deadline, uint8 v, bytes32 r, bytes32 s)",
+		"balanceOf(address account)",
+		"allowance(address owner, address spender)",
+	},
+	StandardERC721: {
+		"transferFrom(address from, address to, uint256 tokenId)",
+		"safeTransferFrom(address from, address to, uint256 tokenId)",
+		"safeTransferFrom(address from, address to, uint256 tokenId, bytes data)",
+		"approve(address to, uint256 tokenId)",
+		"setApprovalForAll(address operator, bool approved)",
+		"ownerOf(uint256 tokenId)",
+		"balanceOf(address owner)",
+	},
+	StandardERC1155: {
+		"safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data)",
+		"safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data)",
+		"setApprovalForAll(address operator, bool approved)",
+		"balanceOf(address account, uint256 id)",
+		"balanceOfBatch(address[] accounts, uint256[] ids)",
+	},
+	StandardMulticall: {
+		"aggregate((address target, bytes callData)[] calls)",
+		"tryAggregate(bool requireSuccess, (address target, bytes callData)[] calls)",
+		"aggregate3((address target, bool allowFailure, bytes callData)[] calls)",
+		"aggregate3Value((address target, bool allowFailure, uint256 value, bytes callData)[] calls)",
+		"multicall(bytes[] data)",
+		"multicall(uint256 deadline, bytes[] data)",
+	},
+	StandardPermit2: {
+		"approve(address token, address spender, uint160 amount, uint48 expiration)",
+		"transferFrom(address from, address to, uint160 amount, address token)",
+		"permit(address owner, ((address token, uint160 amount, uint48 expiration, uint48 nonce) details, address spender, uint256 sigDeadline) permitSingle, bytes signature)",
+		"permit(address owner, ((address token, uint160 amount, uint48 expiration, uint48 nonce)[] details, address spender, uint256 sigDeadline) permitBatch, bytes signature)",
+		"permitTransferFrom(((address token, uint256 amount) permitted, uint256 nonce, uint256 deadline) permit, (address to, uint256 requestedAmount) transferDetails, address owner, bytes signature)",
+		"invalidateNonces(address token, address spender, uint48 newNonce)",
+		"lockdown((address token, address spender)[] approvals)",
+	},
+}
+
+type registryEntry struct {
+	method    *abi.Method
+	standards []string
+}
+
+// abiRegistry maps 4-byte selectors to known methods. When standards share a
+// selector, the first definition's parameter names are used.
+type abiRegistry map[string]*registryEntry
+
+func newABIRegistry() abiRegistry {
+	registry := make(abiRegistry)
+	for _, standard := range []string{StandardERC20, StandardERC721, StandardERC1155, StandardMulticall, StandardPermit2} {
+		for _, signature := range knownSignatures[standard] {
+			method, err := parseSignature(signature)
+			if err != nil {
+				panic(fmt.Sprintf("invalid built-in signature %q: %v", signature, err))
+			}
+			selector := hexutil.Encode(method.ID)
+			if entry, ok := registry[selector]; ok {
+				entry.standards = append(entry.standards, standard)
+				continue
+			}
+			registry[selector] = &registryEntry{method: method, standards: []string{standard}}
+		}
+	}
+	return registry
+}
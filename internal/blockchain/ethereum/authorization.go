@@ -0,0 +1,130 @@
+package ethereum
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// SignAuthorization signs the EIP-7702 tuple (chainId, address, nonce).
+// yParity is 0 or 1 and r and s are 32-byte hex words.
+func (s *Signer) SignAuthorization(privKeyHex string, auth *service.Authorization) (*service.Authorization, error) {
+	privKey, err := crypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	if auth.ChainID < 0 || !common.IsHexAddress(auth.Address) {
+		return nil, fmt.Errorf("%w: invalid authorization", service.ErrValidation)
+	}
+
+	signed, err := types.SignSetCode(privKey, types.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(uint64(auth.ChainID)),
+		Address: common.HexToAddress(auth.Address),
+		Nonce:   auth.Nonce,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign authorization: %w", err)
+	}
+	return fromSetCodeAuthorization(signed), nil
+}
+
+func (s *Signer) signSetCodeTransaction(tx *service.Transaction, privKeyHex string) (*types.Transaction, error) {
+	privKey, err := crypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+
+	value, feeCap, tip, err := dynamicFees(tx)
+	if err != nil {
+		return nil, err
+	}
+	authorizations, err := toSetCodeAuthorizations(tx.AuthorizationList)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := big.NewInt(tx.ChainID)
+	signed, err := types.SignNewTx(privKey, types.NewPragueSigner(chainID), &types.SetCodeTx{
+		ChainID:   uint256.MustFromBig(chainID),
+		Nonce:     tx.Nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       tx.GasLimit,
+		To:        common.HexToAddress(tx.To),
+		Value:     value,
+		Data:      common.FromHex(tx.Data),
+		AuthList:  authorizations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	return signed, nil
+}
+
+func toSetCodeAuthorizations(list []service.Authorization) ([]types.SetCodeAuthorization, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	converted := make([]types.SetCodeAuthorization, len(list))
+	for i, auth := range list {
+		authorization, err := toSetCodeAuthorization(auth)
+		if err != nil {
+			return nil, fmt.Errorf("authorization %d: %w", i, err)
+		}
+		converted[i] = authorization
+	}
+	return converted, nil
+}
+
+func toSetCodeAuthorization(auth service.Authorization) (types.SetCodeAuthorization, error) {
+	if auth.ChainID < 0 || !common.IsHexAddress(auth.Address) {
+		return types.SetCodeAuthorization{}, fmt.Errorf("%w: invalid authorization", service.ErrValidation)
+	}
+	r, err := parseUint256(auth.R)
+	if err != nil {
+		return types.SetCodeAuthorization{}, fmt.Errorf("parse r: %w", err)
+	}
+	s, err := parseUint256(auth.S)
+	if err != nil {
+		return types.SetCodeAuthorization{}, fmt.Errorf("parse s: %w", err)
+	}
+	return types.SetCodeAuthorization{
+		ChainID: *uint256.NewInt(uint64(auth.ChainID)),
+		Address: common.HexToAddress(auth.Address),
+		Nonce:   auth.Nonce,
+		V:       auth.YParity,
+		R:       *r,
+		S:       *s,
+	}, nil
+}
+
+func fromSetCodeAuthorization(auth types.SetCodeAuthorization) *service.Authorization {
+	r, s := auth.R.Bytes32(), auth.S.Bytes32()
+	return &service.Authorization{
+		ChainID: int64(auth.ChainID.Uint64()),
+		Address: auth.Address.Hex(),
+		Nonce:   auth.Nonce,
+		YParity: auth.V,
+		R:       hexutil.Encode(r[:]),
+		S:       hexutil.Encode(s[:]),
+	}
+}
+
+func parseUint256(input string) (*uint256.Int, error) {
+	value, err := parseQuantity(input)
+	if err != nil {
+		return nil, err
+	}
+	converted, overflow := uint256.FromBig(value)
+	if overflow {
+		return nil, fmt.Errorf("quantity %q exceeds 256 bits", input)
+	}
+	return converted, nil
+}
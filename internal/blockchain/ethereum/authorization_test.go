@@ -0,0 +1,76 @@
+package ethereum
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestSignSetCodeTransaction(t *testing.T) {
+	signer := NewSigner()
+	record, err := signer.NewWallet("eth-sepolia")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	delegate := "0x" + strings.Repeat("ab", 20)
+
+	authorization, err := signer.SignAuthorization(record.PrivKey, &service.Authorization{
+		ChainID: 11155111,
+		Address: delegate,
+		Nonce:   1,
+	})
+	if err != nil {
+		t.Fatalf("SignAuthorization returned error: %v", err)
+	}
+	if len(authorization.R) != 66 || len(authorization.S) != 66 || authorization.YParity > 1 {
+		t.Fatalf("unexpected authorization signature: %+v", authorization)
+	}
+	converted, err := toSetCodeAuthorization(*authorization)
+	if err != nil {
+		t.Fatalf("convert authorization: %v", err)
+	}
+	authority, err := converted.Authority()
+	if err != nil || authority.Hex() != record.Address {
+		t.Fatalf("expected authority %s, got %s (%v)", record.Address, authority.Hex(), err)
+	}
+
+	raw, err := signer.SignTransaction(&service.Transaction{
+		ChainID:              11155111,
+		To:                   record.Address,
+		Value:                "0x0",
+		Data:                 "0x",
+		GasLimit:             100000,
+		GasPrice:             "0x77359400",
+		MaxPriorityFeePerGas: "0x3b9aca00",
+		Nonce:                0,
+		AuthorizationList:    []service.Authorization{*authorization},
+	}, record.PrivKey)
+	if err != nil {
+		t.Fatalf("SignTransaction returned error: %v", err)
+	}
+
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(hexutil.MustDecode(raw)); err != nil {
+		t.Fatalf("decode signed transaction: %v", err)
+	}
+	if tx.Type() != types.SetCodeTxType {
+		t.Fatalf("expected type %d, got %d", types.SetCodeTxType, tx.Type())
+	}
+	if tx.GasFeeCap().Cmp(big.NewInt(2000000000)) != 0 || tx.GasTipCap().Cmp(big.NewInt(1000000000)) != 0 {
+		t.Fatalf("unexpected fees: cap %s tip %s", tx.GasFeeCap(), tx.GasTipCap())
+	}
+	auths := tx.SetCodeAuthorizations()
+	if len(auths) != 1 || auths[0].Address != common.HexToAddress(delegate) || auths[0].Nonce != 1 {
+		t.Fatalf("unexpected authorization list: %+v", auths)
+	}
+	sender, err := types.Sender(types.LatestSignerForChainID(big.NewInt(11155111)), &tx)
+	if err != nil || sender.Hex() != record.Address {
+		t.Fatalf("expected sender %s, got %s (%v)", record.Address, sender.Hex(), err)
+	}
+}
@@ -0,0 +1,109 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func (s *Signer) signBlobTransaction(tx *service.Transaction, privKeyHex string) (*types.Transaction, error) {
+	privKey, err := crypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+
+	value, feeCap, tip, err := dynamicFees(tx)
+	if err != nil {
+		return nil, err
+	}
+	blobFeeCap, err := parseUint256(tx.MaxFeePerBlobGas)
+	if err != nil {
+		return nil, fmt.Errorf("parse max fee per blob gas: %w", err)
+	}
+	sidecar, err := blobSidecar(tx.Blobs)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID := big.NewInt(tx.ChainID)
+	signed, err := types.SignNewTx(privKey, types.NewCancunSigner(chainID), &types.BlobTx{
+		ChainID:    uint256.MustFromBig(chainID),
+		Nonce:      tx.Nonce,
+		GasTipCap:  tip,
+		GasFeeCap:  feeCap,
+		Gas:        tx.GasLimit,
+		To:         common.HexToAddress(tx.To),
+		Value:      value,
+		Data:       common.FromHex(tx.Data),
+		BlobFeeCap: blobFeeCap,
+		BlobHashes: sidecar.BlobHashes(),
+		Sidecar:    sidecar,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	return signed, nil
+}
+
+// blobSidecar computes the KZG commitments and cell proofs of blobs. The
+// sidecar uses cell proofs (version 1), which nodes require since Osaka.
+func blobSidecar(blobs []string) (*types.BlobTxSidecar, error) {
+	decoded, commitments, err := blobCommitments(blobs)
+	if err != nil {
+		return nil, err
+	}
+	proofs := make([]kzg4844.Proof, 0, len(decoded)*kzg4844.CellProofsPerBlob)
+	for i := range decoded {
+		cellProofs, err := kzg4844.ComputeCellProofs(&decoded[i])
+		if err != nil {
+			return nil, fmt.Errorf("compute blob %d proofs: %w", i, err)
+		}
+		proofs = append(proofs, cellProofs...)
+	}
+	return types.NewBlobTxSidecar(types.BlobSidecarVersion1, decoded, commitments, proofs), nil
+}
+
+// blobCommitments zero-pads each blob to full size and computes its KZG
+// commitment. A blob whose 32-byte field elements exceed the BLS modulus
+// is rejected.
+func blobCommitments(blobs []string) ([]kzg4844.Blob, []kzg4844.Commitment, error) {
+	decoded := make([]kzg4844.Blob, len(blobs))
+	commitments := make([]kzg4844.Commitment, len(blobs))
+	for i, blob := range blobs {
+		data, err := hexutil.Decode(blob)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: blob %d: %v", service.ErrValidation, i, err)
+		}
+		if len(data) > len(decoded[i]) {
+			return nil, nil, fmt.Errorf("%w: blob %d exceeds %d bytes", service.ErrValidation, i, len(decoded[i]))
+		}
+		copy(decoded[i][:], data)
+		if commitments[i], err = kzg4844.BlobToCommitment(&decoded[i]); err != nil {
+			return nil, nil, fmt.Errorf("%w: blob %d is not a valid blob: %v", service.ErrValidation, i, err)
+		}
+	}
+	return decoded, commitments, nil
+}
+
+// blobHashes returns the versioned hashes of blobs.
+func blobHashes(blobs []string) ([]common.Hash, error) {
+	_, commitments, err := blobCommitments(blobs)
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha256.New()
+	hashes := make([]common.Hash, len(commitments))
+	for i := range commitments {
+		hashes[i] = kzg4844.CalcBlobHashV1(hasher, &commitments[i])
+	}
+	return hashes, nil
+}
@@ -0,0 +1,70 @@
+package ethereum
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestSignBlobTransaction(t *testing.T) {
+	signer := NewSigner()
+	record, err := signer.NewWallet("eth-sepolia")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	tx := &service.Transaction{
+		ChainID:          11155111,
+		To:               "0x" + strings.Repeat("ab", 20),
+		Value:            "0x0",
+		GasLimit:         21000,
+		GasPrice:         "0x77359400",
+		Nonce:            3,
+		MaxFeePerBlobGas: "0x3b9aca00",
+		Blobs:            []string{"0x00" + strings.Repeat("01", 31), "0x0068656c6c6f"},
+	}
+
+	raw, err := signer.SignTransaction(tx, record.PrivKey)
+	if err != nil {
+		t.Fatalf("SignTransaction returned error: %v", err)
+	}
+
+	var signed types.Transaction
+	if err := signed.UnmarshalBinary(hexutil.MustDecode(raw)); err != nil {
+		t.Fatalf("decode signed transaction: %v", err)
+	}
+	sidecar := signed.BlobTxSidecar()
+	if signed.Type() != types.BlobTxType || sidecar == nil {
+		t.Fatalf("expected a blob transaction with sidecar, got type %d", signed.Type())
+	}
+	if sidecar.Version != types.BlobSidecarVersion1 || len(sidecar.Blobs) != 2 {
+		t.Fatalf("unexpected sidecar: version %d with %d blobs", sidecar.Version, len(sidecar.Blobs))
+	}
+	if err := sidecar.ValidateBlobCommitmentHashes(signed.BlobHashes()); err != nil {
+		t.Fatalf("versioned hashes do not match commitments: %v", err)
+	}
+	if err := kzg4844.VerifyCellProofs(sidecar.Blobs, sidecar.Commitments, sidecar.Proofs); err != nil {
+		t.Fatalf("cell proofs do not verify: %v", err)
+	}
+
+	decoded, err := NewDecoder().DecodeTransaction(raw, "")
+	if err != nil {
+		t.Fatalf("DecodeTransaction returned error: %v", err)
+	}
+	if decoded.From != record.Address || decoded.MaxFeePerBlobGas != "1000000000" || len(decoded.BlobVersionedHashes) != 2 {
+		t.Fatalf("unexpected decoded transaction: %+v", decoded)
+	}
+	if decoded.BlobVersionedHashes[0] != signed.BlobHashes()[0].Hex() {
+		t.Fatalf("expected hash %s, got %s", signed.BlobHashes()[0].Hex(), decoded.BlobVersionedHashes[0])
+	}
+
+	tx.Blobs = []string{"0x" + strings.Repeat("ff", 32)}
+	if _, err := signer.SignTransaction(tx, record.PrivKey); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for a non-canonical field element, got %v", err)
+	}
+}
@@ -0,0 +1,47 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+type Broadcaster struct {
+	clientFactory func(rpcURL string) (*ethclient.Client, error)
+}
+
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{
+		clientFactory: ethclient.Dial,
+	}
+}
+
+func (b *Broadcaster) WithClientFactory(factory func(rpcURL string) (*ethclient.Client, error)) {
+	b.clientFactory = factory
+}
+
+// Broadcast submits a raw signed transaction and returns its hash.
+func (b *Broadcaster) Broadcast(ctx context.Context, rpcURL string, signedTx string) (string, error) {
+	raw, err := hexutil.Decode(signedTx)
+	if err != nil {
+		return "", fmt.Errorf("decode signed tx: %w", err)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return "", fmt.Errorf("parse signed tx: %w", err)
+	}
+
+	client, err := b.clientFactory(rpcURL)
+	if err != nil {
+		return "", fmt.Errorf("dial rpc: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.SendTransaction(ctx, &tx); err != nil {
+		return "", fmt.Errorf("send transaction: %w", err)
+	}
+	return tx.Hash().Hex(), nil
+}
@@ -0,0 +1,286 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+var (
+	userOpV06Encoding = abi.Arguments{
+		{Type: mustType("address")}, // sender
+		{Type: mustType("uint256")}, // nonce
+		{Type: mustType("bytes32")}, // keccak(initCode)
+		{Type: mustType("bytes32")}, // keccak(callData)
+		{Type: mustType("uint256")}, // callGasLimit
+		{Type: mustType("uint256")}, // verificationGasLimit
+		{Type: mustType("uint256")}, // preVerificationGas
+		{Type: mustType("uint256")}, // maxFeePerGas
+		{Type: mustType("uint256")}, // maxPriorityFeePerGas
+		{Type: mustType("bytes32")}, // keccak(paymasterAndData)
+	}
+	userOpV07Encoding = abi.Arguments{
+		{Type: mustType("address")}, // sender
+		{Type: mustType("uint256")}, // nonce
+		{Type: mustType("bytes32")}, // keccak(initCode)
+		{Type: mustType("bytes32")}, // keccak(callData)
+		{Type: mustType("bytes32")}, // accountGasLimits
+		{Type: mustType("uint256")}, // preVerificationGas
+		{Type: mustType("bytes32")}, // gasFees
+		{Type: mustType("bytes32")}, // keccak(paymasterAndData)
+	}
+	userOpHashEncoding = abi.Arguments{
+		{Type: mustType("bytes32")},
+		{Type: mustType("address")},
+		{Type: mustType("uint256")},
+	}
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// Bundler computes EntryPoint v0.6 and v0.7 user operation hashes and
+// submits operations to an ERC-4337 bundler RPC.
+type Bundler struct {
+	dial func(ctx context.Context, rpcURL string) (*rpc.Client, error)
+}
+
+func NewBundler() *Bundler {
+	return &Bundler{dial: rpc.DialContext}
+}
+
+// HashUserOperation returns the hash the EntryPoint's getUserOpHash would:
+// keccak256(abi.encode(keccak256(pack(op)), entryPoint, chainId)).
+func (b *Bundler) HashUserOperation(op *service.UserOperation, entryPoint service.EntryPoint, chainID int64) (string, error) {
+	packed, err := packUserOperation(op, entryPoint.Version)
+	if err != nil {
+		return "", err
+	}
+	var inner [32]byte
+	copy(inner[:], crypto.Keccak256(packed))
+	encoded, err := userOpHashEncoding.Pack(inner, common.HexToAddress(entryPoint.Address), big.NewInt(chainID))
+	if err != nil {
+		return "", fmt.Errorf("encode user operation hash: %w", err)
+	}
+	return hexutil.Encode(crypto.Keccak256(encoded)), nil
+}
+
+// SendUserOperation calls eth_sendUserOperation and returns the hash the
+// bundler reports.
+func (b *Bundler) SendUserOperation(ctx context.Context, bundlerURL string, op *service.UserOperation, entryPoint service.EntryPoint) (string, error) {
+	payload, err := rpcUserOperation(op, entryPoint.Version)
+	if err != nil {
+		return "", err
+	}
+	client, err := b.dial(ctx, bundlerURL)
+	if err != nil {
+		return "", fmt.Errorf("dial bundler: %w", err)
+	}
+	defer client.Close()
+
+	var userOpHash string
+	if err := client.CallContext(ctx, &userOpHash, "eth_sendUserOperation", payload, common.HexToAddress(entryPoint.Address)); err != nil {
+		return "", fmt.Errorf("eth_sendUserOperation: %w", err)
+	}
+	return userOpHash, nil
+}
+
+// userOperationFields holds the decoded fields shared by both versions.
+type userOperationFields struct {
+	sender                common.Address
+	nonce                 *big.Int
+	initCode              []byte
+	callData              []byte
+	callGasLimit          *big.Int
+	verificationGasLimit  *big.Int
+	preVerificationGas    *big.Int
+	maxFeePerGas          *big.Int
+	maxPriorityFeePerGas  *big.Int
+	paymasterAndData      []byte
+	paymasterVerification *big.Int
+	paymasterPostOp       *big.Int
+	paymasterData         []byte
+	factoryData           []byte
+}
+
+func decodeUserOperation(op *service.UserOperation) (*userOperationFields, error) {
+	fields := &userOperationFields{sender: common.HexToAddress(op.Sender)}
+	quantities := []struct {
+		name  string
+		value string
+		dest  **big.Int
+	}{
+		{"nonce", op.Nonce, &fields.nonce},
+		{"callGasLimit", op.CallGasLimit, &fields.callGasLimit},
+		{"verificationGasLimit", op.VerificationGasLimit, &fields.verificationGasLimit},
+		{"preVerificationGas", op.PreVerificationGas, &fields.preVerificationGas},
+		{"maxFeePerGas", op.MaxFeePerGas, &fields.maxFeePerGas},
+		{"maxPriorityFeePerGas", op.MaxPriorityFeePerGas, &fields.maxPriorityFeePerGas},
+		{"paymasterVerificationGasLimit", op.PaymasterVerificationGasLimit, &fields.paymasterVerification},
+		{"paymasterPostOpGasLimit", op.PaymasterPostOpGasLimit, &fields.paymasterPostOp},
+	}
+	for _, q := range quantities {
+		value, err := parseQuantity(q.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", service.ErrValidation, q.name, err)
+		}
+		*q.dest = value
+	}
+
+	byteFields := []struct {
+		name  string
+		value string
+		dest  *[]byte
+	}{
+		{"initCode", op.InitCode, &fields.initCode},
+		{"callData", op.CallData, &fields.callData},
+		{"paymasterAndData", op.PaymasterAndData, &fields.paymasterAndData},
+		{"factoryData", op.FactoryData, &fields.factoryData},
+		{"paymasterData", op.PaymasterData, &fields.paymasterData},
+	}
+	for _, f := range byteFields {
+		if strings.TrimSpace(f.value) == "" {
+			continue
+		}
+		value, err := hexutil.Decode(f.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be 0x-prefixed hex", service.ErrValidation, f.name)
+		}
+		*f.dest = value
+	}
+	return fields, nil
+}
+
+func packUserOperation(op *service.UserOperation, version service.EntryPointVersion) ([]byte, error) {
+	fields, err := decodeUserOperation(op)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case service.EntryPointV06:
+		packed, err := userOpV06Encoding.Pack(
+			fields.sender, fields.nonce,
+			keccak32(fields.initCode), keccak32(fields.callData),
+			fields.callGasLimit, fields.verificationGasLimit, fields.preVerificationGas,
+			fields.maxFeePerGas, fields.maxPriorityFeePerGas,
+			keccak32(fields.paymasterAndData),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: encode user operation: %v", service.ErrValidation, err)
+		}
+		return packed, nil
+	case service.EntryPointV07:
+		accountGasLimits, err := packUint128Pair(fields.verificationGasLimit, fields.callGasLimit)
+		if err != nil {
+			return nil, err
+		}
+		gasFees, err := packUint128Pair(fields.maxPriorityFeePerGas, fields.maxFeePerGas)
+		if err != nil {
+			return nil, err
+		}
+		paymasterAndData, err := v07PaymasterAndData(op, fields)
+		if err != nil {
+			return nil, err
+		}
+		packed, err := userOpV07Encoding.Pack(
+			fields.sender, fields.nonce,
+			keccak32(v07InitCode(op, fields)), keccak32(fields.callData),
+			accountGasLimits, fields.preVerificationGas, gasFees,
+			keccak32(paymasterAndData),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("%w: encode user operation: %v", service.ErrValidation, err)
+		}
+		return packed, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported EntryPoint version %q", service.ErrValidation, version)
+	}
+}
+
+// v07InitCode is factory ++ factoryData, or empty for deployed accounts.
+func v07InitCode(op *service.UserOperation, fields *userOperationFields) []byte {
+	if op.Factory == "" {
+		return nil
+	}
+	return append(common.HexToAddress(op.Factory).Bytes(), fields.factoryData...)
+}
+
+// v07PaymasterAndData is paymaster ++ uint128(verificationGasLimit) ++
+// uint128(postOpGasLimit) ++ paymasterData, or empty without a paymaster.
+func v07PaymasterAndData(op *service.UserOperation, fields *userOperationFields) ([]byte, error) {
+	if op.Paymaster == "" {
+		return nil, nil
+	}
+	limits, err := packUint128Pair(fields.paymasterVerification, fields.paymasterPostOp)
+	if err != nil {
+		return nil, err
+	}
+	out := append(common.HexToAddress(op.Paymaster).Bytes(), limits[:]...)
+	return append(out, fields.paymasterData...), nil
+}
+
+// packUint128Pair packs high and low into one word, as v0.7 does for gas
+// limits and fees.
+func packUint128Pair(high, low *big.Int) ([32]byte, error) {
+	var out [32]byte
+	if high.Cmp(maxUint128) > 0 || low.Cmp(maxUint128) > 0 {
+		return out, fmt.Errorf("%w: gas values must fit in 128 bits", service.ErrValidation)
+	}
+	high.FillBytes(out[:16])
+	low.FillBytes(out[16:])
+	return out, nil
+}
+
+func keccak32(data []byte) [32]byte {
+	var out [32]byte
+	copy(out[:], crypto.Keccak256(data))
+	return out
+}
+
+// rpcUserOperation renders op in the JSON shape bundlers expect for the
+// EntryPoint version: v0.6 sends every field, v0.7 leaves out the factory
+// and paymaster fields when they are unused.
+func rpcUserOperation(op *service.UserOperation, version service.EntryPointVersion) (map[string]interface{}, error) {
+	fields, err := decodeUserOperation(op)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]interface{}{
+		"sender":               fields.sender,
+		"nonce":                (*hexutil.Big)(fields.nonce),
+		"callData":             hexutil.Bytes(fields.callData),
+		"callGasLimit":         (*hexutil.Big)(fields.callGasLimit),
+		"verificationGasLimit": (*hexutil.Big)(fields.verificationGasLimit),
+		"preVerificationGas":   (*hexutil.Big)(fields.preVerificationGas),
+		"maxFeePerGas":         (*hexutil.Big)(fields.maxFeePerGas),
+		"maxPriorityFeePerGas": (*hexutil.Big)(fields.maxPriorityFeePerGas),
+		"signature":            op.Signature,
+	}
+	switch version {
+	case service.EntryPointV06:
+		out["initCode"] = hexutil.Bytes(fields.initCode)
+		out["paymasterAndData"] = hexutil.Bytes(fields.paymasterAndData)
+	case service.EntryPointV07:
+		if op.Factory != "" {
+			out["factory"] = common.HexToAddress(op.Factory)
+			out["factoryData"] = hexutil.Bytes(fields.factoryData)
+		}
+		if op.Paymaster != "" {
+			out["paymaster"] = common.HexToAddress(op.Paymaster)
+			out["paymasterVerificationGasLimit"] = (*hexutil.Big)(fields.paymasterVerification)
+			out["paymasterPostOpGasLimit"] = (*hexutil.Big)(fields.paymasterPostOp)
+			out["paymasterData"] = hexutil.Bytes(fields.paymasterData)
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported EntryPoint version %q", service.ErrValidation, version)
+	}
+	return out, nil
+}
@@ -0,0 +1,139 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func word(value []byte) []byte {
+	return common.LeftPadBytes(value, 32)
+}
+
+func userOperation() *service.UserOperation {
+	return &service.UserOperation{
+		Sender:                        "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Nonce:                         "0x1",
+		Factory:                       "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		FactoryData:                   "0x1234",
+		CallData:                      "0xb61d27f6",
+		CallGasLimit:                  "0x30d40",
+		VerificationGasLimit:          "0x186a0",
+		PreVerificationGas:            "0xc350",
+		MaxFeePerGas:                  "0x3b9aca00",
+		MaxPriorityFeePerGas:          "0x5f5e100",
+		Paymaster:                     "0xcccccccccccccccccccccccccccccccccccccccc",
+		PaymasterVerificationGasLimit: "0x7530",
+		PaymasterPostOpGasLimit:       "0x2710",
+		PaymasterData:                 "0xff",
+	}
+}
+
+func TestHashUserOperationV07PacksGasFields(t *testing.T) {
+	op := userOperation()
+	entryPoint := service.EntryPoint{Version: service.EntryPointV07, Address: service.EntryPointV07Address}
+
+	uint128 := func(v int64) []byte { return common.LeftPadBytes(big.NewInt(v).Bytes(), 16) }
+	initCode := append(common.HexToAddress(op.Factory).Bytes(), 0x12, 0x34)
+	paymasterAndData := bytes.Join([][]byte{common.HexToAddress(op.Paymaster).Bytes(), uint128(0x7530), uint128(0x2710), {0xff}}, nil)
+	packed := bytes.Join([][]byte{
+		word(common.HexToAddress(op.Sender).Bytes()),
+		word([]byte{1}),
+		crypto.Keccak256(initCode),
+		crypto.Keccak256(common.FromHex(op.CallData)),
+		append(uint128(0x186a0), uint128(0x30d40)...),
+		word(big.NewInt(0xc350).Bytes()),
+		append(uint128(0x5f5e100), uint128(0x3b9aca00)...),
+		crypto.Keccak256(paymasterAndData),
+	}, nil)
+	want := crypto.Keccak256(bytes.Join([][]byte{
+		crypto.Keccak256(packed),
+		word(common.HexToAddress(entryPoint.Address).Bytes()),
+		word(big.NewInt(11155111).Bytes()),
+	}, nil))
+
+	got, err := NewBundler().HashUserOperation(op, entryPoint, 11155111)
+	if err != nil {
+		t.Fatalf("HashUserOperation returned error: %v", err)
+	}
+	if got != hexutil.Encode(want) {
+		t.Fatalf("hash = %s, want %s", got, hexutil.Encode(want))
+	}
+
+	other, _ := NewBundler().HashUserOperation(op, entryPoint, 1)
+	if other == got {
+		t.Fatalf("expected the chain ID to change the hash")
+	}
+}
+
+func TestHashUserOperationV06HashesDynamicFields(t *testing.T) {
+	op := &service.UserOperation{
+		Sender:               "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Nonce:                "0x0",
+		InitCode:             "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb1234",
+		CallData:             "0xb61d27f6",
+		CallGasLimit:         "0x30d40",
+		VerificationGasLimit: "0x186a0",
+		PreVerificationGas:   "0xc350",
+		MaxFeePerGas:         "0x3b9aca00",
+		MaxPriorityFeePerGas: "0x5f5e100",
+	}
+	entryPoint := service.EntryPoint{Version: service.EntryPointV06, Address: service.EntryPointV06Address}
+
+	quantity := func(v int64) []byte { return word(big.NewInt(v).Bytes()) }
+	packed := bytes.Join([][]byte{
+		word(common.HexToAddress(op.Sender).Bytes()),
+		quantity(0),
+		crypto.Keccak256(common.FromHex(op.InitCode)),
+		crypto.Keccak256(common.FromHex(op.CallData)),
+		quantity(0x30d40), quantity(0x186a0), quantity(0xc350), quantity(0x3b9aca00), quantity(0x5f5e100),
+		crypto.Keccak256(nil),
+	}, nil)
+	want := crypto.Keccak256(bytes.Join([][]byte{
+		crypto.Keccak256(packed),
+		word(common.HexToAddress(entryPoint.Address).Bytes()),
+		quantity(1),
+	}, nil))
+
+	got, err := NewBundler().HashUserOperation(op, entryPoint, 1)
+	if err != nil {
+		t.Fatalf("HashUserOperation returned error: %v", err)
+	}
+	if got != hexutil.Encode(want) {
+		t.Fatalf("hash = %s, want %s", got, hexutil.Encode(want))
+	}
+}
+
+func TestSendUserOperationReturnsBundlerHash(t *testing.T) {
+	hash := "0x" + strings.Repeat("ab", 32)
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		if method == "eth_sendUserOperation" {
+			return hash, nil
+		}
+		return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+	})
+	defer server.Close()
+
+	op := userOperation()
+	op.Signature = "0x01"
+	got, err := NewBundler().SendUserOperation(context.Background(), server.URL, op, service.EntryPoint{Version: service.EntryPointV07, Address: service.EntryPointV07Address})
+	if err != nil || got != hash {
+		t.Fatalf("SendUserOperation = %q, %v", got, err)
+	}
+
+	payload, err := rpcUserOperation(&service.UserOperation{Sender: op.Sender, CallData: "0x"}, service.EntryPointV06)
+	if err != nil {
+		t.Fatalf("rpcUserOperation returned error: %v", err)
+	}
+	if _, ok := payload["initCode"]; !ok {
+		t.Fatalf("v0.6 operations must carry every field, got %v", payload)
+	}
+}
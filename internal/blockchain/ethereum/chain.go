@@ -0,0 +1,76 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// PendingNonce returns the next nonce for address, counting transactions
+// still in the mempool.
+func (s *Simulator) PendingNonce(ctx context.Context, rpcURL string, address string) (uint64, error) {
+	if !common.IsHexAddress(address) {
+		return 0, fmt.Errorf("invalid address %q", address)
+	}
+	client, err := s.client(ctx, rpcURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	nonce, err := client.PendingNonceAt(ctx, common.HexToAddress(address))
+	if err != nil {
+		return 0, fmt.Errorf("get nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// GasPrice returns the node's suggested gas price as a 0x quantity.
+func (s *Simulator) GasPrice(ctx context.Context, rpcURL string) (string, error) {
+	client, err := s.client(ctx, rpcURL)
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	price, err := client.SuggestGasPrice(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get gas price: %w", err)
+	}
+	return hexutil.EncodeBig(price), nil
+}
+
+// EstimateGas returns the gas tx would use when sent from the given address.
+func (s *Simulator) EstimateGas(ctx context.Context, rpcURL string, from string, tx *service.Transaction) (uint64, error) {
+	msg, err := callMsg(from, tx)
+	if err != nil {
+		return 0, err
+	}
+	client, err := s.client(ctx, rpcURL)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	gas, err := client.EstimateGas(ctx, msg)
+	if err != nil {
+		if data, ok := revertData(err); ok {
+			return 0, &service.SimulationRevertedError{Result: revertResult(data, err)}
+		}
+		return 0, fmt.Errorf("estimate gas: %w", err)
+	}
+	return gas, nil
+}
+
+func (s *Simulator) client(ctx context.Context, rpcURL string) (*ethclient.Client, error) {
+	rpcClient, err := s.dial(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+	return ethclient.NewClient(rpcClient), nil
+}
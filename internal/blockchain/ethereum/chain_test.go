@@ -0,0 +1,39 @@
+package ethereum
+
+import (
+	"context"
+	"testing"
+)
+
+func TestChainReadsNonceGasPriceAndEstimate(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		switch method {
+		case "eth_getTransactionCount":
+			return "0x7", nil
+		case "eth_gasPrice":
+			return "0x3b9aca00", nil
+		case "eth_estimateGas":
+			return "0xc350", nil
+		default:
+			return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	chain := NewSimulator()
+	from := "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+
+	nonce, err := chain.PendingNonce(ctx, server.URL, from)
+	if err != nil || nonce != 7 {
+		t.Fatalf("unexpected nonce %d (%v)", nonce, err)
+	}
+	price, err := chain.GasPrice(ctx, server.URL)
+	if err != nil || price != "0x3b9aca00" {
+		t.Fatalf("unexpected gas price %s (%v)", price, err)
+	}
+	gas, err := chain.EstimateGas(ctx, server.URL, from, simulationTx())
+	if err != nil || gas != 50000 {
+		t.Fatalf("unexpected gas estimate %d (%v)", gas, err)
+	}
+}
@@ -0,0 +1,498 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// ABICodec encodes contract calls and decodes their return values. The ABI
+// may be a JSON ABI, a single JSON fragment, or a human-readable signature
+// such as "balanceOf(address) returns (uint256)".
+type ABICodec struct{}
+
+func NewABICodec() *ABICodec {
+	return &ABICodec{}
+}
+
+func (c *ABICodec) Encode(definition string, method string, args []json.RawMessage) (string, error) {
+	m, err := resolveMethod(definition, method)
+	if err != nil {
+		return "", err
+	}
+	if len(args) != len(m.Inputs) {
+		return "", fmt.Errorf("%w: %s takes %d arguments, got %d", service.ErrValidation, m.Sig, len(m.Inputs), len(args))
+	}
+
+	values := make([]interface{}, len(args))
+	for i, input := range m.Inputs {
+		value, err := convertArg(input.Type, args[i])
+		if err != nil {
+			return "", fmt.Errorf("%w: argument %d (%s): %v", service.ErrValidation, i, input.Type, err)
+		}
+		values[i] = value.Interface()
+	}
+
+	packed, err := m.Inputs.Pack(values...)
+	if err != nil {
+		return "", fmt.Errorf("%w: pack arguments: %v", service.ErrValidation, err)
+	}
+	return hexutil.Encode(append(append([]byte{}, m.ID...), packed...)), nil
+}
+
+func (c *ABICodec) Decode(definition string, method string, data string) ([]service.ContractOutput, error) {
+	m, err := resolveMethod(definition, method)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hexutil.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("decode return data: %w", err)
+	}
+	values, err := m.Outputs.Unpack(raw)
+	if err != nil {
+		return nil, fmt.Errorf("unpack return data: %w", err)
+	}
+
+	outputs := make([]service.ContractOutput, len(values))
+	for i, value := range values {
+		outputs[i] = service.ContractOutput{
+			Name:  m.Outputs[i].Name,
+			Type:  m.Outputs[i].Type.String(),
+			Value: jsonValue(reflect.ValueOf(value)),
+		}
+	}
+	return outputs, nil
+}
+
+// resolveMethod finds the function to call. method may be a name or a full
+// signature, and can be left empty when the ABI has a single function.
+func resolveMethod(definition string, method string) (*abi.Method, error) {
+	definition = strings.TrimSpace(definition)
+	if definition == "" {
+		return nil, fmt.Errorf("%w: abi is required", service.ErrValidation)
+	}
+	if !strings.HasPrefix(definition, "[") && !strings.HasPrefix(definition, "{") {
+		m, err := parseSignature(definition)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+		}
+		return m, nil
+	}
+
+	if strings.HasPrefix(definition, "{") {
+		definition = "[" + definition + "]"
+	}
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse abi: %v", service.ErrValidation, err)
+	}
+
+	method = strings.TrimSpace(method)
+	if method == "" {
+		if len(parsed.Methods) != 1 {
+			return nil, fmt.Errorf("%w: method is required when the abi has %d functions", service.ErrValidation, len(parsed.Methods))
+		}
+		for _, m := range parsed.Methods {
+			return &m, nil
+		}
+	}
+	for _, m := range parsed.Methods {
+		if m.Sig == method {
+			return &m, nil
+		}
+	}
+	var match *abi.Method
+	for _, m := range parsed.Methods {
+		if m.RawName != method {
+			continue
+		}
+		if match != nil {
+			return nil, fmt.Errorf("%w: %s is overloaded, pass its full signature", service.ErrValidation, method)
+		}
+		found := m
+		match = &found
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w: method %s not found in abi", service.ErrValidation, method)
+	}
+	return match, nil
+}
+
+// parseSignature builds a method from "name(types) [returns (types)]",
+// optionally prefixed with "function" and with parameter names.
+func parseSignature(signature string) (*abi.Method, error) {
+	signature = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(signature), "function "))
+	open := strings.Index(signature, "(")
+	if open <= 0 {
+		return nil, fmt.Errorf("invalid signature %q", signature)
+	}
+	name := strings.TrimSpace(signature[:open])
+	inputs, rest, err := splitGroup(signature[open:])
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs string
+	if idx := strings.Index(rest, "returns"); idx >= 0 {
+		outputs, _, err = splitGroup(strings.TrimSpace(rest[idx+len("returns"):]))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	inArgs, err := parseArguments(inputs)
+	if err != nil {
+		return nil, err
+	}
+	outArgs, err := parseArguments(outputs)
+	if err != nil {
+		return nil, err
+	}
+	m := abi.NewMethod(name, name, abi.Function, "", false, false, inArgs, outArgs)
+	return &m, nil
+}
+
+// splitGroup returns the contents of the parenthesised group that starts s
+// and whatever follows it.
+func splitGroup(s string) (string, string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return "", "", fmt.Errorf("expected ( in %q", s)
+	}
+	depth := 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[1:i], s[i+1:], nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("unbalanced parentheses in %q", s)
+}
+
+func parseArguments(list string) (abi.Arguments, error) {
+	params, err := parseParams(list)
+	if err != nil {
+		return nil, err
+	}
+	args := make(abi.Arguments, len(params))
+	for i, param := range params {
+		typ, err := abi.NewType(param.Type, "", param.Components)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %d: %w", i, err)
+		}
+		args[i] = abi.Argument{Name: param.Name, Type: typ}
+	}
+	return args, nil
+}
+
+func parseParams(list string) ([]abi.ArgumentMarshaling, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return nil, nil
+	}
+
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range list {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, list[start:])
+
+	params := make([]abi.ArgumentMarshaling, 0, len(parts))
+	for _, part := range parts {
+		param, err := parseParam(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+func parseParam(part string) (abi.ArgumentMarshaling, error) {
+	if part == "" {
+		return abi.ArgumentMarshaling{}, fmt.Errorf("empty parameter")
+	}
+	if strings.HasPrefix(part, "(") {
+		inner, rest, err := splitGroup(part)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		components, err := parseParams(inner)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		for i := range components {
+			if components[i].Name == "" {
+				components[i].Name = fmt.Sprintf("field%d", i)
+			}
+		}
+		suffix, name := splitTypeName(rest)
+		return abi.ArgumentMarshaling{Name: name, Type: "tuple" + suffix, Components: components}, nil
+	}
+
+	fields := strings.Fields(part)
+	param := abi.ArgumentMarshaling{Type: fields[0]}
+	for _, field := range fields[1:] {
+		if field != "memory" && field != "calldata" && field != "storage" && field != "indexed" {
+			param.Name = field
+		}
+	}
+	return param, nil
+}
+
+// splitTypeName separates an array suffix such as "[]" from a parameter name
+// after a tuple's closing parenthesis.
+func splitTypeName(rest string) (string, string) {
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", ""
+	}
+	suffix, name := "", ""
+	for _, field := range fields {
+		switch {
+		case strings.HasPrefix(field, "["):
+			suffix += field
+		case field == "memory" || field == "calldata" || field == "storage":
+		default:
+			name = field
+		}
+	}
+	return suffix, name
+}
+
+// convertArg turns a JSON argument into the Go value abi.Pack expects for
+// typ. Integers may be JSON numbers or decimal/0x strings, and byte types are
+// 0x hex.
+func convertArg(typ abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	goType := typ.GetType()
+	switch typ.T {
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("expected an address")
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BoolTy:
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected a bool")
+		}
+		return reflect.ValueOf(b), nil
+	case abi.StringTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected a string")
+		}
+		return reflect.ValueOf(s), nil
+	case abi.IntTy, abi.UintTy:
+		n, err := parseInteger(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if typ.T == abi.UintTy && n.Sign() < 0 {
+			return reflect.Value{}, fmt.Errorf("expected a non-negative integer")
+		}
+		if goType == reflect.TypeOf(&big.Int{}) {
+			return reflect.ValueOf(n), nil
+		}
+		value := reflect.New(goType).Elem()
+		if typ.T == abi.UintTy {
+			if !n.IsUint64() || value.OverflowUint(n.Uint64()) {
+				return reflect.Value{}, fmt.Errorf("integer out of range")
+			}
+			value.SetUint(n.Uint64())
+		} else {
+			if !n.IsInt64() || value.OverflowInt(n.Int64()) {
+				return reflect.Value{}, fmt.Errorf("integer out of range")
+			}
+			value.SetInt(n.Int64())
+		}
+		return value, nil
+	case abi.BytesTy:
+		data, err := hexArg(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(data), nil
+	case abi.FixedBytesTy:
+		data, err := hexArg(raw)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if len(data) != typ.Size {
+			return reflect.Value{}, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(data))
+		}
+		value := reflect.New(goType).Elem()
+		reflect.Copy(value, reflect.ValueOf(data))
+		return value, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected an array")
+		}
+		var value reflect.Value
+		if typ.T == abi.ArrayTy {
+			if len(items) != typ.Size {
+				return reflect.Value{}, fmt.Errorf("expected %d elements, got %d", typ.Size, len(items))
+			}
+			value = reflect.New(goType).Elem()
+		} else {
+			value = reflect.MakeSlice(goType, len(items), len(items))
+		}
+		for i, item := range items {
+			elem, err := convertArg(*typ.Elem, item)
+			if err != nil {
+				return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+			}
+			value.Index(i).Set(elem)
+		}
+		return value, nil
+	case abi.TupleTy:
+		return convertTuple(typ, raw)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type")
+	}
+}
+
+// convertTuple accepts a tuple as a JSON array in component order or as an
+// object keyed by component name.
+func convertTuple(typ abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	items := make([]json.RawMessage, len(typ.TupleElems))
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		if len(list) != len(items) {
+			return reflect.Value{}, fmt.Errorf("expected %d components, got %d", len(items), len(list))
+		}
+		copy(items, list)
+	} else {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			return reflect.Value{}, fmt.Errorf("expected an array or object")
+		}
+		for i, name := range typ.TupleRawNames {
+			item, ok := object[name]
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("missing component %s", name)
+			}
+			items[i] = item
+		}
+	}
+
+	value := reflect.New(typ.GetType()).Elem()
+	for i, elem := range typ.TupleElems {
+		field, err := convertArg(*elem, items[i])
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("component %s: %w", typ.TupleRawNames[i], err)
+		}
+		value.Field(i).Set(field)
+	}
+	return value, nil
+}
+
+func parseInteger(raw json.RawMessage) (*big.Int, error) {
+	text := strings.TrimSpace(string(raw))
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		text = strings.TrimSpace(s)
+	}
+
+	negative := strings.HasPrefix(text, "-")
+	digits := strings.TrimPrefix(text, "-")
+	base := 10
+	if strings.HasPrefix(digits, "0x") {
+		digits, base = digits[2:], 16
+	}
+	n, ok := new(big.Int).SetString(digits, base)
+	if !ok {
+		return nil, fmt.Errorf("expected an integer, got %s", text)
+	}
+	if negative {
+		n.Neg(n)
+	}
+	return n, nil
+}
+
+func hexArg(raw json.RawMessage) ([]byte, error) {
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("expected 0x hex")
+	}
+	data, err := hexutil.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("expected 0x hex: %v", err)
+	}
+	return data, nil
+}
+
+// jsonValue renders unpacked ABI values for JSON: integers wider than 53
+// bits as decimal strings, addresses and bytes as 0x hex, and tuples as
+// objects.
+func jsonValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case common.Hash:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+
+	switch v.Kind() {
+	case reflect.Uint64, reflect.Int64:
+		return fmt.Sprint(v.Interface())
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(data), v)
+			return hexutil.Encode(data)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]interface{}, v.Len())
+		for i := range items {
+			items[i] = jsonValue(v.Index(i))
+		}
+		return items
+	case reflect.Struct:
+		object := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			name := v.Type().Field(i).Tag.Get("json")
+			if name == "" {
+				name = v.Type().Field(i).Name
+			}
+			object[name] = jsonValue(v.Field(i))
+		}
+		return object
+	default:
+		return v.Interface()
+	}
+}
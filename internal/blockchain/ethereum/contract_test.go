@@ -0,0 +1,115 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func rawArgs(t *testing.T, args ...interface{}) []json.RawMessage {
+	t.Helper()
+	raw := make([]json.RawMessage, len(args))
+	for i, arg := range args {
+		encoded, err := json.Marshal(arg)
+		if err != nil {
+			t.Fatalf("marshal arg: %v", err)
+		}
+		raw[i] = encoded
+	}
+	return raw
+}
+
+const transferCalldata = "0xa9059cbb" +
+	"000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" +
+	"00000000000000000000000000000000000000000000000000000000000003e8"
+
+func TestEncodeFromSignatureAndJSONABI(t *testing.T) {
+	codec := NewABICodec()
+	args := rawArgs(t, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "1000")
+
+	data, err := codec.Encode("transfer(address,uint256)", "", args)
+	if err != nil || data != transferCalldata {
+		t.Fatalf("unexpected calldata %s (%v)", data, err)
+	}
+
+	fragment := `{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}]}`
+	data, err = codec.Encode(fragment, "", rawArgs(t, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", 1000))
+	if err != nil || data != transferCalldata {
+		t.Fatalf("unexpected calldata from fragment %s (%v)", data, err)
+	}
+
+	data, err = codec.Encode("["+fragment+"]", "transfer(address,uint256)", rawArgs(t, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x3e8"))
+	if err != nil || data != transferCalldata {
+		t.Fatalf("unexpected calldata from abi %s (%v)", data, err)
+	}
+}
+
+func TestEncodeTuplesArraysAndBytes(t *testing.T) {
+	codec := NewABICodec()
+	signature := "submit((address to, uint96 amount)[] orders, bytes32 salt, bytes memo, uint8 flags)"
+	args := rawArgs(t,
+		[]interface{}{
+			map[string]interface{}{"to": "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "amount": "5"},
+			[]interface{}{"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", 6},
+		},
+		"0x"+strings.Repeat("11", 32),
+		"0xdeadbeef",
+		7,
+	)
+	data, err := codec.Encode(signature, "", args)
+	if err != nil {
+		t.Fatalf("Encode returned error: %v", err)
+	}
+	if !strings.HasPrefix(data, "0x") || len(data) < 10 {
+		t.Fatalf("unexpected calldata %s", data)
+	}
+}
+
+func TestEncodeRejectsBadArguments(t *testing.T) {
+	codec := NewABICodec()
+	cases := []struct {
+		abi  string
+		args []json.RawMessage
+	}{
+		{"transfer(address,uint256)", rawArgs(t, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")},
+		{"transfer(address,uint256)", rawArgs(t, "not-an-address", 1)},
+		{"transfer(address,uint256)", rawArgs(t, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", -1)},
+		{"set(uint8)", rawArgs(t, 256)},
+		{"set(bytes4)", rawArgs(t, "0x1234")},
+		{"transfer(address,uint256", nil},
+	}
+	for _, tc := range cases {
+		if _, err := codec.Encode(tc.abi, "", tc.args); !errors.Is(err, service.ErrValidation) {
+			t.Fatalf("%s: expected ErrValidation, got %v", tc.abi, err)
+		}
+	}
+
+	overloaded := `[{"type":"function","name":"f","inputs":[{"type":"uint256"}]},{"type":"function","name":"f","inputs":[{"type":"address"}]}]`
+	if _, err := codec.Encode(overloaded, "f", rawArgs(t, 1)); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected overloaded name to be rejected, got %v", err)
+	}
+	if _, err := codec.Encode(overloaded, "f(uint256)", rawArgs(t, 1)); err != nil {
+		t.Fatalf("expected full signature to resolve the overload, got %v", err)
+	}
+}
+
+func TestDecodeOutputs(t *testing.T) {
+	codec := NewABICodec()
+	data := "0x" +
+		"00000000000000000000000000000000000000000000000000000000000003e8" +
+		"000000000000000000000000aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+
+	outputs, err := codec.Decode("position(uint256 id) returns (uint256 balance, address owner)", "", data)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if len(outputs) != 2 || outputs[0].Name != "balance" || outputs[0].Value != "1000" || outputs[1].Type != "address" {
+		t.Fatalf("unexpected outputs %+v", outputs)
+	}
+	if owner, _ := outputs[1].Value.(string); !strings.EqualFold(owner, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa") {
+		t.Fatalf("unexpected owner %v", outputs[1].Value)
+	}
+}
@@ -0,0 +1,212 @@
+package ethereum
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// maxCallDepth bounds how far multicall payloads are unwrapped.
+const maxCallDepth = 3
+
+// Decoder parses raw signed transactions and decodes calldata against a
+// supplied ABI or the built-in registry of common standards.
+type Decoder struct {
+	registry abiRegistry
+}
+
+func NewDecoder() *Decoder {
+	return &Decoder{registry: newABIRegistry()}
+}
+
+func (d *Decoder) DecodeTransaction(raw string, definition string) (*service.DecodedTransaction, error) {
+	encoded, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: raw transaction is not 0x hex", service.ErrValidation)
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(encoded); err != nil {
+		return nil, fmt.Errorf("%w: parse transaction: %v", service.ErrValidation, err)
+	}
+
+	signer := types.LatestSignerForChainID(tx.ChainId())
+	if !tx.Protected() {
+		signer = types.HomesteadSigner{}
+	}
+	from, err := types.Sender(signer, &tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: recover sender: %v", service.ErrValidation, err)
+	}
+
+	decoded := &service.DecodedTransaction{
+		Type:     int(tx.Type()),
+		Hash:     tx.Hash().Hex(),
+		From:     from.Hex(),
+		ChainID:  tx.ChainId().Int64(),
+		Nonce:    tx.Nonce(),
+		Value:    tx.Value().String(),
+		GasLimit: tx.Gas(),
+	}
+	if to := tx.To(); to != nil {
+		decoded.To = to.Hex()
+	}
+	if tx.Type() == types.LegacyTxType || tx.Type() == types.AccessListTxType {
+		decoded.GasPrice = tx.GasPrice().String()
+	} else {
+		decoded.MaxFeePerGas = tx.GasFeeCap().String()
+		decoded.MaxPriorityFeePerGas = tx.GasTipCap().String()
+	}
+	if tx.Type() == types.BlobTxType {
+		decoded.MaxFeePerBlobGas = tx.BlobGasFeeCap().String()
+		for _, hash := range tx.BlobHashes() {
+			decoded.BlobVersionedHashes = append(decoded.BlobVersionedHashes, hash.Hex())
+		}
+	}
+	if data := tx.Data(); len(data) > 0 {
+		decoded.Data = hexutil.Encode(data)
+		if len(data) >= 4 {
+			call, err := d.decodeCall(data, definition, 0)
+			if err != nil {
+				return nil, err
+			}
+			decoded.Call = call
+		}
+	}
+	return decoded, nil
+}
+
+func (d *Decoder) DecodeCalldata(data string, definition string) (*service.DecodedCall, error) {
+	raw, err := hexutil.Decode(data)
+	if err != nil || len(raw) < 4 {
+		return nil, fmt.Errorf("%w: data must be 0x hex with a 4-byte selector", service.ErrValidation)
+	}
+	return d.decodeCall(raw, definition, 0)
+}
+
+func (d *Decoder) decodeCall(data []byte, definition string, depth int) (*service.DecodedCall, error) {
+	call := &service.DecodedCall{Selector: hexutil.Encode(data[:4])}
+
+	method, standards, err := d.lookup(data[:4], definition)
+	if err != nil {
+		return nil, err
+	}
+	if method == nil {
+		return call, nil
+	}
+
+	values, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		// The selector matched but the arguments do not fit, so only the
+		// selector is reported.
+		return call, nil
+	}
+	call.Signature = method.Sig
+	call.Method = method.RawName
+	call.Standards = standards
+	call.Args = make([]service.ContractOutput, len(values))
+	for i, value := range values {
+		call.Args[i] = service.ContractOutput{
+			Name:  method.Inputs[i].Name,
+			Type:  method.Inputs[i].Type.String(),
+			Value: jsonValue(reflect.ValueOf(value)),
+		}
+	}
+
+	if depth < maxCallDepth && containsStandard(standards, StandardMulticall) {
+		for _, inner := range innerCalls(values) {
+			if len(inner) < 4 {
+				continue
+			}
+			decoded, err := d.decodeCall(inner, definition, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			call.Calls = append(call.Calls, *decoded)
+		}
+	}
+	return call, nil
+}
+
+// lookup matches a selector against the supplied ABI first and the registry
+// second. It returns a nil method when neither knows the selector.
+func (d *Decoder) lookup(selector []byte, definition string) (*abi.Method, []string, error) {
+	if definition = strings.TrimSpace(definition); definition != "" {
+		method, err := methodBySelector(definition, selector)
+		if err != nil {
+			return nil, nil, err
+		}
+		if method != nil {
+			return method, nil, nil
+		}
+	}
+	if entry, ok := d.registry[hexutil.Encode(selector)]; ok {
+		return entry.method, entry.standards, nil
+	}
+	return nil, nil, nil
+}
+
+func methodBySelector(definition string, selector []byte) (*abi.Method, error) {
+	if !strings.HasPrefix(definition, "[") && !strings.HasPrefix(definition, "{") {
+		method, err := parseSignature(definition)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+		}
+		if !bytes.Equal(method.ID, selector) {
+			return nil, nil
+		}
+		return method, nil
+	}
+
+	if strings.HasPrefix(definition, "{") {
+		definition = "[" + definition + "]"
+	}
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		return nil, fmt.Errorf("%w: parse abi: %v", service.ErrValidation, err)
+	}
+	method, err := parsed.MethodById(selector)
+	if err != nil {
+		return nil, nil
+	}
+	return method, nil
+}
+
+// innerCalls collects the calldata wrapped by a multicall: bytes[] arguments
+// and the callData field of call tuples.
+func innerCalls(values []interface{}) [][]byte {
+	var calls [][]byte
+	for _, value := range values {
+		v := reflect.ValueOf(value)
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+			continue
+		}
+		for i := 0; i < v.Len(); i++ {
+			item := v.Index(i)
+			switch {
+			case item.Kind() == reflect.Slice && item.Type().Elem().Kind() == reflect.Uint8:
+				calls = append(calls, item.Bytes())
+			case item.Kind() == reflect.Struct:
+				if field := item.FieldByName("CallData"); field.IsValid() {
+					calls = append(calls, field.Bytes())
+				}
+			}
+		}
+	}
+	return calls
+}
+
+func containsStandard(standards []string, standard string) bool {
+	for _, candidate := range standards {
+		if candidate == standard {
+			return true
+		}
+	}
+	return false
+}
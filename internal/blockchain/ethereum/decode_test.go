@@ -0,0 +1,165 @@
+package ethereum
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestDecodeSignedTransactionTypes(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	chainID := big.NewInt(84532)
+	data := hexutil.MustDecode(transferCalldata)
+
+	txs := []types.TxData{
+		&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(10), Gas: 60000, To: &to, Value: big.NewInt(0), Data: data},
+		&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(11), Gas: 60000, To: &to, Value: big.NewInt(5), Data: data},
+		&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(20), Gas: 60000, To: &to, Value: big.NewInt(7), Data: data},
+	}
+
+	decoder := NewDecoder()
+	for i, inner := range txs {
+		signed, err := types.SignNewTx(key, types.LatestSignerForChainID(chainID), inner)
+		if err != nil {
+			t.Fatalf("sign tx %d: %v", i, err)
+		}
+		raw, err := signed.MarshalBinary()
+		if err != nil {
+			t.Fatalf("marshal tx %d: %v", i, err)
+		}
+
+		decoded, err := decoder.DecodeTransaction(hexutil.Encode(raw), "")
+		if err != nil {
+			t.Fatalf("DecodeTransaction(%d) returned error: %v", i, err)
+		}
+		if decoded.Type != i || decoded.From != sender.Hex() || decoded.Hash != signed.Hash().Hex() {
+			t.Fatalf("unexpected decoded tx %d: %+v", i, decoded)
+		}
+		if decoded.ChainID != chainID.Int64() || decoded.Nonce != uint64(i+1) || decoded.To != to.Hex() {
+			t.Fatalf("unexpected fields for tx %d: %+v", i, decoded)
+		}
+		if i < 2 && (decoded.GasPrice == "" || decoded.MaxFeePerGas != "") {
+			t.Fatalf("expected gas price only for tx %d: %+v", i, decoded)
+		}
+		if i == 2 && (decoded.MaxFeePerGas != "20" || decoded.MaxPriorityFeePerGas != "1" || decoded.GasPrice != "") {
+			t.Fatalf("expected dynamic fees for tx %d: %+v", i, decoded)
+		}
+		if decoded.Call == nil || decoded.Call.Method != "transfer" {
+			t.Fatalf("expected transfer call for tx %d: %+v", i, decoded.Call)
+		}
+	}
+}
+
+func TestDecodeCalldataFromRegistry(t *testing.T) {
+	decoder := NewDecoder()
+
+	call, err := decoder.DecodeCalldata(transferCalldata, "")
+	if err != nil {
+		t.Fatalf("DecodeCalldata returned error: %v", err)
+	}
+	if call.Signature != "transfer(address,uint256)" || len(call.Standards) != 1 || call.Standards[0] != StandardERC20 {
+		t.Fatalf("unexpected call %+v", call)
+	}
+	if call.Args[0].Name != "to" || call.Args[1].Value != "1000" {
+		t.Fatalf("unexpected args %+v", call.Args)
+	}
+
+	method, err := parseSignature("transferFrom(address,address,uint256)")
+	if err != nil {
+		t.Fatalf("parseSignature: %v", err)
+	}
+	shared, err := decoder.DecodeCalldata(hexutil.Encode(method.ID)+strings.Repeat("0", 192), "")
+	if err != nil {
+		t.Fatalf("DecodeCalldata returned error: %v", err)
+	}
+	if len(shared.Standards) != 2 {
+		t.Fatalf("expected erc20 and erc721 to share transferFrom, got %v", shared.Standards)
+	}
+}
+
+func TestDecodeMulticallUnwrapsInnerCalls(t *testing.T) {
+	method, err := parseSignature("aggregate3((address target, bool allowFailure, bytes callData)[] calls)")
+	if err != nil {
+		t.Fatalf("parseSignature: %v", err)
+	}
+	type call3 struct {
+		Target       common.Address
+		AllowFailure bool
+		CallData     []byte
+	}
+	packed, err := method.Inputs.Pack([]call3{
+		{Target: common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), CallData: hexutil.MustDecode(transferCalldata)},
+		{Target: common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"), CallData: hexutil.MustDecode("0xdeadbeef")},
+	})
+	if err != nil {
+		t.Fatalf("pack: %v", err)
+	}
+
+	call, err := NewDecoder().DecodeCalldata(hexutil.Encode(append(method.ID, packed...)), "")
+	if err != nil {
+		t.Fatalf("DecodeCalldata returned error: %v", err)
+	}
+	if call.Method != "aggregate3" || len(call.Calls) != 2 {
+		t.Fatalf("unexpected multicall %+v", call)
+	}
+	if call.Calls[0].Method != "transfer" || call.Calls[1].Selector != "0xdeadbeef" || call.Calls[1].Method != "" {
+		t.Fatalf("unexpected inner calls %+v", call.Calls)
+	}
+}
+
+func TestDecodeCalldataWithSuppliedABI(t *testing.T) {
+	method, err := parseSignature("stake(uint256 amount, address beneficiary)")
+	if err != nil {
+		t.Fatalf("parseSignature: %v", err)
+	}
+	packed, err := method.Inputs.Pack(big.NewInt(42), common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"))
+	if err != nil {
+		t.Fatalf("pack: %v", err)
+	}
+	data := hexutil.Encode(append(method.ID, packed...))
+	decoder := NewDecoder()
+
+	unknown, err := decoder.DecodeCalldata(data, "")
+	if err != nil || unknown.Method != "" || unknown.Selector != hexutil.Encode(method.ID) {
+		t.Fatalf("expected selector only, got %+v (%v)", unknown, err)
+	}
+
+	for _, definition := range []string{
+		"stake(uint256 amount, address beneficiary)",
+		`[{"type":"function","name":"stake","inputs":[{"name":"amount","type":"uint256"},{"name":"beneficiary","type":"address"}]}]`,
+	} {
+		call, err := decoder.DecodeCalldata(data, definition)
+		if err != nil {
+			t.Fatalf("DecodeCalldata returned error: %v", err)
+		}
+		if call.Method != "stake" || call.Args[0].Value != "42" || call.Args[1].Name != "beneficiary" {
+			t.Fatalf("unexpected call %+v", call)
+		}
+	}
+}
+
+func TestDecodeRejectsMalformedInput(t *testing.T) {
+	decoder := NewDecoder()
+	if _, err := decoder.DecodeTransaction("0x1234", ""); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for bad transaction, got %v", err)
+	}
+	if _, err := decoder.DecodeCalldata("0x12", ""); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for short calldata, got %v", err)
+	}
+	if _, err := decoder.DecodeCalldata(transferCalldata, "[not json"); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for bad abi, got %v", err)
+	}
+}
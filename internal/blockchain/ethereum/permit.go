@@ -0,0 +1,173 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// Permit2Address is the canonical Uniswap Permit2 deployment, the same on
+// every chain.
+const Permit2Address = "0x000000000022D473030F116dDEE9F6B43aC78BA3"
+
+// fallbackPermitVersions are tried when a token has no version() getter.
+var fallbackPermitVersions = []string{"1", "2"}
+
+// PermitBuilder reads permit nonces and domains from the chain and builds
+// EIP-2612 and Permit2 typed data.
+type PermitBuilder struct {
+	contractReader
+}
+
+func NewPermitBuilder(caller service.ContractCaller) *PermitBuilder {
+	return &PermitBuilder{contractReader{caller: caller}}
+}
+
+func (b *PermitBuilder) BuildPermit(ctx context.Context, rpcURL string, chainID int64, permit service.Permit) (*service.TypedData, error) {
+	switch permit.Kind {
+	case service.PermitKindEIP2612:
+		return b.buildEIP2612(ctx, rpcURL, chainID, permit)
+	case service.PermitKindPermit2:
+		return b.buildPermit2(ctx, rpcURL, chainID, permit)
+	default:
+		return nil, fmt.Errorf("%w: unknown permit kind %q", service.ErrValidation, permit.Kind)
+	}
+}
+
+func (b *PermitBuilder) buildEIP2612(ctx context.Context, rpcURL string, chainID int64, permit service.Permit) (*service.TypedData, error) {
+	token := permit.Token.Address
+	name, err := b.callString(ctx, rpcURL, permit.Owner, token, "name() returns (string)")
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := b.callUint(ctx, rpcURL, permit.Owner, token, "nonces(address) returns (uint256)", permit.Owner)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := b.callBytes32(ctx, rpcURL, permit.Owner, token, "DOMAIN_SEPARATOR() returns (bytes32)")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := fallbackPermitVersions
+	if version, err := b.callString(ctx, rpcURL, permit.Owner, token, "version() returns (string)"); err == nil {
+		versions = append([]string{version}, versions...)
+	}
+
+	data := &service.TypedData{
+		Types: map[string][]service.TypedDataField{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Permit": {
+				{Name: "owner", Type: "address"},
+				{Name: "spender", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "deadline", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Permit",
+		Message: map[string]interface{}{
+			"owner":    common.HexToAddress(permit.Owner).Hex(),
+			"spender":  common.HexToAddress(permit.Spender).Hex(),
+			"value":    permit.Value.String(),
+			"nonce":    nonce.String(),
+			"deadline": strconv.FormatInt(permit.Deadline, 10),
+		},
+	}
+
+	// The version is not readable on every token, so the domain is
+	// reconstructed and checked against the token's DOMAIN_SEPARATOR.
+	for _, version := range versions {
+		data.Domain = service.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainID:           chainID,
+			VerifyingContract: common.HexToAddress(token).Hex(),
+		}
+		computed, err := DomainSeparator(data)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(computed, separator) {
+			return data, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: cannot reproduce the DOMAIN_SEPARATOR of token %s", service.ErrValidation, token)
+}
+
+func (b *PermitBuilder) buildPermit2(ctx context.Context, rpcURL string, chainID int64, permit service.Permit) (*service.TypedData, error) {
+	method, err := parseSignature("allowance(address,address,address) returns (uint160 amount, uint48 expiration, uint48 nonce)")
+	if err != nil {
+		return nil, err
+	}
+	values, err := b.call(ctx, rpcURL, permit.Owner, Permit2Address, method,
+		common.HexToAddress(permit.Owner), common.HexToAddress(permit.Token.Address), common.HexToAddress(permit.Spender))
+	if err != nil {
+		return nil, err
+	}
+	nonce, ok := values[2].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Permit2 allowance nonce %T", values[2])
+	}
+	separator, err := b.callBytes32(ctx, rpcURL, permit.Owner, Permit2Address, "DOMAIN_SEPARATOR() returns (bytes32)")
+	if err != nil {
+		return nil, err
+	}
+
+	data := &service.TypedData{
+		Types: map[string][]service.TypedDataField{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"PermitSingle": {
+				{Name: "details", Type: "PermitDetails"},
+				{Name: "spender", Type: "address"},
+				{Name: "sigDeadline", Type: "uint256"},
+			},
+			"PermitDetails": {
+				{Name: "token", Type: "address"},
+				{Name: "amount", Type: "uint160"},
+				{Name: "expiration", Type: "uint48"},
+				{Name: "nonce", Type: "uint48"},
+			},
+		},
+		PrimaryType: "PermitSingle",
+		Domain: service.TypedDataDomain{
+			Name:              "Permit2",
+			ChainID:           chainID,
+			VerifyingContract: Permit2Address,
+		},
+		Message: map[string]interface{}{
+			"details": map[string]interface{}{
+				"token":      common.HexToAddress(permit.Token.Address).Hex(),
+				"amount":     permit.Value.String(),
+				"expiration": strconv.FormatInt(permit.Expiration, 10),
+				"nonce":      nonce.String(),
+			},
+			"spender":     common.HexToAddress(permit.Spender).Hex(),
+			"sigDeadline": strconv.FormatInt(permit.Deadline, 10),
+		},
+	}
+
+	computed, err := DomainSeparator(data)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(computed, separator) {
+		return nil, fmt.Errorf("%w: Permit2 is not deployed at %s on chain %d", service.ErrValidation, Permit2Address, chainID)
+	}
+	return data, nil
+}
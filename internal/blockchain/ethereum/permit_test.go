@@ -0,0 +1,146 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// fakeToken answers eth_calls by function signature.
+type fakeToken struct {
+	t       *testing.T
+	returns map[string][]interface{}
+}
+
+func (f *fakeToken) Call(_ context.Context, _ string, _ string, tx *service.Transaction) (string, error) {
+	for signature, values := range f.returns {
+		method, err := parseSignature(signature)
+		if err != nil {
+			f.t.Fatalf("parseSignature(%q): %v", signature, err)
+		}
+		if !strings.HasPrefix(tx.Data, hexutil.Encode(method.ID)) {
+			continue
+		}
+		packed, err := method.Outputs.Pack(values...)
+		if err != nil {
+			f.t.Fatalf("pack %s: %v", signature, err)
+		}
+		return hexutil.Encode(packed), nil
+	}
+	return "", &service.SimulationRevertedError{Result: &service.SimulationResult{RevertReason: "unknown function"}}
+}
+
+func domainSeparator(t *testing.T, domain service.TypedDataDomain, fields ...string) [32]byte {
+	t.Helper()
+	types := make([]service.TypedDataField, len(fields))
+	for i, field := range fields {
+		name, typ, _ := strings.Cut(field, " ")
+		types[i] = service.TypedDataField{Name: name, Type: typ}
+	}
+	separator, err := DomainSeparator(&service.TypedData{Types: map[string][]service.TypedDataField{"EIP712Domain": types}, Domain: domain})
+	if err != nil {
+		t.Fatalf("DomainSeparator: %v", err)
+	}
+	var out [32]byte
+	copy(out[:], separator)
+	return out
+}
+
+func TestBuildEIP2612PermitMatchesDomainVersion(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	owner := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	token := "0xcccccccccccccccccccccccccccccccccccccccc"
+	separator := domainSeparator(t, service.TypedDataDomain{Name: "USD Coin", Version: "2", ChainID: 1, VerifyingContract: common.HexToAddress(token).Hex()},
+		"name string", "version string", "chainId uint256", "verifyingContract address")
+
+	builder := NewPermitBuilder(&fakeToken{t: t, returns: map[string][]interface{}{
+		"name() returns (string)":              {"USD Coin"},
+		"nonces(address) returns (uint256)":    {big.NewInt(4)},
+		"DOMAIN_SEPARATOR() returns (bytes32)": {separator},
+	}})
+	data, err := builder.BuildPermit(context.Background(), "http://rpc", 1, service.Permit{
+		Kind:     service.PermitKindEIP2612,
+		Owner:    owner,
+		Token:    service.Token{Address: token, Decimals: 6},
+		Spender:  "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:    big.NewInt(1000000),
+		Deadline: 1700000000,
+	})
+	if err != nil {
+		t.Fatalf("BuildPermit returned error: %v", err)
+	}
+	if data.Domain.Version != "2" || data.Message["nonce"] != "4" || data.PrimaryType != "Permit" {
+		t.Fatalf("unexpected typed data %+v", data)
+	}
+
+	signature, err := NewSigner().SignTypedData("eth-sepolia", hex.EncodeToString(crypto.FromECDSA(key)), data)
+	if err != nil {
+		t.Fatalf("SignTypedData returned error: %v", err)
+	}
+	digest, err := HashTypedData(data)
+	if err != nil {
+		t.Fatalf("HashTypedData: %v", err)
+	}
+	sig := hexutil.MustDecode(signature.Signature)
+	sig[64] -= 27
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil || crypto.PubkeyToAddress(*pub).Hex() != owner {
+		t.Fatalf("signature does not recover to the owner (%v)", err)
+	}
+}
+
+func TestBuildEIP2612PermitRejectsUnknownDomain(t *testing.T) {
+	builder := NewPermitBuilder(&fakeToken{t: t, returns: map[string][]interface{}{
+		"name() returns (string)":              {"Token"},
+		"nonces(address) returns (uint256)":    {big.NewInt(0)},
+		"DOMAIN_SEPARATOR() returns (bytes32)": {[32]byte{1}},
+	}})
+	_, err := builder.BuildPermit(context.Background(), "http://rpc", 1, service.Permit{
+		Kind:    service.PermitKindEIP2612,
+		Owner:   "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		Token:   service.Token{Address: "0xcccccccccccccccccccccccccccccccccccccccc"},
+		Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:   big.NewInt(1),
+	})
+	if !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected a domain mismatch, got %v", err)
+	}
+}
+
+func TestBuildPermit2UsesAllowanceNonce(t *testing.T) {
+	separator := domainSeparator(t, service.TypedDataDomain{Name: "Permit2", ChainID: 1, VerifyingContract: Permit2Address},
+		"name string", "chainId uint256", "verifyingContract address")
+	builder := NewPermitBuilder(&fakeToken{t: t, returns: map[string][]interface{}{
+		"allowance(address,address,address) returns (uint160,uint48,uint48)": {big.NewInt(0), big.NewInt(0), big.NewInt(5)},
+		"DOMAIN_SEPARATOR() returns (bytes32)":                               {separator},
+	}})
+
+	data, err := builder.BuildPermit(context.Background(), "http://rpc", 1, service.Permit{
+		Kind:       service.PermitKindPermit2,
+		Owner:      "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+		Token:      service.Token{Address: "0xcccccccccccccccccccccccccccccccccccccccc"},
+		Spender:    "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:      big.NewInt(10),
+		Deadline:   1700000000,
+		Expiration: 1800000000,
+	})
+	if err != nil {
+		t.Fatalf("BuildPermit returned error: %v", err)
+	}
+	details, _ := data.Message["details"].(map[string]interface{})
+	if data.PrimaryType != "PermitSingle" || details["nonce"] != "5" || details["expiration"] != "1800000000" {
+		t.Fatalf("unexpected typed data %+v", data)
+	}
+	if _, err := HashTypedData(data); err != nil {
+		t.Fatalf("HashTypedData: %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// contractReader makes read-only contract calls by human-readable
+// signature, such as "balanceOf(address) returns (uint256)".
+type contractReader struct {
+	caller service.ContractCaller
+}
+
+func (b *contractReader) callString(ctx context.Context, rpcURL, from, to, signature string, args ...interface{}) (string, error) {
+	values, err := b.callSignature(ctx, rpcURL, from, to, signature, args...)
+	if err != nil {
+		return "", err
+	}
+	value, ok := values[0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected %s result %T", signature, values[0])
+	}
+	return value, nil
+}
+
+func (b *contractReader) callUint(ctx context.Context, rpcURL, from, to, signature string, args ...interface{}) (*big.Int, error) {
+	values, err := b.callSignature(ctx, rpcURL, from, to, signature, args...)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := values[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s result %T", signature, values[0])
+	}
+	return value, nil
+}
+
+func (b *contractReader) callBytes32(ctx context.Context, rpcURL, from, to, signature string) ([]byte, error) {
+	values, err := b.callSignature(ctx, rpcURL, from, to, signature)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := values[0].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s result %T", signature, values[0])
+	}
+	return value[:], nil
+}
+
+func (b *contractReader) callSignature(ctx context.Context, rpcURL, from, to, signature string, args ...interface{}) ([]interface{}, error) {
+	method, err := parseSignature(signature)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		if address, ok := arg.(string); ok {
+			args[i] = common.HexToAddress(address)
+		}
+	}
+	return b.call(ctx, rpcURL, from, to, method, args...)
+}
+
+func (b *contractReader) call(ctx context.Context, rpcURL, from, to string, method *abi.Method, args ...interface{}) ([]interface{}, error) {
+	packed, err := method.Inputs.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s: %w", method.Sig, err)
+	}
+	returned, err := b.caller.Call(ctx, rpcURL, from, &service.Transaction{
+		To:    to,
+		Value: "0x0",
+		Data:  hexutil.Encode(append(method.ID, packed...)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("call %s on %s: %w", method.Sig, to, err)
+	}
+	output, err := hexutil.Decode(returned)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s result: %w", method.Sig, err)
+	}
+	values, err := method.Outputs.Unpack(output)
+	if err != nil || len(values) != len(method.Outputs) {
+		return nil, fmt.Errorf("decode %s result: unexpected return data %s", method.Sig, returned)
+	}
+	return values, nil
+}
@@ -0,0 +1,105 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+const execTransactionSignature = "execTransaction(address to, uint256 value, bytes data, uint8 operation, uint256 safeTxGas, uint256 baseGas, uint256 gasPrice, address gasToken, address refundReceiver, bytes signatures) returns (bool)"
+
+// SafeClient reads Safe state and encodes execTransaction calls.
+type SafeClient struct {
+	contractReader
+}
+
+func NewSafeClient(caller service.ContractCaller) *SafeClient {
+	return &SafeClient{contractReader{caller: caller}}
+}
+
+func (c *SafeClient) ReadSafe(ctx context.Context, rpcURL string, safe string) (*service.SafeInfo, error) {
+	version, err := c.callString(ctx, rpcURL, safe, safe, "VERSION() returns (string)")
+	if err != nil {
+		return nil, err
+	}
+	threshold, err := c.callUint(ctx, rpcURL, safe, safe, "getThreshold() returns (uint256)")
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := c.callUint(ctx, rpcURL, safe, safe, "nonce() returns (uint256)")
+	if err != nil {
+		return nil, err
+	}
+	values, err := c.callSignature(ctx, rpcURL, safe, safe, "getOwners() returns (address[])")
+	if err != nil {
+		return nil, err
+	}
+	owners, ok := values[0].([]common.Address)
+	if !ok {
+		return nil, fmt.Errorf("unexpected getOwners result %T", values[0])
+	}
+	if !threshold.IsInt64() {
+		return nil, fmt.Errorf("unexpected threshold %s", threshold)
+	}
+
+	info := &service.SafeInfo{
+		Version:   version,
+		Threshold: int(threshold.Int64()),
+		Owners:    make([]string, len(owners)),
+		Nonce:     nonce.String(),
+	}
+	for i, owner := range owners {
+		info.Owners[i] = owner.Hex()
+	}
+	return info, nil
+}
+
+// HashTypedData returns the SafeTxHash of a SafeTx typed data document.
+func (c *SafeClient) HashTypedData(data *service.TypedData) (string, error) {
+	digest, err := HashTypedData(data)
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(digest), nil
+}
+
+// EncodeExecTransaction returns the execTransaction calldata for tx with the
+// packed owner signatures.
+func (c *SafeClient) EncodeExecTransaction(tx service.SafeTransaction, signatures string) (string, error) {
+	method, err := parseSignature(execTransactionSignature)
+	if err != nil {
+		return "", err
+	}
+	quantities := make([]*big.Int, 0, 4)
+	for _, value := range []string{tx.Value, tx.SafeTxGas, tx.BaseGas, tx.GasPrice} {
+		quantity, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return "", fmt.Errorf("%w: invalid amount %q", service.ErrValidation, value)
+		}
+		quantities = append(quantities, quantity)
+	}
+	data, err := hexutil.Decode(tx.Data)
+	if err != nil {
+		return "", fmt.Errorf("%w: data must be 0x-prefixed hex", service.ErrValidation)
+	}
+	packedSignatures, err := hexutil.Decode(signatures)
+	if err != nil {
+		return "", fmt.Errorf("%w: signatures must be 0x-prefixed hex", service.ErrValidation)
+	}
+
+	args, err := method.Inputs.Pack(
+		common.HexToAddress(tx.To), quantities[0], data, uint8(tx.Operation),
+		quantities[1], quantities[2], quantities[3],
+		common.HexToAddress(tx.GasToken), common.HexToAddress(tx.RefundReceiver),
+		packedSignatures,
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: encode execTransaction: %v", service.ErrValidation, err)
+	}
+	return hexutil.Encode(append(method.ID, args...)), nil
+}
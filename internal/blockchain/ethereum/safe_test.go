@@ -0,0 +1,116 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+var (
+	// The type hashes hard-coded in Safe.sol.
+	safeDomainTypeHash       = common.HexToHash("0x47e79534a245952e8b16893a336b85a3d9ea9fa8c573f3d803afb92a79469218")
+	safeLegacyDomainTypeHash = common.HexToHash("0x035aff83d86937d35b32e04f0ddc6ff469290eef2f1b692d8a815c89404d4749")
+	safeTxTypeHash           = common.HexToHash("0xbb8310d486368db6bd6f849402fdd73ad53d316b5a4b2644ad6efe0f941286d8")
+)
+
+func safeTransaction() service.SafeTransaction {
+	return service.SafeTransaction{
+		To:             "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:          "1000",
+		Data:           "0xa9059cbb",
+		Operation:      service.SafeOperationCall,
+		SafeTxGas:      "0",
+		BaseGas:        "0",
+		GasPrice:       "0",
+		GasToken:       "0x0000000000000000000000000000000000000000",
+		RefundReceiver: "0x0000000000000000000000000000000000000000",
+		Nonce:          "7",
+	}
+}
+
+// expectedSafeTxHash follows Safe.getTransactionHash.
+func expectedSafeTxHash(domain []byte, tx service.SafeTransaction) []byte {
+	value, _ := new(big.Int).SetString(tx.Value, 10)
+	nonce, _ := new(big.Int).SetString(tx.Nonce, 10)
+	structHash := crypto.Keccak256(
+		safeTxTypeHash.Bytes(),
+		word(common.HexToAddress(tx.To).Bytes()),
+		word(value.Bytes()),
+		crypto.Keccak256(hexutil.MustDecode(tx.Data)),
+		word([]byte{byte(tx.Operation)}),
+		word(nil), word(nil), word(nil),
+		word(common.HexToAddress(tx.GasToken).Bytes()),
+		word(common.HexToAddress(tx.RefundReceiver).Bytes()),
+		word(nonce.Bytes()),
+	)
+	return crypto.Keccak256([]byte{0x19, 0x01}, domain, structHash)
+}
+
+func TestSafeTxHashMatchesContract(t *testing.T) {
+	safe := "0x9999999999999999999999999999999999999999"
+	tx := safeTransaction()
+	client := NewSafeClient(nil)
+
+	cases := []struct {
+		version string
+		domain  []byte
+	}{
+		{"1.3.0+L2", crypto.Keccak256(safeDomainTypeHash.Bytes(), word(big.NewInt(11155111).Bytes()), word(common.HexToAddress(safe).Bytes()))},
+		{"1.1.1", crypto.Keccak256(safeLegacyDomainTypeHash.Bytes(), word(common.HexToAddress(safe).Bytes()))},
+	}
+	for _, tc := range cases {
+		data, err := service.SafeTypedData(tc.version, 11155111, safe, tx)
+		if err != nil {
+			t.Fatalf("SafeTypedData(%s) returned error: %v", tc.version, err)
+		}
+		hash, err := client.HashTypedData(data)
+		if err != nil {
+			t.Fatalf("HashTypedData(%s) returned error: %v", tc.version, err)
+		}
+		if want := hexutil.Encode(expectedSafeTxHash(tc.domain, tx)); hash != want {
+			t.Fatalf("version %s: expected SafeTxHash %s, got %s", tc.version, want, hash)
+		}
+	}
+}
+
+func TestReadSafeAndEncodeExecTransaction(t *testing.T) {
+	owners := []common.Address{common.HexToAddress("0x1111111111111111111111111111111111111111"), common.HexToAddress("0x2222222222222222222222222222222222222222")}
+	client := NewSafeClient(&fakeToken{t: t, returns: map[string][]interface{}{
+		"VERSION() returns (string)":       {"1.4.1"},
+		"getThreshold() returns (uint256)": {big.NewInt(2)},
+		"nonce() returns (uint256)":        {big.NewInt(7)},
+		"getOwners() returns (address[])":  {owners},
+	}})
+
+	info, err := client.ReadSafe(context.Background(), "http://rpc", "0x9999999999999999999999999999999999999999")
+	if err != nil {
+		t.Fatalf("ReadSafe returned error: %v", err)
+	}
+	if info.Version != "1.4.1" || info.Threshold != 2 || info.Nonce != "7" || len(info.Owners) != 2 || info.Owners[1] != owners[1].Hex() {
+		t.Fatalf("unexpected Safe info %+v", info)
+	}
+
+	signatures := bytes.Repeat([]byte{0x01}, 130)
+	data, err := client.EncodeExecTransaction(safeTransaction(), hexutil.Encode(signatures))
+	if err != nil {
+		t.Fatalf("EncodeExecTransaction returned error: %v", err)
+	}
+	method, _ := parseSignature(execTransactionSignature)
+	if hexutil.Encode(method.ID) != "0x6a761202" {
+		t.Fatalf("unexpected execTransaction selector %x", method.ID)
+	}
+	args, err := method.Inputs.Unpack(hexutil.MustDecode(data)[4:])
+	if err != nil {
+		t.Fatalf("decode execTransaction: %v", err)
+	}
+	if !bytes.Equal(args[9].([]byte), signatures) || args[1].(*big.Int).Int64() != 1000 {
+		t.Fatalf("unexpected execTransaction arguments %v", args)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/rickyreddygari/walletsdk/internal/service"
@@ -72,6 +73,12 @@ func hashMessage(payload []byte) []byte {
 }
 
 func RecoverAddress(message []byte, signature []byte) (common.Address, error) {
+	return RecoverDigest(hashMessage(message), signature)
+}
+
+// RecoverDigest returns the address that signed a 32-byte digest. v may be
+// 0/1 or 27/28.
+func RecoverDigest(digest []byte, signature []byte) (common.Address, error) {
 	if len(signature) != 65 {
 		return common.Address{}, fmt.Errorf("invalid signature length: %d", len(signature))
 	}
@@ -83,8 +90,6 @@ func RecoverAddress(message []byte, signature []byte) (common.Address, error) {
 		sig[64] -= 27
 	}
 
-	digest := hashMessage(message)
-
 	pubKey, err := crypto.SigToPub(digest, sig)
 	if err != nil {
 		return common.Address{}, fmt.Errorf("recover public key: %w", err)
@@ -94,6 +99,10 @@ func RecoverAddress(message []byte, signature []byte) (common.Address, error) {
 }
 
 func (s *Signer) SignTransaction(tx *service.Transaction, privKeyHex string) (string, error) {
+	if len(tx.AuthorizationList) > 0 || len(tx.Blobs) > 0 {
+		return s.signTypedTransaction(tx, privKeyHex)
+	}
+
 	privKey, err := crypto.HexToECDSA(privKeyHex)
 	if err != nil {
 		return "", fmt.Errorf("decode private key: %w", err)
@@ -135,9 +144,59 @@ func (s *Signer) SignTransaction(tx *service.Transaction, privKeyHex string) (st
 	return "0x" + hex.EncodeToString(bytes), nil
 }
 
+// signTypedTransaction signs set-code and blob transactions. A blob
+// transaction is returned in its network encoding, with the sidecar.
+func (s *Signer) signTypedTransaction(tx *service.Transaction, privKeyHex string) (string, error) {
+	sign := s.signSetCodeTransaction
+	if len(tx.Blobs) > 0 {
+		sign = s.signBlobTransaction
+	}
+	signedTx, err := sign(tx, privKeyHex)
+	if err != nil {
+		return "", err
+	}
+	bytes, err := signedTx.MarshalBinary()
+	if err != nil {
+		return "", fmt.Errorf("marshal signed tx: %w", err)
+	}
+	return "0x" + hex.EncodeToString(bytes), nil
+}
+
+// dynamicFees parses the value and fees of a set-code or blob transaction,
+// whose GasPrice is the fee cap.
+func dynamicFees(tx *service.Transaction) (value, feeCap, tip *uint256.Int, err error) {
+	if value, err = parseUint256(tx.Value); err != nil {
+		return nil, nil, nil, fmt.Errorf("parse value: %w", err)
+	}
+	if feeCap, err = parseUint256(tx.GasPrice); err != nil {
+		return nil, nil, nil, fmt.Errorf("parse gas price: %w", err)
+	}
+	tip = feeCap
+	if tx.MaxPriorityFeePerGas != "" {
+		if tip, err = parseUint256(tx.MaxPriorityFeePerGas); err != nil {
+			return nil, nil, nil, fmt.Errorf("parse max priority fee: %w", err)
+		}
+	}
+	return value, feeCap, tip, nil
+}
+
 func stripHex(input string) string {
 	if len(input) >= 2 && input[:2] == "0x" {
 		return input[2:]
 	}
 	return input
 }
+
+// RecoverSigner returns the checksummed address that produced a
+// personal_sign signature over message.
+func (s *Signer) RecoverSigner(message []byte, signature string) (string, error) {
+	sig, err := hex.DecodeString(stripHex(signature))
+	if err != nil {
+		return "", fmt.Errorf("decode signature: %w", err)
+	}
+	address, err := RecoverAddress(message, sig)
+	if err != nil {
+		return "", err
+	}
+	return address.Hex(), nil
+}
@@ -0,0 +1,213 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// Simulator dry-runs transactions with eth_call and eth_estimateGas, and adds
+// a debug_traceCall trace when the node exposes the debug namespace. It also
+// reads the nonce and gas price needed to fill in transactions.
+type Simulator struct {
+	dial func(ctx context.Context, rpcURL string) (*rpc.Client, error)
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{dial: rpc.DialContext}
+}
+
+func (s *Simulator) Simulate(ctx context.Context, rpcURL string, from string, tx *service.Transaction) (*service.SimulationResult, error) {
+	msg, err := callMsg(from, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcClient, err := s.dial(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+	defer rpcClient.Close()
+	client := ethclient.NewClient(rpcClient)
+
+	result := &service.SimulationResult{}
+	output, err := client.CallContract(ctx, msg, nil)
+	if err != nil {
+		data, ok := revertData(err)
+		if !ok {
+			return nil, fmt.Errorf("eth_call: %w", err)
+		}
+		result = revertResult(data, err)
+	} else {
+		result.Success = true
+		result.ReturnData = hexutil.Encode(output)
+		gas, err := client.EstimateGas(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("estimate gas: %w", err)
+		}
+		result.GasEstimate = gas
+	}
+
+	// Not every provider enables debug_traceCall, so a failure only means the
+	// trace is left out.
+	var trace json.RawMessage
+	if err := rpcClient.CallContext(ctx, &trace, "debug_traceCall", traceArgs(msg), "latest", map[string]string{"tracer": "callTracer"}); err == nil {
+		result.Trace = trace
+	}
+	return result, nil
+}
+
+// Call runs a read-only eth_call and returns the raw return data.
+func (s *Simulator) Call(ctx context.Context, rpcURL string, from string, tx *service.Transaction) (string, error) {
+	msg, err := callMsg(from, tx)
+	if err != nil {
+		return "", err
+	}
+
+	rpcClient, err := s.dial(ctx, rpcURL)
+	if err != nil {
+		return "", fmt.Errorf("dial rpc: %w", err)
+	}
+	defer rpcClient.Close()
+
+	output, err := ethclient.NewClient(rpcClient).CallContract(ctx, msg, nil)
+	if err != nil {
+		data, ok := revertData(err)
+		if !ok {
+			return "", fmt.Errorf("eth_call: %w", err)
+		}
+		return "", &service.SimulationRevertedError{Result: revertResult(data, err)}
+	}
+	return hexutil.Encode(output), nil
+}
+
+// DecodeRevert returns the reason encoded as Error(string) or Panic(uint256),
+// falling back to the node's error message for custom errors.
+func DecodeRevert(data []byte, callErr error) string {
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	if callErr != nil {
+		return callErr.Error()
+	}
+	return "execution reverted"
+}
+
+func revertResult(data []byte, callErr error) *service.SimulationResult {
+	result := &service.SimulationResult{RevertReason: DecodeRevert(data, callErr)}
+	if len(data) > 0 {
+		result.RevertData = hexutil.Encode(data)
+	}
+	return result
+}
+
+// revertData extracts the revert payload from a JSON-RPC error. Nodes report
+// reverts as "execution reverted" with the payload in the error data.
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if raw, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(raw); decodeErr == nil {
+				return data, true
+			}
+		}
+	}
+	if strings.Contains(err.Error(), "execution reverted") {
+		return nil, true
+	}
+	return nil, false
+}
+
+func callMsg(from string, tx *service.Transaction) (ethereum.CallMsg, error) {
+	if !common.IsHexAddress(from) {
+		return ethereum.CallMsg{}, fmt.Errorf("invalid from address %q", from)
+	}
+	msg := ethereum.CallMsg{
+		From: common.HexToAddress(from),
+		Gas:  tx.GasLimit,
+	}
+	if tx.To != "" {
+		to := common.HexToAddress(tx.To)
+		msg.To = &to
+	}
+
+	value, err := parseQuantity(tx.Value)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("invalid value: %w", err)
+	}
+	msg.Value = value
+	gasPrice, err := parseQuantity(tx.GasPrice)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("invalid gas price: %w", err)
+	}
+	msg.GasPrice = gasPrice
+
+	if tx.Data != "" {
+		data, err := hexutil.Decode(tx.Data)
+		if err != nil {
+			return ethereum.CallMsg{}, fmt.Errorf("invalid data: %w", err)
+		}
+		msg.Data = data
+	}
+	if msg.AuthorizationList, err = toSetCodeAuthorizations(tx.AuthorizationList); err != nil {
+		return ethereum.CallMsg{}, err
+	}
+	if len(tx.Blobs) > 0 {
+		if msg.BlobHashes, err = blobHashes(tx.Blobs); err != nil {
+			return ethereum.CallMsg{}, err
+		}
+		if msg.BlobGasFeeCap, err = parseQuantity(tx.MaxFeePerBlobGas); err != nil {
+			return ethereum.CallMsg{}, fmt.Errorf("invalid max fee per blob gas: %w", err)
+		}
+	}
+	return msg, nil
+}
+
+func traceArgs(msg ethereum.CallMsg) map[string]interface{} {
+	args := map[string]interface{}{
+		"from":  msg.From,
+		"input": hexutil.Bytes(msg.Data),
+		"value": (*hexutil.Big)(msg.Value),
+	}
+	if msg.To != nil {
+		args["to"] = msg.To
+	}
+	if msg.Gas != 0 {
+		args["gas"] = hexutil.Uint64(msg.Gas)
+	}
+	if msg.GasPrice != nil && msg.GasPrice.Sign() > 0 {
+		args["gasPrice"] = (*hexutil.Big)(msg.GasPrice)
+	}
+	if msg.AuthorizationList != nil {
+		args["authorizationList"] = msg.AuthorizationList
+	}
+	if msg.BlobHashes != nil {
+		args["blobVersionedHashes"] = msg.BlobHashes
+		args["maxFeePerBlobGas"] = (*hexutil.Big)(msg.BlobGasFeeCap)
+	}
+	return args
+}
+
+func parseQuantity(input string) (*big.Int, error) {
+	digits := stripHex(strings.TrimSpace(input))
+	if digits == "" {
+		return new(big.Int), nil
+	}
+	value, ok := new(big.Int).SetString(digits, 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid quantity %q", input)
+	}
+	return value, nil
+}
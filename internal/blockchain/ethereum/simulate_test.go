@@ -0,0 +1,105 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// Error("insufficient balance")
+const errorRevertData = "0x08c379a0" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"0000000000000000000000000000000000000000000000000000000000000014" +
+	"696e73756666696369656e742062616c616e6365000000000000000000000000"
+
+func newRPCServer(t *testing.T, handle func(method string) (interface{}, map[string]interface{})) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode rpc request: %v", err)
+			return
+		}
+		result, rpcErr := handle(req.Method)
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if rpcErr != nil {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = result
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func simulationTx() *service.Transaction {
+	return &service.Transaction{
+		ChainID:  11155111,
+		To:       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:    "0x0",
+		Data:     "0xa9059cbb",
+		GasLimit: 100000,
+		GasPrice: "0x1",
+	}
+}
+
+func TestSimulateDecodesRevertReason(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		switch method {
+		case "eth_call":
+			return nil, map[string]interface{}{"code": 3, "message": "execution reverted", "data": errorRevertData}
+		default:
+			return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+	})
+	defer server.Close()
+
+	result, err := NewSimulator().Simulate(context.Background(), server.URL, "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", simulationTx())
+	if err != nil {
+		t.Fatalf("Simulate returned error: %v", err)
+	}
+	if result.Success || result.RevertReason != "insufficient balance" || result.RevertData != errorRevertData {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if result.Trace != nil {
+		t.Fatalf("expected no trace when debug_traceCall is unavailable")
+	}
+}
+
+func TestSimulateEstimatesGasAndTraces(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		switch method {
+		case "eth_call":
+			return "0x01", nil
+		case "eth_estimateGas":
+			return hexutil.Uint64(46000), nil
+		case "debug_traceCall":
+			return map[string]string{"type": "CALL"}, nil
+		}
+		return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+	})
+	defer server.Close()
+
+	result, err := NewSimulator().Simulate(context.Background(), server.URL, "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", simulationTx())
+	if err != nil {
+		t.Fatalf("Simulate returned error: %v", err)
+	}
+	if !result.Success || result.GasEstimate != 46000 || result.ReturnData != "0x01" || string(result.Trace) != `{"type":"CALL"}` {
+		t.Fatalf("unexpected result %+v", result)
+	}
+}
+
+func TestDecodeRevertPanic(t *testing.T) {
+	data := hexutil.MustDecode("0x4e487b71" + "0000000000000000000000000000000000000000000000000000000000000011")
+	if reason := DecodeRevert(data, nil); reason != "arithmetic underflow or overflow" {
+		t.Fatalf("unexpected panic reason %q", reason)
+	}
+}
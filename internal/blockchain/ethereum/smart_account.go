@@ -0,0 +1,102 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+var (
+	createAccountMethod = abi.NewMethod("createAccount", "createAccount", abi.Function, "", false, false,
+		abi.Arguments{{Name: "owner", Type: mustType("address")}, {Name: "salt", Type: mustType("uint256")}},
+		abi.Arguments{{Name: "account", Type: mustType("address")}},
+	)
+	getAddressMethod = abi.NewMethod("getAddress", "getAddress", abi.Function, "view", false, false,
+		abi.Arguments{{Name: "owner", Type: mustType("address")}, {Name: "salt", Type: mustType("uint256")}},
+		abi.Arguments{{Name: "account", Type: mustType("address")}},
+	)
+	accountSaltEncoding = abi.Arguments{
+		{Type: mustType("address")},
+		{Type: mustType("uint256")},
+	}
+)
+
+// AccountAddress returns the CREATE2 address factory deploys for owner and
+// salt: keccak256(0xff ++ factory ++ keccak256(abi.encode(owner, salt)) ++
+// initCodeHash)[12:].
+func (b *Bundler) AccountAddress(factory service.AccountFactory, owner string, salt *big.Int) (string, error) {
+	initCodeHash, err := hexutil.Decode(factory.InitCodeHash)
+	if err != nil || len(initCodeHash) != 32 {
+		return "", fmt.Errorf("%w: factory %q needs a 32-byte init code hash", service.ErrValidation, factory.Name)
+	}
+	if !common.IsHexAddress(factory.Address) {
+		return "", fmt.Errorf("%w: invalid factory address", service.ErrValidation)
+	}
+	encoded, err := accountSaltEncoding.Pack(common.HexToAddress(owner), salt)
+	if err != nil {
+		return "", fmt.Errorf("%w: encode account salt: %v", service.ErrValidation, err)
+	}
+	address := crypto.CreateAddress2(common.HexToAddress(factory.Address), keccak32(encoded), initCodeHash)
+	return address.Hex(), nil
+}
+
+// FactoryAddress asks the deployed factory for the address it would create
+// for owner and salt, by calling its getAddress(owner, salt) view.
+func (b *Bundler) FactoryAddress(ctx context.Context, rpcURL string, factory service.AccountFactory, owner string, salt *big.Int) (string, error) {
+	args, err := getAddressMethod.Inputs.Pack(common.HexToAddress(owner), salt)
+	if err != nil {
+		return "", fmt.Errorf("%w: encode getAddress: %v", service.ErrValidation, err)
+	}
+	rpcClient, err := b.dial(ctx, rpcURL)
+	if err != nil {
+		return "", fmt.Errorf("dial rpc: %w", err)
+	}
+	client := ethclient.NewClient(rpcClient)
+	defer client.Close()
+
+	to := common.HexToAddress(factory.Address)
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &to, Data: append(getAddressMethod.ID, args...)}, nil)
+	if err != nil {
+		return "", fmt.Errorf("call getAddress: %w", err)
+	}
+	values, err := getAddressMethod.Outputs.Unpack(output)
+	if err != nil || len(values) != 1 {
+		return "", fmt.Errorf("decode getAddress: unexpected result %s", hexutil.Encode(output))
+	}
+	return values[0].(common.Address).Hex(), nil
+}
+
+// FactoryData returns the createAccount(owner, salt) calldata that deploys
+// the account.
+func (b *Bundler) FactoryData(factory service.AccountFactory, owner string, salt *big.Int) (string, error) {
+	args, err := createAccountMethod.Inputs.Pack(common.HexToAddress(owner), salt)
+	if err != nil {
+		return "", fmt.Errorf("%w: encode createAccount: %v", service.ErrValidation, err)
+	}
+	return hexutil.Encode(append(createAccountMethod.ID, args...)), nil
+}
+
+// AccountDeployed reports whether address has code at the latest block.
+func (b *Bundler) AccountDeployed(ctx context.Context, rpcURL string, address string) (bool, error) {
+	rpcClient, err := b.dial(ctx, rpcURL)
+	if err != nil {
+		return false, fmt.Errorf("dial rpc: %w", err)
+	}
+	client := ethclient.NewClient(rpcClient)
+	defer client.Close()
+
+	code, err := client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return false, fmt.Errorf("get code: %w", err)
+	}
+	return len(code) > 0, nil
+}
@@ -0,0 +1,89 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestAccountAddressMatchesCreate2(t *testing.T) {
+	initCodeHash := crypto.Keccak256([]byte("proxy creation code"))
+	factory := service.AccountFactory{
+		Name:         "default",
+		Address:      "0x9406Cc6185a346906296840746125a0E44976454",
+		InitCodeHash: hexutil.Encode(initCodeHash),
+	}
+	owner := "0x1111111111111111111111111111111111111111"
+	bundler := NewBundler()
+
+	address, err := bundler.AccountAddress(factory, owner, big.NewInt(7))
+	if err != nil {
+		t.Fatalf("AccountAddress returned error: %v", err)
+	}
+	salt := crypto.Keccak256(word(common.HexToAddress(owner).Bytes()), word([]byte{7}))
+	want := crypto.CreateAddress2(common.HexToAddress(factory.Address), [32]byte(salt), initCodeHash)
+	if address != want.Hex() {
+		t.Fatalf("expected %s, got %s", want.Hex(), address)
+	}
+
+	data, err := bundler.FactoryData(factory, owner, big.NewInt(7))
+	if err != nil {
+		t.Fatalf("FactoryData returned error: %v", err)
+	}
+	selector := hexutil.Encode(crypto.Keccak256([]byte("createAccount(address,uint256)"))[:4])
+	if data[:10] != selector || len(data) != 2+2*(4+64) {
+		t.Fatalf("unexpected createAccount calldata %s", data)
+	}
+
+	factory.InitCodeHash = "0x1234"
+	if _, err := bundler.AccountAddress(factory, owner, big.NewInt(7)); err == nil {
+		t.Fatal("expected an error for a short init code hash")
+	}
+}
+
+func TestAccountDeployedChecksCode(t *testing.T) {
+	code := "0x"
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		if method != "eth_getCode" {
+			t.Errorf("unexpected method %s", method)
+		}
+		return code, nil
+	})
+	defer server.Close()
+
+	bundler := NewBundler()
+	deployed, err := bundler.AccountDeployed(context.Background(), server.URL, "0x2222222222222222222222222222222222222222")
+	if err != nil || deployed {
+		t.Fatalf("expected an undeployed account, got %v (%v)", deployed, err)
+	}
+	code = "0x6080"
+	deployed, err = bundler.AccountDeployed(context.Background(), server.URL, "0x2222222222222222222222222222222222222222")
+	if err != nil || !deployed {
+		t.Fatalf("expected a deployed account, got %v (%v)", deployed, err)
+	}
+}
+
+func TestFactoryAddressCallsGetAddress(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		if method != "eth_call" {
+			t.Errorf("unexpected method %s", method)
+		}
+		return hexutil.Encode(word(common.HexToAddress("0x2222222222222222222222222222222222222222").Bytes())), nil
+	})
+	defer server.Close()
+
+	factory := service.AccountFactory{Name: "default", Address: "0x9406Cc6185a346906296840746125a0E44976454"}
+	address, err := NewBundler().FactoryAddress(context.Background(), server.URL, factory, "0x1111111111111111111111111111111111111111", big.NewInt(7))
+	if err != nil {
+		t.Fatalf("FactoryAddress returned error: %v", err)
+	}
+	if address != "0x2222222222222222222222222222222222222222" {
+		t.Fatalf("unexpected factory address %s", address)
+	}
+}
@@ -0,0 +1,83 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// SignTypedData signs the EIP-712 hash of data. v is 27 or 28, as
+// eth_signTypedData_v4 returns it.
+func (s *Signer) SignTypedData(_ string, privKeyHex string, data *service.TypedData) (*service.SignatureOutput, error) {
+	privKey, err := crypto.HexToECDSA(privKeyHex)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+
+	digest, err := HashTypedData(data)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(digest, privKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign digest: %w", err)
+	}
+	sig[64] += 27
+
+	return &service.SignatureOutput{
+		Signature: "0x" + hex.EncodeToString(sig),
+		PublicKey: "0x" + hex.EncodeToString(crypto.FromECDSAPub(&privKey.PublicKey)),
+	}, nil
+}
+
+// HashTypedData returns the EIP-712 digest of data.
+func HashTypedData(data *service.TypedData) ([]byte, error) {
+	digest, _, err := apitypes.TypedDataAndHash(toAPITypedData(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash typed data: %v", service.ErrValidation, err)
+	}
+	return digest, nil
+}
+
+// DomainSeparator returns the hash of the EIP712Domain of data.
+func DomainSeparator(data *service.TypedData) ([]byte, error) {
+	typed := toAPITypedData(data)
+	separator, err := typed.HashStruct("EIP712Domain", typed.Domain.Map())
+	if err != nil {
+		return nil, fmt.Errorf("%w: hash domain: %v", service.ErrValidation, err)
+	}
+	return separator, nil
+}
+
+func toAPITypedData(data *service.TypedData) apitypes.TypedData {
+	types := make(apitypes.Types, len(data.Types))
+	for name, fields := range data.Types {
+		converted := make([]apitypes.Type, len(fields))
+		for i, field := range fields {
+			converted[i] = apitypes.Type{Name: field.Name, Type: field.Type}
+		}
+		types[name] = converted
+	}
+
+	domain := apitypes.TypedDataDomain{
+		Name:              data.Domain.Name,
+		Version:           data.Domain.Version,
+		VerifyingContract: data.Domain.VerifyingContract,
+	}
+	if data.Domain.ChainID != 0 {
+		domain.ChainId = (*math.HexOrDecimal256)(big.NewInt(data.Domain.ChainID))
+	}
+
+	return apitypes.TypedData{
+		Types:       types,
+		PrimaryType: data.PrimaryType,
+		Domain:      domain,
+		Message:     data.Message,
+	}
+}
@@ -0,0 +1,274 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// erc6492Suffix marks a signature wrapped as
+// abi.encode(factory, factoryCalldata, signature) ++ suffix.
+var erc6492Suffix = common.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+
+// erc1271MagicValue is what isValidSignature returns for a valid signature.
+var erc1271MagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+
+var (
+	isValidSignatureMethod = mustParseSignature("isValidSignature(bytes32 hash, bytes signature) returns (bytes4)")
+	erc6492Wrapper         = abi.Arguments{
+		{Type: mustType("address")},
+		{Type: mustType("bytes")},
+		{Type: mustType("bytes")},
+	}
+)
+
+// SignatureVerifier checks EOA signatures with ecrecover and smart-account
+// signatures with ERC-1271, including EIP-6492 signatures from accounts that
+// are not deployed yet.
+type SignatureVerifier struct {
+	dial func(ctx context.Context, rpcURL string) (*rpc.Client, error)
+}
+
+func NewSignatureVerifier() *SignatureVerifier {
+	return &SignatureVerifier{dial: rpc.DialContext}
+}
+
+func (v *SignatureVerifier) VerifySignature(ctx context.Context, rpcURL string, req service.SignatureVerifyRequest) (*service.SignatureVerification, error) {
+	digest, err := signatureDigest(req)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := hexutil.Decode(req.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature must be 0x-prefixed hex", service.ErrValidation)
+	}
+	address := common.HexToAddress(req.Address)
+	result := &service.SignatureVerification{Address: address.Hex()}
+
+	rpcClient, err := v.dial(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+	defer rpcClient.Close()
+	client := ethclient.NewClient(rpcClient)
+
+	code, err := client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get code: %w", err)
+	}
+
+	if bytes.HasSuffix(signature, erc6492Suffix) {
+		factory, factoryCalldata, inner, err := unwrapERC6492(signature)
+		if err != nil {
+			return nil, err
+		}
+		if len(code) == 0 {
+			valid, reason, err := v.validateCounterfactual(ctx, client, address, factory, factoryCalldata, digest, inner)
+			if err != nil {
+				return nil, err
+			}
+			if valid {
+				result.Valid, result.Method = true, service.SignatureMethodERC6492
+			} else {
+				result.Reason = reason
+			}
+			return result, nil
+		}
+		// The account has been deployed since signing, so the inner
+		// signature can be checked directly.
+		signature = inner
+	}
+
+	var reason string
+	if len(code) > 0 {
+		valid, why, err := isValidSignature(ctx, client, address, digest, signature)
+		if err != nil {
+			return nil, err
+		}
+		if valid {
+			result.Valid, result.Method = true, service.SignatureMethodERC1271
+			return result, nil
+		}
+		reason = why
+	}
+
+	// Accounts with code can still be EOAs, for example under an EIP-7702
+	// delegation, so ecrecover is always tried last.
+	if len(signature) == 65 {
+		signer, err := RecoverDigest(digest, signature)
+		if err == nil && signer == address {
+			result.Valid, result.Method = true, service.SignatureMethodECRecover
+			return result, nil
+		}
+		if reason == "" && err == nil {
+			reason = "signature was made by " + signer.Hex()
+		}
+	}
+	if reason == "" {
+		reason = fmt.Sprintf("%s has no code and the signature is not a 65-byte ECDSA signature", address.Hex())
+	}
+	result.Reason = reason
+	return result, nil
+}
+
+// signatureDigest returns the 32-byte hash the signature should cover.
+func signatureDigest(req service.SignatureVerifyRequest) ([]byte, error) {
+	switch {
+	case req.TypedData != nil:
+		return HashTypedData(req.TypedData)
+	case req.Hash != "":
+		digest, err := hexutil.Decode(req.Hash)
+		if err != nil || len(digest) != 32 {
+			return nil, fmt.Errorf("%w: hash must be 32 bytes of 0x-prefixed hex", service.ErrValidation)
+		}
+		return digest, nil
+	default:
+		return hashMessage([]byte(req.Message)), nil
+	}
+}
+
+func unwrapERC6492(signature []byte) (common.Address, []byte, []byte, error) {
+	values, err := erc6492Wrapper.Unpack(signature[:len(signature)-len(erc6492Suffix)])
+	if err != nil || len(values) != 3 {
+		return common.Address{}, nil, nil, fmt.Errorf("%w: malformed EIP-6492 signature", service.ErrValidation)
+	}
+	factory, _ := values[0].(common.Address)
+	factoryCalldata, _ := values[1].([]byte)
+	inner, _ := values[2].([]byte)
+	return factory, factoryCalldata, inner, nil
+}
+
+func isValidSignatureCalldata(digest []byte, signature []byte) ([]byte, error) {
+	var hash [32]byte
+	copy(hash[:], digest)
+	packed, err := isValidSignatureMethod.Inputs.Pack(hash, signature)
+	if err != nil {
+		return nil, fmt.Errorf("encode isValidSignature: %w", err)
+	}
+	return append(append([]byte{}, isValidSignatureMethod.ID...), packed...), nil
+}
+
+func isValidSignature(ctx context.Context, client *ethclient.Client, address common.Address, digest []byte, signature []byte) (bool, string, error) {
+	data, err := isValidSignatureCalldata(digest, signature)
+	if err != nil {
+		return false, "", err
+	}
+	output, err := client.CallContract(ctx, ethereum.CallMsg{To: &address, Data: data}, nil)
+	if err != nil {
+		if revert, ok := revertData(err); ok {
+			return false, "isValidSignature reverted: " + DecodeRevert(revert, err), nil
+		}
+		return false, "", fmt.Errorf("call isValidSignature: %w", err)
+	}
+	if !hasMagicValue(output) {
+		return false, "isValidSignature did not return the ERC-1271 magic value", nil
+	}
+	return true, "", nil
+}
+
+// counterfactualValidator is the init code of a deployless validator, run
+// as an eth_call without a recipient the way EIP-6492 verifies signatures
+// of accounts that are not deployed yet. The constructor reads its
+// arguments from the code that follows it:
+//
+//	factory (32 bytes) ++ account (32) ++ len(factoryCalldata) (32) ++
+//	len(checkCalldata) (32) ++ factoryCalldata ++ checkCalldata
+//
+// It calls the factory, then staticcalls the account with checkCalldata,
+// and returns one status byte followed by the failing call's revert data.
+var counterfactualValidator = common.FromHex("0x" +
+	"6100663803610066600039" + // 0x00 codecopy(0, 0x66, codesize-0x66)
+	"6000600060405160806000600051" + // 0x0b push the factory call arguments
+	"5af11561004b57" + // 0x19 call the factory, jump to 0x4b on failure
+	"60206000606051604051608001602051" + // 0x20 push the isValidSignature arguments
+	"5afa1561005257" + // 0x30 staticcall the account, jump to 0x52 on failure
+	"60005160e01c631626ba7e14600053" + // 0x37 mstore8(0, returned bytes4 == magic value)
+	"60016000f3" + // 0x46 return(0, 1)
+	"5b600261005556" + // 0x4b status 2, jump to 0x55
+	"5b6003" + // 0x52 status 3
+	"5b6000533d600060013e3d6001016000f3", // 0x55 return the status and the revert data
+)
+
+// Status bytes returned by counterfactualValidator.
+const (
+	validatorInvalid byte = iota
+	validatorValid
+	validatorDeployFailed
+	validatorCheckFailed
+)
+
+// validateCounterfactual deploys the account through its factory and calls
+// isValidSignature from the constructor of counterfactualValidator, so both
+// happen in a single eth_call and nothing is sent on-chain.
+func (v *SignatureVerifier) validateCounterfactual(ctx context.Context, client *ethclient.Client, address, factory common.Address, factoryCalldata, digest, signature []byte) (bool, string, error) {
+	check, err := isValidSignatureCalldata(digest, signature)
+	if err != nil {
+		return false, "", err
+	}
+	data := append([]byte{}, counterfactualValidator...)
+	data = append(data, common.LeftPadBytes(factory.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(address.Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(factoryCalldata))).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(check))).Bytes(), 32)...)
+	data = append(data, factoryCalldata...)
+	data = append(data, check...)
+
+	output, err := client.CallContract(ctx, ethereum.CallMsg{Data: data}, nil)
+	if err != nil {
+		return false, "", fmt.Errorf("eth_call: %w", err)
+	}
+	if len(output) == 0 {
+		return false, "", errors.New("eth_call: empty validator result")
+	}
+
+	switch output[0] {
+	case validatorValid:
+		return true, "", nil
+	case validatorInvalid:
+		return false, "isValidSignature did not return the ERC-1271 magic value", nil
+	case validatorDeployFailed:
+		return false, "factory call failed" + validatorRevert(output[1:]), nil
+	case validatorCheckFailed:
+		return false, "isValidSignature reverted" + validatorRevert(output[1:]), nil
+	default:
+		return false, "", fmt.Errorf("eth_call: unexpected validator result %s", hexutil.Encode(output))
+	}
+}
+
+func validatorRevert(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+	return ": " + DecodeRevert(data, nil)
+}
+
+func hasMagicValue(output []byte) bool {
+	return len(output) >= 4 && bytes.Equal(output[:4], erc1271MagicValue)
+}
+
+func mustParseSignature(signature string) *abi.Method {
+	method, err := parseSignature(signature)
+	if err != nil {
+		panic(err)
+	}
+	return method
+}
+
+func mustType(name string) abi.Type {
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
@@ -0,0 +1,200 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+var magicWord = "0x1626ba7e" + strings.Repeat("0", 56)
+
+func TestVerifySignatureFallsBackToECRecover(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		if method == "eth_getCode" {
+			return "0x", nil
+		}
+		return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+	})
+	defer server.Close()
+
+	key, _ := crypto.GenerateKey()
+	sig, err := crypto.Sign(hashMessage([]byte("hello")), key)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	sig[64] += 27
+	req := service.SignatureVerifyRequest{
+		Address:   crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		Message:   "hello",
+		Signature: hexutil.Encode(sig),
+	}
+
+	result, err := NewSignatureVerifier().VerifySignature(context.Background(), server.URL, req)
+	if err != nil {
+		t.Fatalf("VerifySignature returned error: %v", err)
+	}
+	if !result.Valid || result.Method != service.SignatureMethodECRecover {
+		t.Fatalf("expected an ecrecover match, got %+v", result)
+	}
+
+	req.Message = "goodbye"
+	result, err = NewSignatureVerifier().VerifySignature(context.Background(), server.URL, req)
+	if err != nil || result.Valid || !strings.HasPrefix(result.Reason, "signature was made by") {
+		t.Fatalf("expected a mismatch, got %+v (%v)", result, err)
+	}
+}
+
+func TestVerifySignatureCallsERC1271OnContracts(t *testing.T) {
+	server := newRPCServer(t, func(method string) (interface{}, map[string]interface{}) {
+		switch method {
+		case "eth_getCode":
+			return "0x6080604052", nil
+		case "eth_call":
+			return magicWord, nil
+		default:
+			return nil, map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+	})
+	defer server.Close()
+
+	result, err := NewSignatureVerifier().VerifySignature(context.Background(), server.URL, service.SignatureVerifyRequest{
+		Address:   "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Hash:      hexutil.Encode(crypto.Keccak256([]byte("safe tx"))),
+		Signature: "0x" + strings.Repeat("ab", 130),
+	})
+	if err != nil {
+		t.Fatalf("VerifySignature returned error: %v", err)
+	}
+	if !result.Valid || result.Method != service.SignatureMethodERC1271 {
+		t.Fatalf("expected an ERC-1271 match, got %+v", result)
+	}
+}
+
+// Contracts used to run counterfactualValidator in the EVM. accountCode
+// answers every call with the ERC-1271 magic value; factoryCode deploys it
+// with CREATE.
+var (
+	accountCode = common.FromHex("0x631626ba7e60e01b60005260206000f3")
+	factoryCode = common.FromHex("0x78" +
+		"6f631626ba7e60e01b60005260206000f360005260106010f3" + // init code returning accountCode
+		"600052601960076000f000") // create(0, 7, 25)
+	revertCode   = common.FromHex("0x60006000fd")
+	validatorKey = common.HexToAddress("0x1111111111111111111111111111111111111111")
+)
+
+// newValidatorServer answers eth_call by running the call's data as init
+// code in an EVM whose state holds the given contracts, the way a node runs a
+// deployless call.
+func newValidatorServer(t *testing.T, contracts map[common.Address][]byte) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode rpc request: %v", err)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_getCode":
+			resp["result"] = "0x"
+		case "eth_call":
+			var call struct {
+				To    *common.Address `json:"to"`
+				Data  hexutil.Bytes   `json:"data"`
+				Input hexutil.Bytes   `json:"input"`
+			}
+			if err := json.Unmarshal(req.Params[0], &call); err != nil || call.To != nil {
+				t.Errorf("expected a deployless eth_call, got %s", req.Params[0])
+				return
+			}
+			input := call.Input
+			if len(input) == 0 {
+				input = call.Data
+			}
+			statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+			for address, code := range contracts {
+				statedb.SetCode(address, code, tracing.CodeChangeUnspecified)
+				statedb.SetNonce(address, 1, tracing.NonceChangeUnspecified)
+			}
+			output, _, _, err := runtime.Create(input, &runtime.Config{State: statedb, Origin: validatorKey})
+			if err != nil {
+				resp["error"] = map[string]interface{}{"code": 3, "message": err.Error()}
+			} else {
+				resp["result"] = hexutil.Encode(output)
+			}
+		default:
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "method not found"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestVerifySignatureValidatesERC6492Deployment(t *testing.T) {
+	factory := common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")
+	deployed := crypto.CreateAddress(factory, 1)
+	stranger := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	broken := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	server := newValidatorServer(t, map[common.Address][]byte{
+		factory: factoryCode,
+		broken:  revertCode,
+	})
+	defer server.Close()
+
+	verify := func(address, factory common.Address) *service.SignatureVerification {
+		t.Helper()
+		wrapped, err := erc6492Wrapper.Pack(factory, []byte{0x12, 0x34}, []byte{0xab})
+		if err != nil {
+			t.Fatalf("pack: %v", err)
+		}
+		result, err := NewSignatureVerifier().VerifySignature(context.Background(), server.URL, service.SignatureVerifyRequest{
+			Address:   address.Hex(),
+			Message:   "hello",
+			Signature: hexutil.Encode(append(wrapped, erc6492Suffix...)),
+		})
+		if err != nil {
+			t.Fatalf("VerifySignature returned error: %v", err)
+		}
+		return result
+	}
+
+	if result := verify(deployed, factory); !result.Valid || result.Method != service.SignatureMethodERC6492 {
+		t.Fatalf("expected an EIP-6492 match, got %+v", result)
+	}
+	if result := verify(stranger, factory); result.Valid || !strings.Contains(result.Reason, "magic value") {
+		t.Fatalf("expected an account the factory did not deploy to fail, got %+v", result)
+	}
+	if result := verify(deployed, broken); result.Valid || !strings.HasPrefix(result.Reason, "factory call failed") {
+		t.Fatalf("expected a failed factory call, got %+v", result)
+	}
+	if result := verify(broken, factory); result.Valid || !strings.HasPrefix(result.Reason, "isValidSignature reverted") {
+		t.Fatalf("expected a reverted check, got %+v", result)
+	}
+}
+
+func TestCounterfactualValidatorLayout(t *testing.T) {
+	if len(counterfactualValidator) != 0x66 {
+		t.Fatalf("validator is %d bytes, but its code copy and jumps assume 0x66", len(counterfactualValidator))
+	}
+	for _, dest := range []int{0x4b, 0x52, 0x55} {
+		if counterfactualValidator[dest] != 0x5b {
+			t.Fatalf("expected a JUMPDEST at %#x", dest)
+		}
+	}
+}
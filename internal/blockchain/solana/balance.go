@@ -0,0 +1,74 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// BalanceFetcher reads SOL balances through the JSON-RPC getBalance method.
+type BalanceFetcher struct {
+	client *http.Client
+}
+
+func NewBalanceFetcher() *BalanceFetcher {
+	return &BalanceFetcher{client: http.DefaultClient}
+}
+
+// FetchBalance returns the confirmed balance of address in lamports.
+func (f *BalanceFetcher) FetchBalance(ctx context.Context, rpcURL string, address string) (string, error) {
+	var result struct {
+		Value uint64 `json:"value"`
+	}
+	if err := f.call(ctx, rpcURL, "getBalance", []interface{}{address, map[string]string{"commitment": "confirmed"}}, &result); err != nil {
+		return "", err
+	}
+	return strconv.FormatUint(result.Value, 10), nil
+}
+
+func (f *BalanceFetcher) call(ctx context.Context, rpcURL string, method string, params []interface{}, out interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rpcURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", method, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("call %s: unexpected status %d", method, resp.StatusCode)
+	}
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("decode %s response: %w", method, err)
+	}
+	if envelope.Error != nil {
+		return fmt.Errorf("call %s: %s (code %d)", method, envelope.Error.Message, envelope.Error.Code)
+	}
+	if err := json.Unmarshal(envelope.Result, out); err != nil {
+		return fmt.Errorf("decode %s result: %w", method, err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchBalanceCallsGetBalance(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string        `json:"method"`
+			Params []interface{} `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if req.Method != "getBalance" || len(req.Params) == 0 || req.Params[0] != "So11111111111111111111111111111111111111112" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":{"context":{"slot":42},"value":1500000000}}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL, "So11111111111111111111111111111111111111112")
+	if err != nil {
+		t.Fatalf("FetchBalance returned error: %v", err)
+	}
+	if balance != "1500000000" {
+		t.Fatalf("expected 1500000000 lamports, got %s", balance)
+	}
+}
+
+func TestFetchBalanceReturnsRPCErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"Invalid param: WrongSize"}}`))
+	}))
+	defer server.Close()
+
+	if _, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL, "bad"); err == nil {
+		t.Fatal("expected an error for an RPC error response")
+	}
+}
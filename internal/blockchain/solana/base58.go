@@ -0,0 +1,31 @@
+package solana
+
+import (
+	"math/big"
+	"strings"
+)
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Radix = big.NewInt(58)
+
+// encodeBase58 encodes data with the Bitcoin alphabet Solana uses for keys
+// and signatures. Leading zero bytes become leading '1's.
+func encodeBase58(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	var digits []byte
+	value := new(big.Int).SetBytes(data)
+	remainder := new(big.Int)
+	for value.Sign() > 0 {
+		value.DivMod(value, base58Radix, remainder)
+		digits = append(digits, base58Alphabet[remainder.Int64()])
+	}
+	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
+		digits[i], digits[j] = digits[j], digits[i]
+	}
+	return strings.Repeat("1", zeros) + string(digits)
+}
@@ -0,0 +1,139 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// Signer holds ed25519 keys. The private key is stored as the hex seed, and
+// addresses, public keys and signatures are base58.
+type Signer struct{}
+
+func NewSigner() *Signer {
+	return &Signer{}
+}
+
+func (s *Signer) NewWallet(network string, addressType service.AddressType) (*service.WalletRecord, error) {
+	if addressType != "" {
+		return nil, fmt.Errorf("%w: solana wallets do not have address types", service.ErrValidation)
+	}
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	address := encodeBase58(public)
+	return &service.WalletRecord{
+		Network:   network,
+		Address:   address,
+		PublicKey: address,
+		PrivKey:   hex.EncodeToString(private.Seed()),
+	}, nil
+}
+
+// SignMessage signs payload as a Solana off-chain message, the format of
+// solana sign-offchain-message. Its "\xffsolana offchain" preamble can never
+// start a transaction message, so a message signature cannot authorise a
+// transaction that SignSerializedTransaction would refuse.
+func (s *Signer) SignMessage(record *service.WalletRecord, payload []byte) (*service.SignatureOutput, error) {
+	key, err := privateKey(record.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+	message, err := offchainMessage(payload)
+	if err != nil {
+		return nil, err
+	}
+	return &service.SignatureOutput{
+		Signature: encodeBase58(ed25519.Sign(key, message)),
+		PublicKey: encodeBase58(key.Public().(ed25519.PublicKey)),
+	}, nil
+}
+
+// SignSerializedTransaction signs the message of a base64 legacy or v0
+// transaction and writes the signature into the wallet's slot. The
+// signature is the transaction ID when the wallet is the fee payer.
+func (s *Signer) SignSerializedTransaction(record *service.WalletRecord, tx string) (*service.SerializedTransactionResult, error) {
+	key, err := privateKey(record.PrivKey)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.StdEncoding.DecodeString(tx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: transaction is not base64", service.ErrValidation)
+	}
+	parsed, err := parseWireTransaction(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+	}
+
+	signature := ed25519.Sign(key, parsed.message)
+	if err := parsed.setSignature(key.Public().(ed25519.PublicKey), signature); err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+	}
+	return &service.SerializedTransactionResult{
+		Transaction: base64.StdEncoding.EncodeToString(parsed.raw),
+		Signature:   encodeBase58(signature),
+	}, nil
+}
+
+// offchainSigningDomain starts every off-chain message.
+const offchainSigningDomain = "\xffsolana offchain"
+
+// Off-chain message formats, and the longest message each allows. Restricted
+// ASCII and limited UTF-8 messages fit what a Ledger can display.
+const (
+	offchainFormatRestrictedASCII = 0
+	offchainFormatLimitedUTF8     = 1
+	offchainFormatExtendedUTF8    = 2
+
+	offchainHeaderLength  = len(offchainSigningDomain) + 4
+	offchainMaxLedgerSize = 1212
+	offchainMaxSize       = 65535 - offchainHeaderLength
+)
+
+// offchainMessage wraps payload in a version 0 off-chain message: the
+// signing domain, the header version, the message format and the message
+// length as a little-endian uint16, followed by the message.
+func offchainMessage(payload []byte) ([]byte, error) {
+	if len(payload) == 0 || len(payload) > offchainMaxSize || !utf8.Valid(payload) {
+		return nil, fmt.Errorf("%w: solana messages must be 1 to %d bytes of UTF-8", service.ErrValidation, offchainMaxSize)
+	}
+	format := byte(offchainFormatExtendedUTF8)
+	if len(payload) <= offchainMaxLedgerSize {
+		format = offchainFormatLimitedUTF8
+		if isPrintableASCII(payload) {
+			format = offchainFormatRestrictedASCII
+		}
+	}
+
+	message := make([]byte, 0, offchainHeaderLength+len(payload))
+	message = append(message, offchainSigningDomain...)
+	message = append(message, 0, format)
+	message = binary.LittleEndian.AppendUint16(message, uint16(len(payload)))
+	return append(message, payload...), nil
+}
+
+func isPrintableASCII(payload []byte) bool {
+	for _, b := range payload {
+		if b < 0x20 || b > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func privateKey(privKeyHex string) (ed25519.PrivateKey, error) {
+	seed, err := hex.DecodeString(privKeyHex)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("decode private key: invalid ed25519 seed")
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
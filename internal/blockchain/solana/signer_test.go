@@ -0,0 +1,126 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestEncodeBase58(t *testing.T) {
+	cases := map[string][]byte{
+		"11111111111111111111111111111111": make([]byte, 32),
+		"StV1DL6CwTryKyV":                  []byte("hello world"),
+		"1112":                             {0, 0, 0, 1},
+	}
+	for want, input := range cases {
+		if got := encodeBase58(input); got != want {
+			t.Errorf("encodeBase58(%x) = %s, want %s", input, got, want)
+		}
+	}
+}
+
+func TestSignerCreatesWalletsAndSignsMessages(t *testing.T) {
+	signer := NewSigner()
+	record, err := signer.NewWallet("solana-devnet", "")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	key := testKey(t, record.PrivKey)
+	if record.Address != encodeBase58(key.Public().(ed25519.PublicKey)) || record.PublicKey != record.Address {
+		t.Fatalf("address %s does not match the key", record.Address)
+	}
+
+	output, err := signer.SignMessage(record, []byte("hello"))
+	if err != nil {
+		t.Fatalf("SignMessage returned error: %v", err)
+	}
+	message := append([]byte("\xffsolana offchain\x00\x00\x05\x00"), "hello"...)
+	if output.Signature != encodeBase58(ed25519.Sign(key, message)) {
+		t.Fatalf("unexpected signature %s", output.Signature)
+	}
+
+	// A transaction message signed as a message must not yield the
+	// transaction's signature.
+	_, txMessage := testTransaction(false, bytes.Repeat([]byte{7}, 32), key.Public().(ed25519.PublicKey))
+	if output, err := signer.SignMessage(record, txMessage); err == nil && output.Signature == encodeBase58(ed25519.Sign(key, txMessage)) {
+		t.Fatal("expected message signatures to differ from transaction signatures")
+	}
+	if _, err := signer.SignMessage(record, []byte{0xff, 0xfe}); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for a message that is not UTF-8, got %v", err)
+	}
+}
+
+func TestSignSerializedTransaction(t *testing.T) {
+	signer := NewSigner()
+	record, err := signer.NewWallet("solana-devnet", "")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	key := testKey(t, record.PrivKey)
+	wallet := key.Public().(ed25519.PublicKey)
+	payer := bytes.Repeat([]byte{7}, 32)
+
+	for name, versioned := range map[string]bool{"legacy": false, "v0": true} {
+		raw, message := testTransaction(versioned, payer, wallet)
+		result, err := signer.SignSerializedTransaction(record, base64.StdEncoding.EncodeToString(raw))
+		if err != nil {
+			t.Fatalf("%s: SignSerializedTransaction returned error: %v", name, err)
+		}
+		signed, err := base64.StdEncoding.DecodeString(result.Transaction)
+		if err != nil {
+			t.Fatalf("%s: decode signed transaction: %v", name, err)
+		}
+		if !bytes.Equal(signed[1:65], make([]byte, 64)) {
+			t.Fatalf("%s: the payer's slot was overwritten", name)
+		}
+		if !ed25519.Verify(wallet, message, signed[65:129]) || result.Signature != encodeBase58(signed[65:129]) {
+			t.Fatalf("%s: wallet slot does not hold a valid signature", name)
+		}
+		if !bytes.Equal(signed[129:], message) {
+			t.Fatalf("%s: message was modified", name)
+		}
+	}
+
+	other, _ := testTransaction(false, payer, bytes.Repeat([]byte{9}, 32))
+	if _, err := signer.SignSerializedTransaction(record, base64.StdEncoding.EncodeToString(other)); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error when the wallet is not a signer, got %v", err)
+	}
+	if _, err := signer.SignSerializedTransaction(record, base64.StdEncoding.EncodeToString([]byte{1, 2, 3})); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for a truncated transaction, got %v", err)
+	}
+}
+
+// testTransaction builds a transaction with empty signature slots for payer
+// and signer, a read-only program account and no instructions.
+func testTransaction(versioned bool, payer, signer []byte) ([]byte, []byte) {
+	var message []byte
+	if versioned {
+		message = append(message, 0x80)
+	}
+	message = append(message, 2, 0, 1, 3)
+	message = append(message, payer...)
+	message = append(message, signer...)
+	message = append(message, bytes.Repeat([]byte{1}, 32)...)
+	message = append(message, bytes.Repeat([]byte{2}, 32)...)
+	message = append(message, 0)
+	if versioned {
+		message = append(message, 0)
+	}
+
+	raw := append([]byte{2}, make([]byte, 128)...)
+	return append(raw, message...), message
+}
+
+func testKey(t *testing.T, privKeyHex string) ed25519.PrivateKey {
+	t.Helper()
+	seed, err := hex.DecodeString(privKeyHex)
+	if err != nil {
+		t.Fatalf("decode seed: %v", err)
+	}
+	return ed25519.NewKeyFromSeed(seed)
+}
@@ -0,0 +1,100 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	signatureLength = 64
+	publicKeyLength = 32
+)
+
+// wireTransaction is a legacy or v0 Solana transaction split into its
+// signature slots and the message they sign.
+type wireTransaction struct {
+	raw []byte
+	// signaturesAt is the offset of the first signature slot.
+	signaturesAt int
+	signatures   int
+	message      []byte
+	// signers are the account keys that must sign, in slot order.
+	signers [][]byte
+}
+
+func parseWireTransaction(raw []byte) (*wireTransaction, error) {
+	signatures, offset, err := readCompactU16(raw, 0)
+	if err != nil {
+		return nil, fmt.Errorf("read signature count: %w", err)
+	}
+	messageAt := offset + signatures*signatureLength
+	if signatures == 0 || messageAt >= len(raw) {
+		return nil, errors.New("transaction has no signatures or message")
+	}
+	message := raw[messageAt:]
+
+	header := 0
+	if message[0]&0x80 != 0 {
+		if version := message[0] & 0x7f; version != 0 {
+			return nil, fmt.Errorf("unsupported transaction version %d", version)
+		}
+		header = 1
+	}
+	if len(message) < header+3 {
+		return nil, errors.New("message header is truncated")
+	}
+	required := int(message[header])
+	if required != signatures {
+		return nil, fmt.Errorf("transaction has %d signature slots but requires %d", signatures, required)
+	}
+
+	accounts, keysAt, err := readCompactU16(message, header+3)
+	if err != nil {
+		return nil, fmt.Errorf("read account keys: %w", err)
+	}
+	if accounts < required || keysAt+accounts*publicKeyLength > len(message) {
+		return nil, errors.New("account keys are truncated")
+	}
+	signers := make([][]byte, required)
+	for i := range signers {
+		start := keysAt + i*publicKeyLength
+		signers[i] = message[start : start+publicKeyLength]
+	}
+
+	return &wireTransaction{
+		raw:          raw,
+		signaturesAt: offset,
+		signatures:   signatures,
+		message:      message,
+		signers:      signers,
+	}, nil
+}
+
+// setSignature writes signature into the slot of signer.
+func (tx *wireTransaction) setSignature(signer []byte, signature []byte) error {
+	for i, key := range tx.signers {
+		if string(key) == string(signer) {
+			start := tx.signaturesAt + i*signatureLength
+			copy(tx.raw[start:start+signatureLength], signature)
+			return nil
+		}
+	}
+	return errors.New("wallet is not a required signer of the transaction")
+}
+
+// readCompactU16 reads Solana's variable-length u16 at offset and returns it
+// with the offset that follows.
+func readCompactU16(data []byte, offset int) (int, int, error) {
+	value := 0
+	for i := 0; i < 3; i++ {
+		if offset+i >= len(data) {
+			return 0, 0, errors.New("unexpected end of data")
+		}
+		b := data[offset+i]
+		value |= int(b&0x7f) << (7 * i)
+		if b&0x80 == 0 {
+			return value, offset + i + 1, nil
+		}
+	}
+	return 0, 0, errors.New("compact-u16 is too long")
+}
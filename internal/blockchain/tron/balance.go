@@ -0,0 +1,101 @@
+package tron
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BalanceFetcher reads balances from a full node's HTTP API, such as the
+// one TronGrid serves.
+type BalanceFetcher struct {
+	client *http.Client
+}
+
+func NewBalanceFetcher() *BalanceFetcher {
+	return &BalanceFetcher{client: http.DefaultClient}
+}
+
+// FetchBalance returns the TRX balance of address in sun. Accounts that
+// have never received TRX do not exist yet and hold nothing.
+func (f *BalanceFetcher) FetchBalance(ctx context.Context, apiURL string, address string) (string, error) {
+	var account struct {
+		Balance int64 `json:"balance"`
+	}
+	if err := f.post(ctx, apiURL, "/wallet/getaccount", map[string]any{"address": address, "visible": true}, &account); err != nil {
+		return "", fmt.Errorf("get account: %w", err)
+	}
+	return strconv.FormatInt(account.Balance, 10), nil
+}
+
+// FetchTokenBalance returns the TRC-20 balance of address in the token's
+// base units, from a constant call to the contract's balanceOf.
+func (f *BalanceFetcher) FetchTokenBalance(ctx context.Context, apiURL string, address string, contract string) (string, error) {
+	owner, err := DecodeAddress(address)
+	if err != nil {
+		return "", err
+	}
+	if _, err := DecodeAddress(contract); err != nil {
+		return "", err
+	}
+
+	var result struct {
+		ConstantResult []string `json:"constant_result"`
+		Result         struct {
+			Result  bool   `json:"result"`
+			Message string `json:"message"`
+		} `json:"result"`
+	}
+	err = f.post(ctx, apiURL, "/wallet/triggerconstantcontract", map[string]any{
+		"owner_address":     address,
+		"contract_address":  contract,
+		"function_selector": "balanceOf(address)",
+		"parameter":         hex.EncodeToString(common.LeftPadBytes(owner.Bytes(), 32)),
+		"visible":           true,
+	}, &result)
+	if err != nil {
+		return "", fmt.Errorf("call balanceOf: %w", err)
+	}
+	if !result.Result.Result || len(result.ConstantResult) == 0 {
+		message, _ := hex.DecodeString(result.Result.Message)
+		return "", fmt.Errorf("call balanceOf: %s", message)
+	}
+	raw, err := hex.DecodeString(result.ConstantResult[0])
+	if err != nil {
+		return "", fmt.Errorf("decode balanceOf: %w", err)
+	}
+	return new(big.Int).SetBytes(raw).String(), nil
+}
+
+func (f *BalanceFetcher) post(ctx context.Context, apiURL string, path string, body any, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(apiURL, "/")+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
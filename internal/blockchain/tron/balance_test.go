@@ -0,0 +1,75 @@
+package tron
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	testAddress  = "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"
+	testContract = "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf"
+)
+
+func TestFetchBalanceReadsAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/wallet/getaccount" || body["address"] != testAddress || body["visible"] != true {
+			t.Errorf("unexpected request %s %v", r.URL.Path, body)
+		}
+		w.Write([]byte(`{"address":"` + testAddress + `","balance":2500000}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL+"/", testAddress)
+	if err != nil {
+		t.Fatalf("FetchBalance returned error: %v", err)
+	}
+	if balance != "2500000" {
+		t.Fatalf("expected 2500000 sun, got %s", balance)
+	}
+}
+
+func TestFetchBalanceOfNewAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchBalance(context.Background(), server.URL, testAddress)
+	if err != nil || balance != "0" {
+		t.Fatalf("expected a zero balance, got %q, %v", balance, err)
+	}
+}
+
+func TestFetchTokenBalanceCallsBalanceOf(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if r.URL.Path != "/wallet/triggerconstantcontract" || body["contract_address"] != testContract || body["function_selector"] != "balanceOf(address)" {
+			t.Errorf("unexpected request %s %v", r.URL.Path, body)
+		}
+		if body["parameter"] != "000000000000000000000000a614f803b6fd780986a42c78ec9c7f77e6ded13c" {
+			t.Errorf("unexpected parameter %v", body["parameter"])
+		}
+		w.Write([]byte(`{"result":{"result":true},"constant_result":["00000000000000000000000000000000000000000000000000000000004c4b40"]}`))
+	}))
+	defer server.Close()
+
+	balance, err := NewBalanceFetcher().FetchTokenBalance(context.Background(), server.URL, testAddress, testContract)
+	if err != nil {
+		t.Fatalf("FetchTokenBalance returned error: %v", err)
+	}
+	if balance != "5000000" {
+		t.Fatalf("expected 5000000, got %s", balance)
+	}
+}
+
+func TestFetchTokenBalanceRejectsBadContracts(t *testing.T) {
+	if _, err := NewBalanceFetcher().FetchTokenBalance(context.Background(), "http://unused", testAddress, "0xdAC17F958D2ee523a2206206994597C13D831ec7"); err == nil {
+		t.Fatal("expected an error for a non-tron contract address")
+	}
+}
@@ -0,0 +1,166 @@
+package tron
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/base58"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// addressVersion prefixes the 20-byte account hash in every Tron address.
+const addressVersion = 0x41
+
+// KeyGenerator creates secp256k1 keys, stored as hex like the Ethereum
+// signer stores them.
+type KeyGenerator interface {
+	NewWallet(network string) (*service.WalletRecord, error)
+}
+
+// Signer holds secp256k1 keys for Tron. An address is the Ethereum address
+// of the key behind the 0x41 prefix, in base58check. Signatures are the hex
+// 65-byte r||s||v with v of 27 or 28, as TronWeb produces them.
+type Signer struct {
+	keys KeyGenerator
+}
+
+func NewSigner(keys KeyGenerator) *Signer {
+	return &Signer{keys: keys}
+}
+
+func (s *Signer) NewWallet(network string, addressType service.AddressType) (*service.WalletRecord, error) {
+	if addressType != "" {
+		return nil, fmt.Errorf("%w: tron wallets do not have address types", service.ErrValidation)
+	}
+	record, err := s.keys.NewWallet(network)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.HexToECDSA(record.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	record.Address = EncodeAddress(crypto.PubkeyToAddress(key.PublicKey))
+	return record, nil
+}
+
+// SignMessage signs payload as TIP-191 describes, the scheme of TronWeb's
+// signMessageV2: keccak256 of "\x19TRON Signed Message:\n", the payload's
+// length and the payload.
+func (s *Signer) SignMessage(record *service.WalletRecord, payload []byte) (*service.SignatureOutput, error) {
+	key, err := loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+	prefix := "\x19TRON Signed Message:\n" + strconv.Itoa(len(payload))
+	signature, err := sign(key, crypto.Keccak256([]byte(prefix), payload))
+	if err != nil {
+		return nil, err
+	}
+	return &service.SignatureOutput{
+		Signature: "0x" + hex.EncodeToString(signature),
+		PublicKey: record.PublicKey,
+	}, nil
+}
+
+// SignSerializedTransaction signs the hex raw_data of a transaction, as the
+// full node returns it in raw_data_hex. The signature is over the txID, the
+// SHA-256 of raw_data, and the result is the hex Transaction that
+// /wallet/broadcasthex accepts.
+func (s *Signer) SignSerializedTransaction(record *service.WalletRecord, tx string) (*service.SerializedTransactionResult, error) {
+	key, err := loadKey(record)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := hex.DecodeString(strings.TrimPrefix(tx, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: raw_data is not hex", service.ErrValidation)
+	}
+	if err := checkRawData(raw); err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrValidation, err)
+	}
+
+	txID := sha256.Sum256(raw)
+	signature, err := sign(key, txID[:])
+	if err != nil {
+		return nil, err
+	}
+	var signed []byte
+	signed = protowire.AppendTag(signed, 1, protowire.BytesType)
+	signed = protowire.AppendBytes(signed, raw)
+	signed = protowire.AppendTag(signed, 2, protowire.BytesType)
+	signed = protowire.AppendBytes(signed, signature)
+	return &service.SerializedTransactionResult{
+		Transaction: hex.EncodeToString(signed),
+		Signature:   hex.EncodeToString(signature),
+		TxID:        hex.EncodeToString(txID[:]),
+	}, nil
+}
+
+// EncodeAddress returns the base58check Tron address of an account.
+func EncodeAddress(address common.Address) string {
+	return base58.CheckEncode(address.Bytes(), addressVersion)
+}
+
+// DecodeAddress returns the account behind a base58check Tron address.
+func DecodeAddress(address string) (common.Address, error) {
+	decoded, version, err := base58.CheckDecode(address)
+	if err != nil || version != addressVersion || len(decoded) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("%w: invalid tron address %q", service.ErrValidation, address)
+	}
+	return common.BytesToAddress(decoded), nil
+}
+
+func loadKey(record *service.WalletRecord) (*ecdsa.PrivateKey, error) {
+	key, err := crypto.HexToECDSA(record.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode private key: %w", err)
+	}
+	if EncodeAddress(crypto.PubkeyToAddress(key.PublicKey)) != record.Address {
+		return nil, fmt.Errorf("private key does not match address %s", record.Address)
+	}
+	return key, nil
+}
+
+func sign(key *ecdsa.PrivateKey, digest []byte) ([]byte, error) {
+	signature, err := crypto.Sign(digest, key)
+	if err != nil {
+		return nil, fmt.Errorf("sign digest: %w", err)
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// checkRawData rejects bytes that are not a protobuf Transaction.raw with
+// at least one contract, field 11.
+func checkRawData(raw []byte) error {
+	contracts := 0
+	for len(raw) > 0 {
+		num, typ, n := protowire.ConsumeTag(raw)
+		if n < 0 {
+			return fmt.Errorf("decode raw_data: %w", protowire.ParseError(n))
+		}
+		raw = raw[n:]
+		n = protowire.ConsumeFieldValue(num, typ, raw)
+		if n < 0 {
+			return fmt.Errorf("decode raw_data field %d: %w", num, protowire.ParseError(n))
+		}
+		raw = raw[n:]
+		if num == 11 && typ == protowire.BytesType {
+			contracts++
+		}
+	}
+	if contracts == 0 {
+		return errors.New("raw_data has no contract")
+	}
+	return nil
+}
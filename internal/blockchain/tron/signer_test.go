@@ -0,0 +1,130 @@
+package tron
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func testRecord(t *testing.T) *service.WalletRecord {
+	t.Helper()
+	record, err := NewSigner(ethereum.NewSigner()).NewWallet("tron-nile", "")
+	if err != nil {
+		t.Fatalf("NewWallet returned error: %v", err)
+	}
+	return record
+}
+
+func TestAddressEncoding(t *testing.T) {
+	// The USDT contract on mainnet.
+	account := common.HexToAddress("0xa614f803b6fd780986a42c78ec9c7f77e6ded13c")
+	if got := EncodeAddress(account); got != "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t" {
+		t.Fatalf("EncodeAddress = %s", got)
+	}
+	decoded, err := DecodeAddress("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t")
+	if err != nil || decoded != account {
+		t.Fatalf("DecodeAddress = %s, %v", decoded.Hex(), err)
+	}
+	if _, err := DecodeAddress("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6u"); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected a checksum error, got %v", err)
+	}
+}
+
+func TestNewWalletDerivesTronAddress(t *testing.T) {
+	record := testRecord(t)
+	key, err := crypto.HexToECDSA(record.PrivKey)
+	if err != nil {
+		t.Fatalf("decode private key: %v", err)
+	}
+	if record.Address[0] != 'T' || record.Address != EncodeAddress(crypto.PubkeyToAddress(key.PublicKey)) {
+		t.Fatalf("unexpected address %s", record.Address)
+	}
+
+	if _, err := NewSigner(ethereum.NewSigner()).NewWallet("tron-nile", service.AddressTypeP2TR); !errors.Is(err, service.ErrValidation) {
+		t.Fatalf("expected validation error for an address type, got %v", err)
+	}
+}
+
+func TestSignMessageFollowsTIP191(t *testing.T) {
+	record := testRecord(t)
+	message := []byte("hello tron")
+	output, err := NewSigner(ethereum.NewSigner()).SignMessage(record, message)
+	if err != nil {
+		t.Fatalf("SignMessage returned error: %v", err)
+	}
+
+	digest := crypto.Keccak256([]byte("\x19TRON Signed Message:\n"+strconv.Itoa(len(message))), message)
+	if got := recoverAddress(t, digest, output.Signature[2:]); got != record.Address {
+		t.Fatalf("signature recovers %s, want %s", got, record.Address)
+	}
+}
+
+func TestSignSerializedTransactionSignsTxID(t *testing.T) {
+	record := testRecord(t)
+	var raw []byte
+	raw = protowire.AppendTag(raw, 1, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, []byte{0x12, 0x34})
+	raw = protowire.AppendTag(raw, 8, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, 1700000000000)
+	raw = protowire.AppendTag(raw, 11, protowire.BytesType)
+	raw = protowire.AppendBytes(raw, []byte("contract"))
+
+	result, err := NewSigner(ethereum.NewSigner()).SignSerializedTransaction(record, hex.EncodeToString(raw))
+	if err != nil {
+		t.Fatalf("SignSerializedTransaction returned error: %v", err)
+	}
+	txID := sha256.Sum256(raw)
+	if result.TxID != hex.EncodeToString(txID[:]) {
+		t.Fatalf("unexpected txID %s", result.TxID)
+	}
+	if got := recoverAddress(t, txID[:], result.Signature); got != record.Address {
+		t.Fatalf("signature recovers %s, want %s", got, record.Address)
+	}
+
+	signature, _ := hex.DecodeString(result.Signature)
+	var want []byte
+	want = protowire.AppendTag(want, 1, protowire.BytesType)
+	want = protowire.AppendBytes(want, raw)
+	want = protowire.AppendTag(want, 2, protowire.BytesType)
+	want = protowire.AppendBytes(want, signature)
+	if result.Transaction != hex.EncodeToString(want) {
+		t.Fatalf("unexpected transaction %s", result.Transaction)
+	}
+}
+
+func TestSignSerializedTransactionChecksRawData(t *testing.T) {
+	record := testRecord(t)
+	cases := map[string]string{
+		"not hex":     "zz",
+		"truncated":   "0a05",
+		"no contract": "0a021234",
+	}
+	for name, tx := range cases {
+		if _, err := NewSigner(ethereum.NewSigner()).SignSerializedTransaction(record, tx); !errors.Is(err, service.ErrValidation) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
+
+func recoverAddress(t *testing.T, digest []byte, signatureHex string) string {
+	t.Helper()
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil || len(signature) != 65 || signature[64] < 27 {
+		t.Fatalf("expected a hex 65-byte signature with v of 27 or 28, got %q", signatureHex)
+	}
+	signature[64] -= 27
+	pub, err := crypto.SigToPub(digest, signature)
+	if err != nil {
+		t.Fatalf("recover public key: %v", err)
+	}
+	return EncodeAddress(crypto.PubkeyToAddress(*pub))
+}
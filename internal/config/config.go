@@ -3,31 +3,132 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 )
 
 const (
-	defaultHTTPPort       = "8080"
-	defaultGRPCPort       = "9090"
-	defaultEnv            = "local"
-	defaultBaseSepoliaRPC = "https://sepolia.base.org"
-	defaultEthSepoliaRPC  = "https://ethereum-sepolia.blockpi.network/v1/rpc/public"
+	defaultHTTPPort          = "8080"
+	defaultGRPCPort          = "9090"
+	defaultEnv               = "local"
+	defaultBaseSepoliaRPC    = "https://sepolia.base.org"
+	defaultEthSepoliaRPC     = "https://ethereum-sepolia.blockpi.network/v1/rpc/public"
+	defaultSolanaDevnetRPC   = "https://api.devnet.solana.com"
+	defaultBitcoinTestnetAPI = "https://mempool.space/testnet/api"
+	defaultBitcoinSignetAPI  = "https://mempool.space/signet/api"
+	defaultOsmosisTestnetAPI = "https://lcd.osmotest5.osmosis.zone"
+	defaultTronNileAPI       = "https://nile.trongrid.io"
 )
 
+// DefaultDeletionGracePeriod is how long a deleted wallet can still be
+// restored before its key is removed from the wallet record.
+const DefaultDeletionGracePeriod = 24 * time.Hour
+
 type AppConfig struct {
 	HTTPPort string
 	GRPCPort string
 	Env      string
 
+	// DeletionGracePeriod is how long a deleted wallet keeps its key before
+	// the key is removed from the wallet record.
+	DeletionGracePeriod time.Duration
+
+	// AuditLogPath points at the append-only audit file. Entries are kept in
+	// memory when it is empty.
+	AuditLogPath string
+
+	// BootstrapAdminKey, when set, is registered as an admin-scoped API key at
+	// startup so the first keys can be issued through the API.
+	BootstrapAdminKey string
+
+	// SimulateBeforeSigning runs every transaction through eth_call first and
+	// refuses to sign ones that revert unless the request sets force.
+	SimulateBeforeSigning bool
+
+	// JWT enables end-user bearer tokens when a JWKS file or URL is set.
+	JWT JWTConfig
+
+	// NetworksFile is a YAML or JSON list of networks in chainlist form.
+	// Its networks are added to the built-in ones, replacing those with the
+	// same key.
+	NetworksFile string
+
+	// NetworkStorePath is the JSON file networks added or disabled through
+	// the API are kept in. They last until restart when it is empty.
+	NetworkStorePath string
+
 	Networks map[string]NetworkConfig
 }
 
+type JWTConfig struct {
+	JWKSFile     string
+	JWKSURL      string
+	Issuer       string
+	Audience     string
+	TenantClaim  string
+	WalletsClaim string
+	// AllowAdminScope accepts the admin scope from user tokens.
+	AllowAdminScope bool
+}
+
+// Enabled reports whether a key source has been configured.
+func (c JWTConfig) Enabled() bool {
+	return c.JWKSFile != "" || c.JWKSURL != ""
+}
+
 type NetworkConfig struct {
 	Name        string
 	ChainID     int64
 	RPCURL      string
 	NativeAsset string
+	// Decimals is the number of decimals of the native asset.
+	Decimals    uint8
+	ExplorerURL string
+	// Disabled networks serve their existing wallets but accept no new
+	// ones.
+	Disabled bool
+	// Family is the chain family, "ethereum" (the default), "solana",
+	// "bitcoin", "cosmos" or "tron". Bitcoin networks read balances from the
+	// Esplora API at RPCURL, Cosmos networks from the REST API there, in the
+	// NativeAsset denomination, and Tron networks from the full node's HTTP
+	// API.
+	Family string
+	// CosmosChainID is the chain ID Cosmos sign docs must name.
+	CosmosChainID string
+	// Bech32Prefix is the human-readable part of Cosmos addresses.
+	Bech32Prefix string
+	// BitcoinChain selects the chain parameters of Bitcoin networks:
+	// "mainnet", "testnet3", "signet" or "regtest".
+	BitcoinChain string
+	// BundlerURL is the ERC-4337 bundler RPC that user operations are
+	// submitted to. Submission is disabled when it is empty.
+	BundlerURL string
+	// Tokens maps upper-case symbols to the ERC-20 contracts known on the
+	// network, or the TRC-20 contracts on Tron.
+	Tokens map[string]TokenConfig
+	// AccountFactories maps names to the CREATE2 factories smart account
+	// wallets can be created with.
+	AccountFactories map[string]AccountFactoryConfig
 }
 
+type TokenConfig struct {
+	Address  string `json:"address"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// AccountFactoryConfig describes a smart account factory. InitCodeHash is
+// the keccak256 of the account proxy's creation code, which the factory
+// deploys unchanged for every owner. EntryPoint is "v0.6" or "v0.7".
+type AccountFactoryConfig struct {
+	Address      string
+	InitCodeHash string
+	EntryPoint   string
+}
+
+// DefaultAccountFactory is the name given to the factory configured through
+// the <NETWORK>_ACCOUNT_FACTORY environment variables.
+const DefaultAccountFactory = "default"
+
 func (c *AppConfig) Lookup(key string) (*NetworkConfig, error) {
 	network, ok := c.Networks[key]
 	if !ok {
@@ -37,26 +138,133 @@ func (c *AppConfig) Lookup(key string) (*NetworkConfig, error) {
 }
 
 func Load() (*AppConfig, error) {
+	gracePeriod, err := getDurationEnv("WALLET_DELETION_GRACE_PERIOD", DefaultDeletionGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	simulate, err := getBoolEnv("SIMULATE_BEFORE_SIGNING", false)
+	if err != nil {
+		return nil, err
+	}
+	jwtAdmin, err := getBoolEnv("JWT_ALLOW_ADMIN_SCOPE", false)
+	if err != nil {
+		return nil, err
+	}
+
 	cfg := &AppConfig{
 		HTTPPort: getEnv("HTTP_PORT", defaultHTTPPort),
 		GRPCPort: getEnv("GRPC_PORT", defaultGRPCPort),
 		Env:      getEnv("APP_ENV", defaultEnv),
+
+		DeletionGracePeriod: gracePeriod,
+		AuditLogPath:        os.Getenv("AUDIT_LOG_PATH"),
+		BootstrapAdminKey:   os.Getenv("BOOTSTRAP_ADMIN_KEY"),
+
+		SimulateBeforeSigning: simulate,
+
+		NetworksFile:     os.Getenv("NETWORKS_FILE"),
+		NetworkStorePath: os.Getenv("NETWORK_STORE_PATH"),
+
+		JWT: JWTConfig{
+			JWKSFile:        os.Getenv("JWT_JWKS_FILE"),
+			JWKSURL:         os.Getenv("JWT_JWKS_URL"),
+			Issuer:          os.Getenv("JWT_ISSUER"),
+			Audience:        os.Getenv("JWT_AUDIENCE"),
+			TenantClaim:     getEnv("JWT_TENANT_CLAIM", "tenant"),
+			WalletsClaim:    getEnv("JWT_WALLETS_CLAIM", "wallet_ids"),
+			AllowAdminScope: jwtAdmin,
+		},
+
 		Networks: map[string]NetworkConfig{
 			"base-sepolia": {
-				Name:        "Base Sepolia",
-				ChainID:     84532,
-				RPCURL:      getEnv("BASE_SEPOLIA_RPC_URL", defaultBaseSepoliaRPC),
-				NativeAsset: "ETH",
+				Name:             "Base Sepolia",
+				Family:           "ethereum",
+				ChainID:          84532,
+				RPCURL:           getEnv("BASE_SEPOLIA_RPC_URL", defaultBaseSepoliaRPC),
+				NativeAsset:      "ETH",
+				Decimals:         18,
+				ExplorerURL:      "https://sepolia.basescan.org",
+				BundlerURL:       os.Getenv("BASE_SEPOLIA_BUNDLER_URL"),
+				AccountFactories: accountFactoriesFromEnv("BASE_SEPOLIA"),
+				Tokens: map[string]TokenConfig{
+					"USDC": {Address: "0x036CbD53842c5426634e7929541eC2318f3dCF7e", Decimals: 6},
+				},
 			},
 			"eth-sepolia": {
-				Name:        "Ethereum Sepolia",
-				ChainID:     11155111,
-				RPCURL:      getEnv("ETH_SEPOLIA_RPC_URL", defaultEthSepoliaRPC),
-				NativeAsset: "ETH",
+				Name:             "Ethereum Sepolia",
+				Family:           "ethereum",
+				ChainID:          11155111,
+				RPCURL:           getEnv("ETH_SEPOLIA_RPC_URL", defaultEthSepoliaRPC),
+				NativeAsset:      "ETH",
+				Decimals:         18,
+				ExplorerURL:      "https://sepolia.etherscan.io",
+				BundlerURL:       os.Getenv("ETH_SEPOLIA_BUNDLER_URL"),
+				AccountFactories: accountFactoriesFromEnv("ETH_SEPOLIA"),
+				Tokens: map[string]TokenConfig{
+					"USDC": {Address: "0x1c7D4B196Cb0C7B01d743Fbc6116a902379C7238", Decimals: 6},
+				},
+			},
+			"solana-devnet": {
+				Name:        "Solana Devnet",
+				Family:      "solana",
+				RPCURL:      getEnv("SOLANA_DEVNET_RPC_URL", defaultSolanaDevnetRPC),
+				NativeAsset: "SOL",
+				Decimals:    9,
+				ExplorerURL: "https://explorer.solana.com/?cluster=devnet",
+			},
+			"bitcoin-testnet": {
+				Name:         "Bitcoin Testnet",
+				Family:       "bitcoin",
+				BitcoinChain: "testnet3",
+				RPCURL:       getEnv("BITCOIN_TESTNET_API_URL", defaultBitcoinTestnetAPI),
+				NativeAsset:  "BTC",
+				Decimals:     8,
+				ExplorerURL:  "https://mempool.space/testnet",
+			},
+			"bitcoin-signet": {
+				Name:         "Bitcoin Signet",
+				Family:       "bitcoin",
+				BitcoinChain: "signet",
+				RPCURL:       getEnv("BITCOIN_SIGNET_API_URL", defaultBitcoinSignetAPI),
+				NativeAsset:  "BTC",
+				Decimals:     8,
+				ExplorerURL:  "https://mempool.space/signet",
+			},
+			"osmosis-testnet": {
+				Name:          "Osmosis Testnet",
+				Family:        "cosmos",
+				CosmosChainID: "osmo-test-5",
+				Bech32Prefix:  "osmo",
+				RPCURL:        getEnv("OSMOSIS_TESTNET_API_URL", defaultOsmosisTestnetAPI),
+				NativeAsset:   "uosmo",
+				Decimals:      6,
+				ExplorerURL:   "https://www.mintscan.io/osmosis-testnet",
+			},
+			"tron-nile": {
+				Name:        "Tron Nile Testnet",
+				Family:      "tron",
+				RPCURL:      getEnv("TRON_NILE_API_URL", defaultTronNileAPI),
+				NativeAsset: "TRX",
+				Decimals:    6,
+				ExplorerURL: "https://nile.tronscan.org",
+				Tokens: map[string]TokenConfig{
+					"USDT": {Address: "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf", Decimals: 6},
+				},
 			},
 		},
 	}
 
+	if cfg.NetworksFile != "" {
+		networks, err := LoadNetworksFile(cfg.NetworksFile)
+		if err != nil {
+			return nil, err
+		}
+		for key, network := range networks {
+			cfg.Networks[key] = network
+		}
+	}
+
 	if cfg.Networks["base-sepolia"].RPCURL == "" {
 		return nil, fmt.Errorf("missing RPC URL for base-sepolia")
 	}
@@ -74,3 +282,44 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+// accountFactoriesFromEnv reads <prefix>_ACCOUNT_FACTORY,
+// <prefix>_ACCOUNT_FACTORY_INIT_CODE_HASH and
+// <prefix>_ACCOUNT_FACTORY_ENTRY_POINT into the default factory.
+func accountFactoriesFromEnv(prefix string) map[string]AccountFactoryConfig {
+	address := os.Getenv(prefix + "_ACCOUNT_FACTORY")
+	if address == "" {
+		return nil
+	}
+	return map[string]AccountFactoryConfig{
+		DefaultAccountFactory: {
+			Address:      address,
+			InitCodeHash: os.Getenv(prefix + "_ACCOUNT_FACTORY_INIT_CODE_HASH"),
+			EntryPoint:   getEnv(prefix+"_ACCOUNT_FACTORY_ENTRY_POINT", "v0.7"),
+		},
+	}
+}
+
+func getDurationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return d, nil
+}
+
+func getBoolEnv(key string, fallback bool) (bool, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return b, nil
+}
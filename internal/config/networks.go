@@ -0,0 +1,189 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var networkKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// families lists the chain families a network may belong to.
+var families = map[string]bool{"ethereum": true, "solana": true, "bitcoin": true, "cosmos": true, "tron": true}
+
+// bitcoinChains lists the chains a Bitcoin network may declare.
+var bitcoinChains = map[string]bool{"mainnet": true, "testnet3": true, "signet": true, "regtest": true}
+
+// ChainlistNetwork is a network in the format of chainlist's chains.json,
+// with the fields walletsdk needs on top of it. Entries copied from
+// chainlist only need a network key and, off Ethereum, a family.
+type ChainlistNetwork struct {
+	// Network is the key wallets are created with. It defaults to ShortName.
+	Network   string `json:"network,omitempty"`
+	Name      string `json:"name"`
+	ShortName string `json:"shortName,omitempty"`
+	ChainID   int64  `json:"chainId,omitempty"`
+	// RPC lists endpoints in order of preference. ${VAR} references are
+	// filled in from the environment. The first HTTP URL whose variables are
+	// all set is used, so websocket and keyed endpoints are skipped.
+	RPC            []string       `json:"rpc"`
+	NativeCurrency NativeCurrency `json:"nativeCurrency"`
+	Explorers      []Explorer     `json:"explorers,omitempty"`
+
+	Family        string                 `json:"family,omitempty"`
+	CosmosChainID string                 `json:"cosmosChainId,omitempty"`
+	Bech32Prefix  string                 `json:"bech32Prefix,omitempty"`
+	BitcoinChain  string                 `json:"bitcoinChain,omitempty"`
+	BundlerURL    string                 `json:"bundlerUrl,omitempty"`
+	Tokens        map[string]TokenConfig `json:"tokens,omitempty"`
+	Disabled      bool                   `json:"disabled,omitempty"`
+}
+
+// NativeCurrency is the network's native asset. On Cosmos networks Symbol
+// is the denomination balances are read in, such as "uosmo".
+type NativeCurrency struct {
+	Name     string `json:"name,omitempty"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+type Explorer struct {
+	Name     string `json:"name,omitempty"`
+	URL      string `json:"url"`
+	Standard string `json:"standard,omitempty"`
+}
+
+// Key returns the key wallets name the network by.
+func (n ChainlistNetwork) Key() string {
+	if key := strings.TrimSpace(n.Network); key != "" {
+		return key
+	}
+	return strings.TrimSpace(n.ShortName)
+}
+
+// NetworkConfig validates the entry and converts it to the configuration
+// the registry serves.
+func (n ChainlistNetwork) NetworkConfig() (NetworkConfig, error) {
+	key := n.Key()
+	if !networkKeyPattern.MatchString(key) {
+		return NetworkConfig{}, fmt.Errorf("network %q must be lowercase alphanumeric or dashes", key)
+	}
+	family := strings.ToLower(strings.TrimSpace(n.Family))
+	if family == "" {
+		family = "ethereum"
+	}
+	if !families[family] {
+		return NetworkConfig{}, fmt.Errorf("network %s: unknown family %q", key, n.Family)
+	}
+	if family == "ethereum" && n.ChainID <= 0 {
+		return NetworkConfig{}, fmt.Errorf("network %s: chainId is required", key)
+	}
+	if family == "cosmos" && (n.CosmosChainID == "" || n.Bech32Prefix == "") {
+		return NetworkConfig{}, fmt.Errorf("network %s: cosmosChainId and bech32Prefix are required", key)
+	}
+	if family == "bitcoin" && !bitcoinChains[n.BitcoinChain] {
+		return NetworkConfig{}, fmt.Errorf("network %s: bitcoinChain must be mainnet, testnet3, signet or regtest", key)
+	}
+	if strings.TrimSpace(n.NativeCurrency.Symbol) == "" {
+		return NetworkConfig{}, fmt.Errorf("network %s: nativeCurrency.symbol is required", key)
+	}
+	rpcURL := firstRPCURL(n.RPC)
+	if rpcURL == "" {
+		return NetworkConfig{}, fmt.Errorf("network %s: no usable rpc url", key)
+	}
+
+	name := strings.TrimSpace(n.Name)
+	if name == "" {
+		name = key
+	}
+	cfg := NetworkConfig{
+		Name:          name,
+		Family:        family,
+		ChainID:       n.ChainID,
+		CosmosChainID: n.CosmosChainID,
+		Bech32Prefix:  n.Bech32Prefix,
+		BitcoinChain:  n.BitcoinChain,
+		RPCURL:        rpcURL,
+		NativeAsset:   strings.TrimSpace(n.NativeCurrency.Symbol),
+		Decimals:      n.NativeCurrency.Decimals,
+		BundlerURL:    n.BundlerURL,
+		Disabled:      n.Disabled,
+	}
+	if len(n.Tokens) > 0 {
+		cfg.Tokens = make(map[string]TokenConfig, len(n.Tokens))
+		for symbol, token := range n.Tokens {
+			cfg.Tokens[strings.ToUpper(symbol)] = token
+		}
+	}
+	if len(n.Explorers) > 0 {
+		cfg.ExplorerURL = n.Explorers[0].URL
+	}
+	return cfg, nil
+}
+
+// ParseNetworks reads a list of networks from YAML or JSON, which YAML
+// includes.
+func ParseNetworks(data []byte) ([]ChainlistNetwork, error) {
+	var raw any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parse networks: %w", err)
+	}
+	// Going through JSON lets the entries share one set of field tags.
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("parse networks: %w", err)
+	}
+	var networks []ChainlistNetwork
+	if err := json.Unmarshal(encoded, &networks); err != nil {
+		return nil, fmt.Errorf("parse networks: %w", err)
+	}
+	return networks, nil
+}
+
+// LoadNetworksFile reads the networks in the file at path, keyed by their
+// network key.
+func LoadNetworksFile(path string) (map[string]NetworkConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read networks file: %w", err)
+	}
+	entries, err := ParseNetworks(data)
+	if err != nil {
+		return nil, err
+	}
+
+	networks := make(map[string]NetworkConfig, len(entries))
+	for _, entry := range entries {
+		cfg, err := entry.NetworkConfig()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := networks[entry.Key()]; ok {
+			return nil, fmt.Errorf("network %s is listed twice", entry.Key())
+		}
+		networks[entry.Key()] = cfg
+	}
+	return networks, nil
+}
+
+func firstRPCURL(urls []string) string {
+	for _, url := range urls {
+		missing := false
+		expanded := os.Expand(url, func(name string) string {
+			value, ok := os.LookupEnv(name)
+			if !ok || value == "" {
+				missing = true
+			}
+			return value
+		})
+		expanded = strings.TrimSpace(expanded)
+		if !missing && (strings.HasPrefix(expanded, "https://") || strings.HasPrefix(expanded, "http://")) {
+			return expanded
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testNetworksYAML = `
+- name: Arbitrum Sepolia
+  chain: ETH
+  network: arbitrum-sepolia
+  shortName: arb-sep
+  chainId: 421614
+  rpc:
+    - https://arbitrum-sepolia.infura.io/v3/${WALLETSDK_TEST_INFURA_KEY}
+    - wss://arbitrum-sepolia-rpc.publicnode.com
+    - https://sepolia-rollup.arbitrum.io/rpc
+  nativeCurrency: {name: Sepolia Ether, symbol: ETH, decimals: 18}
+  explorers:
+    - {name: Arbiscan, url: "https://sepolia.arbiscan.io", standard: EIP3091}
+  tokens:
+    usdc: {address: "0x75faf114eafb1BDbe2F0316DF893fd58CE46AA4d", decimals: 6}
+- name: Cosmos Hub Testnet
+  shortName: theta
+  family: cosmos
+  cosmosChainId: theta-testnet-001
+  bech32Prefix: cosmos
+  rpc: [https://rest.sentry-01.theta-testnet.polypore.xyz]
+  nativeCurrency: {symbol: uatom, decimals: 6}
+`
+
+func TestParseNetworksReadsChainlistYAML(t *testing.T) {
+	entries, err := ParseNetworks([]byte(testNetworksYAML))
+	if err != nil {
+		t.Fatalf("ParseNetworks returned error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].Key() != "arbitrum-sepolia" || entries[1].Key() != "theta" {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+
+	arbitrum, err := entries[0].NetworkConfig()
+	if err != nil {
+		t.Fatalf("NetworkConfig returned error: %v", err)
+	}
+	if arbitrum.RPCURL != "https://sepolia-rollup.arbitrum.io/rpc" {
+		t.Fatalf("expected the keyed and websocket endpoints to be skipped, got %s", arbitrum.RPCURL)
+	}
+	if arbitrum.Family != "ethereum" || arbitrum.ChainID != 421614 || arbitrum.NativeAsset != "ETH" || arbitrum.Decimals != 18 {
+		t.Fatalf("unexpected network %+v", arbitrum)
+	}
+	if arbitrum.ExplorerURL != "https://sepolia.arbiscan.io" || arbitrum.Tokens["USDC"].Decimals != 6 {
+		t.Fatalf("unexpected explorer or tokens %+v", arbitrum)
+	}
+
+	t.Setenv("WALLETSDK_TEST_INFURA_KEY", "secret")
+	arbitrum, _ = entries[0].NetworkConfig()
+	if arbitrum.RPCURL != "https://arbitrum-sepolia.infura.io/v3/secret" {
+		t.Fatalf("expected the keyed endpoint once its variable is set, got %s", arbitrum.RPCURL)
+	}
+
+	theta, err := entries[1].NetworkConfig()
+	if err != nil {
+		t.Fatalf("NetworkConfig returned error: %v", err)
+	}
+	if theta.Family != "cosmos" || theta.Bech32Prefix != "cosmos" || theta.NativeAsset != "uatom" {
+		t.Fatalf("unexpected network %+v", theta)
+	}
+}
+
+func TestNetworkConfigValidates(t *testing.T) {
+	valid := ChainlistNetwork{
+		Network:        "example",
+		ChainID:        1,
+		RPC:            []string{"https://rpc.example"},
+		NativeCurrency: NativeCurrency{Symbol: "ETH", Decimals: 18},
+	}
+	cases := map[string]func(n *ChainlistNetwork){
+		"bad key":        func(n *ChainlistNetwork) { n.Network = "Example Net" },
+		"unknown family": func(n *ChainlistNetwork) { n.Family = "aptos" },
+		"no chain id":    func(n *ChainlistNetwork) { n.ChainID = 0 },
+		"cosmos prefix":  func(n *ChainlistNetwork) { n.Family = "cosmos"; n.CosmosChainID = "test-1" },
+		"bitcoin chain":  func(n *ChainlistNetwork) { n.Family = "bitcoin"; n.BitcoinChain = "testnet9" },
+		"no symbol":      func(n *ChainlistNetwork) { n.NativeCurrency.Symbol = "" },
+		"no rpc":         func(n *ChainlistNetwork) { n.RPC = []string{"wss://rpc.example"} },
+	}
+	for name, mutate := range cases {
+		n := valid
+		mutate(&n)
+		if _, err := n.NetworkConfig(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := valid.NetworkConfig(); err != nil {
+		t.Fatalf("NetworkConfig returned error: %v", err)
+	}
+}
+
+func TestLoadNetworksFileRejectsDuplicates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "networks.json")
+	entry := `{"network":"example","chainId":1,"rpc":["https://rpc.example"],"nativeCurrency":{"symbol":"ETH","decimals":18}}`
+	if err := os.WriteFile(path, []byte("["+entry+","+entry+"]"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadNetworksFile(path); err == nil || !strings.Contains(err.Error(), "twice") {
+		t.Fatalf("expected a duplicate error, got %v", err)
+	}
+}
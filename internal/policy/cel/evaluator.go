@@ -0,0 +1,298 @@
+// Package cel evaluates signing policy expressions written in the Common
+// Expression Language.
+//
+// Expressions see the following variables:
+//
+//	wallet   {id, network, address, status}
+//	tenant   {id, name, allowedNetworks, maxWallets}
+//	tx       {chainId, from, to, value, data, gasLimit, gasPrice, nonce}
+//	calldata {selector, args, method, signature, params}
+//	spend    {lastHour, lastDay, lastWeek}
+//	now      timestamp of the evaluation
+//	hour     hour of day in UTC, 0-23
+//	weekday  day of week in UTC, 0 (Sunday) to 6
+//
+// Addresses, selectors and calldata words are lower-case 0x hex. calldata
+// params are the decoded arguments of known methods, by name, as strings.
+//
+// Amounts are wei as doubles, so values above 2^53 wei compare
+// approximately. tx.valueWei and spend.lastHourWei, lastDayWei and
+// lastWeekWei hold them exactly as decimal strings, for use with
+//
+//	compareAmounts(a, b) -1, 0 or 1 as a is less than, equal to or greater than b
+//	addAmounts(a, b)     the sum of a and b
+//
+// which take and return decimal integer strings of any size.
+package cel
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"sync"
+
+	gocel "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// Wallet is the wallet being signed for.
+type Wallet struct {
+	ID      string `cel:"id"`
+	Network string `cel:"network"`
+	Address string `cel:"address"`
+	Status  string `cel:"status"`
+}
+
+// Tenant is the wallet's tenant.
+type Tenant struct {
+	ID              string   `cel:"id"`
+	Name            string   `cel:"name"`
+	AllowedNetworks []string `cel:"allowedNetworks"`
+	MaxWallets      int64    `cel:"maxWallets"`
+}
+
+// Transaction is the transaction being signed.
+type Transaction struct {
+	ChainID  int64   `cel:"chainId"`
+	From     string  `cel:"from"`
+	To       string  `cel:"to"`
+	Value    float64 `cel:"value"`
+	ValueWei string  `cel:"valueWei"`
+	Data     string  `cel:"data"`
+	GasLimit int64   `cel:"gasLimit"`
+	GasPrice float64 `cel:"gasPrice"`
+	Nonce    int64   `cel:"nonce"`
+	// Delegates are the EIP-7702 delegation targets of a set-code
+	// transaction.
+	Delegates []string `cel:"delegates"`
+}
+
+// Calldata is the transaction data split into selector and argument words,
+// with the decoded arguments when the method is known.
+type Calldata struct {
+	Selector  string            `cel:"selector"`
+	Args      []string          `cel:"args"`
+	Method    string            `cel:"method"`
+	Signature string            `cel:"signature"`
+	Params    map[string]string `cel:"params"`
+}
+
+// Spend holds the wallet's signed value over rolling windows.
+type Spend struct {
+	LastHour    float64 `cel:"lastHour"`
+	LastDay     float64 `cel:"lastDay"`
+	LastWeek    float64 `cel:"lastWeek"`
+	LastHourWei string  `cel:"lastHourWei"`
+	LastDayWei  string  `cel:"lastDayWei"`
+	LastWeekWei string  `cel:"lastWeekWei"`
+}
+
+// Evaluator compiles expressions once and caches the resulting programs.
+type Evaluator struct {
+	env *gocel.Env
+
+	mu       sync.RWMutex
+	programs map[string]gocel.Program
+}
+
+var _ service.ExpressionEvaluator = (*Evaluator)(nil)
+
+func NewEvaluator() (*Evaluator, error) {
+	env, err := gocel.NewEnv(
+		ext.NativeTypes(
+			reflect.TypeOf(Wallet{}),
+			reflect.TypeOf(Tenant{}),
+			reflect.TypeOf(Transaction{}),
+			reflect.TypeOf(Calldata{}),
+			reflect.TypeOf(Spend{}),
+			ext.ParseStructTags(true),
+		),
+		ext.Strings(),
+		gocel.CrossTypeNumericComparisons(true),
+		gocel.Variable("wallet", gocel.ObjectType("cel.Wallet")),
+		gocel.Variable("tenant", gocel.ObjectType("cel.Tenant")),
+		gocel.Variable("tx", gocel.ObjectType("cel.Transaction")),
+		gocel.Variable("calldata", gocel.ObjectType("cel.Calldata")),
+		gocel.Variable("spend", gocel.ObjectType("cel.Spend")),
+		gocel.Variable("now", gocel.TimestampType),
+		gocel.Variable("hour", gocel.IntType),
+		gocel.Variable("weekday", gocel.IntType),
+		gocel.Function("compareAmounts",
+			gocel.Overload("compareAmounts_string_string",
+				[]*gocel.Type{gocel.StringType, gocel.StringType}, gocel.IntType,
+				gocel.BinaryBinding(compareAmounts))),
+		gocel.Function("addAmounts",
+			gocel.Overload("addAmounts_string_string",
+				[]*gocel.Type{gocel.StringType, gocel.StringType}, gocel.StringType,
+				gocel.BinaryBinding(addAmounts))),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create cel environment: %w", err)
+	}
+	return &Evaluator{env: env, programs: make(map[string]gocel.Program)}, nil
+}
+
+// Compile type-checks the expression and requires it to yield a bool.
+func (e *Evaluator) Compile(expression string) error {
+	_, err := e.program(expression)
+	return err
+}
+
+func (e *Evaluator) Evaluate(ctx context.Context, expression string, input *service.PolicyInput) (bool, error) {
+	program, err := e.program(expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.ContextEval(ctx, activation(input))
+	if err != nil {
+		return false, err
+	}
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s, want bool", out.Type())
+	}
+	return allowed, nil
+}
+
+func (e *Evaluator) program(expression string) (gocel.Program, error) {
+	e.mu.RLock()
+	program, ok := e.programs[expression]
+	e.mu.RUnlock()
+	if ok {
+		return program, nil
+	}
+
+	ast, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != gocel.BoolType {
+		return nil, fmt.Errorf("expression returns %s, want bool", ast.OutputType())
+	}
+	program, err := e.env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	e.programs[expression] = program
+	e.mu.Unlock()
+	return program, nil
+}
+
+func activation(input *service.PolicyInput) map[string]any {
+	tx := input.Transaction
+	gasPrice, _ := new(big.Int).SetString(strings.TrimPrefix(tx.GasPrice, "0x"), 16)
+	now := input.Now.UTC()
+
+	return map[string]any{
+		"wallet": Wallet{
+			ID:      input.Wallet.ID,
+			Network: input.Wallet.Network,
+			Address: strings.ToLower(input.Wallet.Address),
+			Status:  string(input.Wallet.Status),
+		},
+		"tenant": Tenant{
+			ID:              input.Tenant.ID,
+			Name:            input.Tenant.Name,
+			AllowedNetworks: append([]string{}, input.Tenant.AllowedNetworks...),
+			MaxWallets:      int64(input.Tenant.MaxWallets),
+		},
+		"tx": Transaction{
+			ChainID:   tx.ChainID,
+			From:      strings.ToLower(tx.From),
+			To:        strings.ToLower(tx.To),
+			Value:     wei(input.Value),
+			ValueWei:  decimal(input.Value),
+			Data:      strings.ToLower(tx.Data),
+			GasLimit:  int64(tx.GasLimit),
+			GasPrice:  wei(gasPrice),
+			Nonce:     int64(tx.Nonce),
+			Delegates: delegates(tx.AuthorizationList),
+		},
+		"calldata": Calldata{
+			Selector:  input.Calldata.Selector,
+			Args:      append([]string{}, input.Calldata.Args...),
+			Method:    input.Calldata.Method,
+			Signature: input.Calldata.Signature,
+			Params:    params(input.Calldata.Params),
+		},
+		"spend": Spend{
+			LastHour:    wei(input.Spend.LastHour),
+			LastDay:     wei(input.Spend.LastDay),
+			LastWeek:    wei(input.Spend.LastWeek),
+			LastHourWei: decimal(input.Spend.LastHour),
+			LastDayWei:  decimal(input.Spend.LastDay),
+			LastWeekWei: decimal(input.Spend.LastWeek),
+		},
+		"now":     now,
+		"hour":    int64(now.Hour()),
+		"weekday": int64(now.Weekday()),
+	}
+}
+
+func wei(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	return f
+}
+
+func decimal(amount *big.Int) string {
+	if amount == nil {
+		return "0"
+	}
+	return amount.String()
+}
+
+func params(decoded map[string]string) map[string]string {
+	copied := make(map[string]string, len(decoded))
+	for name, value := range decoded {
+		copied[name] = value
+	}
+	return copied
+}
+
+func compareAmounts(lhs, rhs ref.Val) ref.Val {
+	a, b, err := amounts(lhs, rhs)
+	if err != nil {
+		return types.NewErr("compareAmounts: %v", err)
+	}
+	return types.Int(a.Cmp(b))
+}
+
+func addAmounts(lhs, rhs ref.Val) ref.Val {
+	a, b, err := amounts(lhs, rhs)
+	if err != nil {
+		return types.NewErr("addAmounts: %v", err)
+	}
+	return types.String(new(big.Int).Add(a, b).String())
+}
+
+func amounts(lhs, rhs ref.Val) (*big.Int, *big.Int, error) {
+	a, ok := new(big.Int).SetString(string(lhs.(types.String)), 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q is not a decimal integer", lhs.Value())
+	}
+	b, ok := new(big.Int).SetString(string(rhs.(types.String)), 10)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q is not a decimal integer", rhs.Value())
+	}
+	return a, b, nil
+}
+
+func delegates(list []service.Authorization) []string {
+	addresses := make([]string, 0, len(list))
+	for _, authorization := range list {
+		addresses = append(addresses, strings.ToLower(authorization.Address))
+	}
+	return addresses
+}
@@ -0,0 +1,98 @@
+package cel
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func testInput() *service.PolicyInput {
+	return &service.PolicyInput{
+		Wallet: service.Wallet{ID: "w1", Network: "base-sepolia", Address: "0xABC", Status: service.WalletStatusActive},
+		Tenant: service.Tenant{ID: "default", Name: "Default"},
+		Transaction: service.Transaction{
+			ChainID: 84532,
+			To:      "0x1111111111111111111111111111111111111111",
+			Value:   "0xde0b6b3a7640000",
+			Data:    "0xa9059cbb" + "000000000000000000000000000000000000000000000000000000000000dead",
+		},
+		Value: big.NewInt(1_000_000_000_000_000_000),
+		Calldata: service.Calldata{
+			Selector:  "0xa9059cbb",
+			Args:      []string{"0x000000000000000000000000000000000000000000000000000000000000dead"},
+			Method:    "transfer",
+			Signature: "transfer(address,uint256)",
+			Params:    map[string]string{"to": "0x000000000000000000000000000000000000dead", "amount": "9007199254740993"},
+		},
+		Now:   time.Date(2026, 3, 4, 14, 30, 0, 0, time.UTC),
+		Spend: service.SpendTotals{LastHour: big.NewInt(0), LastDay: big.NewInt(2e18), LastWeek: big.NewInt(5e18)},
+	}
+}
+
+func TestEvaluatorExpressions(t *testing.T) {
+	evaluator, err := NewEvaluator()
+	if err != nil {
+		t.Fatalf("new evaluator: %v", err)
+	}
+
+	cases := []struct {
+		expression string
+		want       bool
+	}{
+		{`tx.value <= 1e18`, true},
+		{`tx.value + spend.lastDay > 2.5e18`, true},
+		{`wallet.network == "base-sepolia" && tenant.id == "default"`, true},
+		{`calldata.selector == "0xa9059cbb" && calldata.args[0].endsWith("dead")`, true},
+		{`hour >= 9 && hour < 17 && weekday != 0`, true},
+		{`now < timestamp("2026-01-01T00:00:00Z")`, false},
+		{`tx.to in ["0x2222222222222222222222222222222222222222"]`, false},
+		{`tx.chainId == 84532`, true},
+		{`tx.value >= 1000000000000000000`, true},
+		{`calldata.method == "transfer" && calldata.params["to"].endsWith("dead")`, true},
+		{`compareAmounts(calldata.params["amount"], "9007199254740992") > 0`, true},
+		{`compareAmounts(tx.valueWei, "1000000000000000001") < 0`, true},
+		{`compareAmounts(addAmounts(tx.valueWei, spend.lastDayWei), "3000000000000000000") == 0`, true},
+	}
+	for _, tc := range cases {
+		if err := evaluator.Compile(tc.expression); err != nil {
+			t.Fatalf("compile %q: %v", tc.expression, err)
+		}
+		got, err := evaluator.Evaluate(context.Background(), tc.expression, testInput())
+		if err != nil {
+			t.Fatalf("evaluate %q: %v", tc.expression, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%q = %v, want %v", tc.expression, got, tc.want)
+		}
+	}
+}
+
+func TestEvaluatorRejectsInvalidExpressions(t *testing.T) {
+	evaluator, err := NewEvaluator()
+	if err != nil {
+		t.Fatalf("new evaluator: %v", err)
+	}
+
+	for _, expression := range []string{`tx.value`, `tx.unknown == 1`, `tx.value <=`} {
+		if err := evaluator.Compile(expression); err == nil {
+			t.Fatalf("expected %q to be rejected", expression)
+		}
+	}
+}
+
+func TestEvaluatorFailsOnRuntimeError(t *testing.T) {
+	evaluator, err := NewEvaluator()
+	if err != nil {
+		t.Fatalf("new evaluator: %v", err)
+	}
+
+	if _, err := evaluator.Evaluate(context.Background(), `calldata.args[5] == "0x"`, testInput()); err == nil {
+		t.Fatal("expected an out of range index to fail")
+	}
+	if _, err := evaluator.Evaluate(context.Background(), `compareAmounts("1e18", tx.valueWei) == 0`, testInput()); err == nil {
+		t.Fatal("expected a non-integer amount to fail")
+	}
+}
@@ -0,0 +1,481 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrApprovalRequired marks a transaction that was queued for approval
+// instead of being signed. It is always wrapped in an *ApprovalPendingError.
+var ErrApprovalRequired = errors.New("approval required")
+
+// DefaultApprovalTTL is how long a request stays open when the quorum rule
+// does not set one.
+const DefaultApprovalTTL = 24 * time.Hour
+
+type ApprovalStatus string
+
+const (
+	ApprovalStatusPending  ApprovalStatus = "pending"
+	ApprovalStatusApproved ApprovalStatus = "approved"
+	ApprovalStatusRejected ApprovalStatus = "rejected"
+	ApprovalStatusExpired  ApprovalStatus = "expired"
+	// ApprovalStatusFailed marks a request whose quorum was reached but whose
+	// transaction could not be signed. Error says why.
+	ApprovalStatusFailed ApprovalStatus = "failed"
+)
+
+// QuorumRule requires Required of the listed approvers to sign off before a
+// transaction is signed. Approvers are actor names such as "apikey:<id>" or
+// "user:<sub>".
+type QuorumRule struct {
+	Required  int      `json:"required"`
+	Approvers []string `json:"approvers"`
+	TTL       Duration `json:"ttl,omitempty"`
+	// Broadcast submits the signed transaction to the wallet's network once
+	// the quorum is reached.
+	Broadcast bool `json:"broadcast,omitempty"`
+}
+
+type ApprovalDecision struct {
+	Actor    string    `json:"actor"`
+	Approved bool      `json:"approved"`
+	Comment  string    `json:"comment,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// ApprovalRequest is a transaction waiting for its quorum. A queued user
+// operation is held in UserOperation, with Transaction describing the call
+// the EntryPoint makes into the account, and its signed form is set in
+// UserOperationResult once approved.
+type ApprovalRequest struct {
+	ID                  string                `json:"id"`
+	TenantID            string                `json:"tenantId"`
+	WalletID            string                `json:"walletId"`
+	PolicyID            string                `json:"policyId"`
+	Requester           string                `json:"requester"`
+	Transaction         Transaction           `json:"transaction"`
+	UserOperation       *UserOperationRequest `json:"userOperation,omitempty"`
+	Required            int                   `json:"required"`
+	Approvers           []string              `json:"approvers"`
+	Broadcast           bool                  `json:"broadcast,omitempty"`
+	Decisions           []ApprovalDecision    `json:"decisions"`
+	Status              ApprovalStatus        `json:"status"`
+	SignedTransaction   string                `json:"signedTransaction,omitempty"`
+	UserOperationResult *UserOperationResult  `json:"userOperationResult,omitempty"`
+	TxHash              string                `json:"txHash,omitempty"`
+	BroadcastError      string                `json:"broadcastError,omitempty"`
+	Error               string                `json:"error,omitempty"`
+	CreatedAt           time.Time             `json:"createdAt"`
+	UpdatedAt           time.Time             `json:"updatedAt"`
+	ExpiresAt           time.Time             `json:"expiresAt"`
+}
+
+// ApprovalPendingError is returned by SignTransaction when the transaction
+// has been queued. Callers recover the request with errors.As.
+type ApprovalPendingError struct {
+	Request *ApprovalRequest
+}
+
+func (e *ApprovalPendingError) Error() string {
+	return fmt.Sprintf("%s: request %s needs %d approvals", ErrApprovalRequired, e.Request.ID, e.Request.Required)
+}
+
+func (e *ApprovalPendingError) Unwrap() error {
+	return ErrApprovalRequired
+}
+
+func (r *ApprovalRequest) count(approved bool) int {
+	n := 0
+	for _, decision := range r.Decisions {
+		if decision.Approved == approved {
+			n++
+		}
+	}
+	return n
+}
+
+func (r *ApprovalRequest) isApprover(actor string) bool {
+	for _, approver := range r.Approvers {
+		if approver == actor {
+			return true
+		}
+	}
+	return false
+}
+
+// eligible returns how many approvers can vote on the request. Requesters
+// cannot approve their own transactions.
+func (r *ApprovalRequest) eligible() int {
+	if r.isApprover(r.Requester) {
+		return len(r.Approvers) - 1
+	}
+	return len(r.Approvers)
+}
+
+func (r *ApprovalRequest) decided(actor string) bool {
+	for _, decision := range r.Decisions {
+		if decision.Actor == actor {
+			return true
+		}
+	}
+	return false
+}
+
+type ApprovalRepository interface {
+	Create(ctx context.Context, request ApprovalRequest) (*ApprovalRequest, error)
+	GetByID(ctx context.Context, tenantID string, id string) (*ApprovalRequest, error)
+	List(ctx context.Context, tenantID string) ([]ApprovalRequest, error)
+	Update(ctx context.Context, request ApprovalRequest) (*ApprovalRequest, error)
+	// ListAll returns requests across every tenant, for maintenance jobs only.
+	ListAll(ctx context.Context) ([]ApprovalRequest, error)
+}
+
+// Broadcaster submits raw signed transactions to a network's RPC endpoint and
+// returns the transaction hash.
+type Broadcaster interface {
+	Broadcast(ctx context.Context, rpcURL string, signedTx string) (string, error)
+}
+
+// WithApprovals queues transactions on wallets governed by a quorum policy
+// instead of signing them immediately.
+func WithApprovals(approvals ApprovalRepository) WalletServiceOption {
+	return func(s *walletService) {
+		s.approvals = approvals
+	}
+}
+
+// WithBroadcaster lets approved requests with Broadcast set be submitted to
+// the network's RPC endpoint.
+func WithBroadcaster(broadcaster Broadcaster, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.broadcaster = broadcaster
+		s.registry = registry
+	}
+}
+
+// ListApprovals returns the caller's tenant's requests, optionally filtered
+// by status.
+func (s *walletService) ListApprovals(ctx context.Context, status ApprovalStatus) ([]ApprovalRequest, error) {
+	if s.approvals == nil {
+		return []ApprovalRequest{}, nil
+	}
+	requests, err := s.approvals.List(ctx, TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list approvals: %w", err)
+	}
+
+	result := make([]ApprovalRequest, 0, len(requests))
+	for _, request := range requests {
+		if !walletVisible(ctx, request.WalletID) {
+			continue
+		}
+		if status != "" && request.Status != status {
+			continue
+		}
+		result = append(result, request)
+	}
+	return result, nil
+}
+
+func (s *walletService) GetApproval(ctx context.Context, id string) (*ApprovalRequest, error) {
+	if s.approvals == nil {
+		return nil, ErrNotFound
+	}
+	request, err := s.approvals.GetByID(ctx, TenantFromContext(ctx), id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get approval: %w", err)
+	}
+	if !walletVisible(ctx, request.WalletID) {
+		return nil, ErrNotFound
+	}
+	return request, nil
+}
+
+// ApproveRequest records the caller's approval. When the quorum is reached
+// the transaction is signed, and broadcast if the rule asks for it.
+func (s *walletService) ApproveRequest(ctx context.Context, id string, comment string) (*ApprovalRequest, error) {
+	return s.decide(ctx, id, true, comment)
+}
+
+// RejectRequest records the caller's rejection. The request is rejected once
+// the quorum can no longer be reached.
+func (s *walletService) RejectRequest(ctx context.Context, id string, comment string) (*ApprovalRequest, error) {
+	return s.decide(ctx, id, false, comment)
+}
+
+// ExpireApprovals closes pending requests whose TTL has elapsed and returns
+// how many were expired.
+func (s *walletService) ExpireApprovals(ctx context.Context) (int, error) {
+	if s.approvals == nil {
+		return 0, nil
+	}
+	s.approvalMu.Lock()
+	defer s.approvalMu.Unlock()
+
+	requests, err := s.approvals.ListAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list approvals: %w", err)
+	}
+
+	now := s.now()
+	expired := 0
+	for i := range requests {
+		request := requests[i]
+		if request.Status != ApprovalStatusPending || now.Before(request.ExpiresAt) {
+			continue
+		}
+		request.Status = ApprovalStatusExpired
+		request.UpdatedAt = now
+		if _, err := s.approvals.Update(ctx, request); err != nil {
+			return expired, fmt.Errorf("update approval %s: %w", request.ID, err)
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+func (s *walletService) decide(ctx context.Context, id string, approve bool, comment string) (*ApprovalRequest, error) {
+	request, err := s.recordDecision(ctx, id, approve, comment)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != ApprovalStatusApproved || !request.Broadcast {
+		return request, nil
+	}
+
+	// The request is no longer pending, so nothing else updates it while it
+	// is broadcast and approvalMu is free for other requests.
+	s.broadcast(ctx, request)
+	request.UpdatedAt = s.now()
+	stored, err := s.approvals.Update(ctx, *request)
+	if err != nil {
+		return nil, fmt.Errorf("update approval: %w", err)
+	}
+	return stored, nil
+}
+
+// recordDecision stores the caller's decision and, when it completes the
+// quorum, signs the transaction. The decision is stored first so that it
+// survives a failure to sign, which closes the request as failed.
+func (s *walletService) recordDecision(ctx context.Context, id string, approve bool, comment string) (*ApprovalRequest, error) {
+	s.approvalMu.Lock()
+	defer s.approvalMu.Unlock()
+
+	request, err := s.GetApproval(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	if request.Status == ApprovalStatusPending && !now.Before(request.ExpiresAt) {
+		request.Status = ApprovalStatusExpired
+		request.UpdatedAt = now
+		if _, err := s.approvals.Update(ctx, *request); err != nil {
+			return nil, fmt.Errorf("update approval: %w", err)
+		}
+	}
+	if request.Status != ApprovalStatusPending {
+		return nil, fmt.Errorf("%w: request is %s", ErrConflict, request.Status)
+	}
+
+	actor := ActorFromContext(ctx)
+	if !request.isApprover(actor) {
+		return nil, fmt.Errorf("%w: %s is not an approver", ErrForbidden, actor)
+	}
+	if actor == request.Requester {
+		return nil, fmt.Errorf("%w: requesters cannot approve their own transactions", ErrForbidden)
+	}
+	if request.decided(actor) {
+		return nil, fmt.Errorf("%w: %s has already decided", ErrConflict, actor)
+	}
+
+	request.Decisions = append(request.Decisions, ApprovalDecision{
+		Actor:    actor,
+		Approved: approve,
+		Comment:  strings.TrimSpace(comment),
+		At:       now,
+	})
+	request.UpdatedAt = now
+	if request.count(false) > request.eligible()-request.Required {
+		request.Status = ApprovalStatusRejected
+	}
+
+	stored, err := s.approvals.Update(ctx, *request)
+	if err != nil {
+		return nil, fmt.Errorf("update approval: %w", err)
+	}
+	if stored.Status != ApprovalStatusPending || stored.count(true) < stored.Required {
+		return stored, nil
+	}
+
+	if err := s.executeApproval(ctx, stored); err != nil {
+		stored.Status = ApprovalStatusFailed
+		stored.Error = err.Error()
+	}
+	stored.UpdatedAt = s.now()
+	if stored, err = s.approvals.Update(ctx, *stored); err != nil {
+		return nil, fmt.Errorf("update approval: %w", err)
+	}
+	return stored, nil
+}
+
+// executeApproval signs the queued transaction on behalf of the approver who
+// completed the quorum. Other policies are evaluated again at this point
+// because limits may have been used up while the request was pending.
+func (s *walletService) executeApproval(ctx context.Context, request *ApprovalRequest) error {
+	if request.UserOperation != nil {
+		return s.executeUserOperationApproval(ctx, request)
+	}
+	tx := request.Transaction
+	signed, err := s.signApproved(ctx, request.WalletID, &tx)
+	if auditErr := s.record(ctx, request.WalletID, AuditOperationSignTransaction, hashTransactionRequest(&tx), transactionHash(signed), err); auditErr != nil {
+		return auditErr
+	}
+	if err != nil {
+		return err
+	}
+
+	request.Status = ApprovalStatusApproved
+	request.SignedTransaction = signed
+	return nil
+}
+
+func (s *walletService) signApproved(ctx context.Context, walletID string, tx *Transaction) (string, error) {
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return "", err
+	}
+	if record.Status != WalletStatusActive {
+		return "", ErrWalletInactive
+	}
+
+	s.spendMu.Lock()
+	defer s.spendMu.Unlock()
+
+	value, _, err := s.checkPolicies(ctx, record, tx)
+	if err != nil {
+		return "", err
+	}
+	return s.signAndRecordSpend(ctx, record, tx, value)
+}
+
+func (s *walletService) broadcast(ctx context.Context, request *ApprovalRequest) {
+	if request.UserOperationResult != nil {
+		s.submitApprovedUserOperation(ctx, request)
+		return
+	}
+	if s.broadcaster == nil || s.registry == nil {
+		request.BroadcastError = "broadcasting is not configured"
+		return
+	}
+	record, err := s.load(ctx, request.WalletID)
+	if err != nil {
+		request.BroadcastError = err.Error()
+		return
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		request.BroadcastError = err.Error()
+		return
+	}
+	hash, err := s.broadcaster.Broadcast(ctx, network.RPCURL, request.SignedTransaction)
+	if err != nil {
+		request.BroadcastError = err.Error()
+		return
+	}
+	request.TxHash = hash
+}
+
+// queueForApproval stores a pending request for tx under the quorum rule of
+// policy and returns the error SignTransaction reports to the caller.
+func (s *walletService) queueForApproval(ctx context.Context, record *WalletRecord, tx *Transaction, policy *Policy) error {
+	return s.queue(ctx, record, policy, ApprovalRequest{Transaction: *tx})
+}
+
+// queue completes request for the wallet and policy and stores it.
+func (s *walletService) queue(ctx context.Context, record *WalletRecord, policy *Policy, request ApprovalRequest) error {
+	if s.approvals == nil {
+		return fmt.Errorf("%w: policy %q requires approvals but no approval queue is configured", ErrPolicyViolation, policy.Name)
+	}
+
+	quorum := policy.Rules.Quorum
+	ttl := time.Duration(quorum.TTL)
+	if ttl <= 0 {
+		ttl = DefaultApprovalTTL
+	}
+	now := s.now()
+
+	request.ID = uuid.NewString()
+	request.TenantID = record.TenantID
+	request.WalletID = record.ID
+	request.PolicyID = policy.ID
+	request.Requester = ActorFromContext(ctx)
+	request.Required = quorum.Required
+	request.Approvers = append([]string(nil), quorum.Approvers...)
+	request.Broadcast = request.Broadcast || quorum.Broadcast
+	request.Decisions = []ApprovalDecision{}
+	if request.eligible() < request.Required {
+		return fmt.Errorf("%w: policy %q needs %d approvers other than %s", ErrPolicyViolation, policy.Name, request.Required, request.Requester)
+	}
+	request.Status = ApprovalStatusPending
+	request.CreatedAt = now
+	request.UpdatedAt = now
+	request.ExpiresAt = now.Add(ttl)
+	stored, err := s.approvals.Create(ctx, request)
+	if err != nil {
+		return fmt.Errorf("store approval: %w", err)
+	}
+	return &ApprovalPendingError{Request: stored}
+}
+
+func (s *walletService) signAndRecordSpend(ctx context.Context, record *WalletRecord, tx *Transaction, value *big.Int) (string, error) {
+	signed, err := s.signer.SignTransaction(tx, record.PrivKey)
+	if err != nil {
+		if errors.Is(err, ErrNotImplemented) {
+			return "", ErrNotImplemented
+		}
+		return "", fmt.Errorf("sign transaction: %w", err)
+	}
+
+	if err := s.recordSpend(ctx, record, value); err != nil {
+		return "", fmt.Errorf("record spend: %w", err)
+	}
+	return signed, nil
+}
+
+func validateQuorum(quorum *QuorumRule) error {
+	if quorum.Required < 1 {
+		return fmt.Errorf("%w: quorum.required must be at least 1", ErrValidation)
+	}
+	seen := make(map[string]struct{}, len(quorum.Approvers))
+	for i, approver := range quorum.Approvers {
+		approver = strings.TrimSpace(approver)
+		if approver == "" {
+			return fmt.Errorf("%w: approver names must not be empty", ErrValidation)
+		}
+		if _, dup := seen[approver]; dup {
+			return fmt.Errorf("%w: duplicate approver %s", ErrValidation, approver)
+		}
+		seen[approver] = struct{}{}
+		quorum.Approvers[i] = approver
+	}
+	// Whether the quorum can be reached without the requester is checked
+	// when a transaction is queued.
+	if quorum.Required > len(quorum.Approvers) {
+		return fmt.Errorf("%w: quorum.required must not exceed the number of approvers", ErrValidation)
+	}
+	if quorum.TTL < 0 {
+		return fmt.Errorf("%w: quorum.ttl must not be negative", ErrValidation)
+	}
+	return nil
+}
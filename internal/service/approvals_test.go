@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type stubApprovalRepo struct {
+	mu       sync.Mutex
+	requests map[string]ApprovalRequest
+}
+
+func newStubApprovalRepo() *stubApprovalRepo {
+	return &stubApprovalRepo{requests: make(map[string]ApprovalRequest)}
+}
+
+func (r *stubApprovalRepo) Create(_ context.Context, request ApprovalRequest) (*ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[request.ID] = request
+	return &request, nil
+}
+
+func (r *stubApprovalRepo) GetByID(_ context.Context, tenantID string, id string) (*ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	request, ok := r.requests[id]
+	if !ok || request.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+	request.Decisions = append([]ApprovalDecision(nil), request.Decisions...)
+	return &request, nil
+}
+
+func (r *stubApprovalRepo) List(_ context.Context, tenantID string) ([]ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []ApprovalRequest
+	for _, request := range r.requests {
+		if request.TenantID == tenantID {
+			result = append(result, request)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubApprovalRepo) Update(_ context.Context, request ApprovalRequest) (*ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[request.ID] = request
+	return &request, nil
+}
+
+func (r *stubApprovalRepo) ListAll(_ context.Context) ([]ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []ApprovalRequest
+	for _, request := range r.requests {
+		result = append(result, request)
+	}
+	return result, nil
+}
+
+func actorContext(keyID string) context.Context {
+	return ContextWithPrincipal(context.Background(), &Principal{ID: keyID, TenantID: DefaultTenantID, Scopes: []Scope{ScopeAdmin}})
+}
+
+func newQuorumWallet(t *testing.T, now *time.Time) (WalletService, *Wallet) {
+	t.Helper()
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{},
+		WithPolicies(policies),
+		WithApprovals(newStubApprovalRepo()),
+		WithClock(func() time.Time { return *now }),
+	)
+
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(context.Background(), Policy{
+		Name:     "treasury",
+		WalletID: wallet.ID,
+		Rules: PolicyRules{Quorum: &QuorumRule{
+			Required:  2,
+			Approvers: []string{"apikey:alice", "apikey:bob", "apikey:carol"},
+			TTL:       Duration(time.Hour),
+		}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	return svc, wallet
+}
+
+func submitForApproval(t *testing.T, svc WalletService, ctx context.Context, walletID string) *ApprovalRequest {
+	t.Helper()
+	_, err := svc.SignTransaction(ctx, walletID, policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x1", ""))
+	var pending *ApprovalPendingError
+	if !errors.As(err, &pending) {
+		t.Fatalf("expected ApprovalPendingError, got %v", err)
+	}
+	if !errors.Is(err, ErrApprovalRequired) {
+		t.Fatalf("expected error to match ErrApprovalRequired")
+	}
+	return pending.Request
+}
+
+func TestQuorumSignsAfterEnoughApprovals(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc, wallet := newQuorumWallet(t, &now)
+
+	request := submitForApproval(t, svc, actorContext("alice"), wallet.ID)
+	if request.Status != ApprovalStatusPending || request.Required != 2 {
+		t.Fatalf("unexpected request: %+v", request)
+	}
+
+	if _, err := svc.ApproveRequest(actorContext("alice"), request.ID, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected requester approval to be forbidden, got %v", err)
+	}
+	if _, err := svc.ApproveRequest(actorContext("mallory"), request.ID, ""); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected non-approver to be forbidden, got %v", err)
+	}
+
+	updated, err := svc.ApproveRequest(actorContext("bob"), request.ID, "looks good")
+	if err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	if updated.Status != ApprovalStatusPending || updated.SignedTransaction != "" {
+		t.Fatalf("expected request to stay pending after one approval, got %s", updated.Status)
+	}
+	if _, err := svc.ApproveRequest(actorContext("bob"), request.ID, ""); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected duplicate approval to conflict, got %v", err)
+	}
+
+	approved, err := svc.ApproveRequest(actorContext("carol"), request.ID, "")
+	if err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	if approved.Status != ApprovalStatusApproved || approved.SignedTransaction != "signed-tx" {
+		t.Fatalf("expected approved request with signature, got %+v", approved)
+	}
+}
+
+func TestQuorumRejectionAndExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc, wallet := newQuorumWallet(t, &now)
+
+	// Alice cannot vote on her own request, so one rejection out of the two
+	// remaining approvers already makes the 2-of-3 quorum unreachable.
+	rejected := submitForApproval(t, svc, actorContext("alice"), wallet.ID)
+	final, err := svc.RejectRequest(actorContext("bob"), rejected.ID, "no")
+	if err != nil {
+		t.Fatalf("RejectRequest returned error: %v", err)
+	}
+	if final.Status != ApprovalStatusRejected {
+		t.Fatalf("expected rejected once quorum is unreachable, got %s", final.Status)
+	}
+
+	outsider := submitForApproval(t, svc, actorContext("dave"), wallet.ID)
+	if still, err := svc.RejectRequest(actorContext("bob"), outsider.ID, "no"); err != nil || still.Status != ApprovalStatusPending {
+		t.Fatalf("expected one rejection of three to leave the request pending, got %+v (%v)", still, err)
+	}
+	if final, err := svc.RejectRequest(actorContext("carol"), outsider.ID, "no"); err != nil || final.Status != ApprovalStatusRejected {
+		t.Fatalf("expected rejected once quorum is unreachable, got %+v (%v)", final, err)
+	}
+
+	stale := submitForApproval(t, svc, actorContext("alice"), wallet.ID)
+	now = now.Add(2 * time.Hour)
+	expired, err := svc.ExpireApprovals(context.Background())
+	if err != nil {
+		t.Fatalf("ExpireApprovals returned error: %v", err)
+	}
+	if expired != 1 {
+		t.Fatalf("expected 1 expired request, got %d", expired)
+	}
+	if _, err := svc.ApproveRequest(actorContext("bob"), stale.ID, ""); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected approving an expired request to conflict, got %v", err)
+	}
+
+	pending, err := svc.ListApprovals(actorContext("bob"), ApprovalStatusPending)
+	if err != nil {
+		t.Fatalf("ListApprovals returned error: %v", err)
+	}
+	if len(pending) != 0 {
+		t.Fatalf("expected no pending requests, got %d", len(pending))
+	}
+}
+
+func TestFailedApprovalKeepsDecision(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{},
+		WithPolicies(policies),
+		WithApprovals(newStubApprovalRepo()),
+		WithClock(func() time.Time { return now }),
+	)
+	policySvc := NewPolicyService(policies, repo, familyRegistry{})
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := policySvc.CreatePolicy(context.Background(), Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 1, Approvers: []string{"apikey:alice", "apikey:bob"}}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	request := submitForApproval(t, svc, actorContext("alice"), wallet.ID)
+	if _, err := policySvc.CreatePolicy(context.Background(), Policy{
+		Name:  "sanctions",
+		Rules: PolicyRules{DeniedDestinations: []string{request.Transaction.To}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	failed, err := svc.ApproveRequest(actorContext("bob"), request.ID, "")
+	if err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	if failed.Status != ApprovalStatusFailed || !strings.Contains(failed.Error, PolicyRuleDeniedDestinations) || failed.SignedTransaction != "" {
+		t.Fatalf("expected a failed request, got %+v", failed)
+	}
+	stored, err := svc.GetApproval(actorContext("bob"), request.ID)
+	if err != nil || len(stored.Decisions) != 1 || stored.Status != ApprovalStatusFailed {
+		t.Fatalf("expected the decision to be kept, got %+v (%v)", stored, err)
+	}
+}
+
+// lockCheckingBroadcaster records whether approvals were locked while it
+// was called.
+type lockCheckingBroadcaster struct {
+	svc    *walletService
+	locked bool
+}
+
+func (b *lockCheckingBroadcaster) Broadcast(context.Context, string, string) (string, error) {
+	if b.svc.approvalMu.TryLock() {
+		b.svc.approvalMu.Unlock()
+	} else {
+		b.locked = true
+	}
+	return "0xhash", nil
+}
+
+func TestApprovalBroadcastsWithoutHoldingTheLock(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	broadcaster := &lockCheckingBroadcaster{}
+	svc := NewWalletService(repo, &stubSigner{},
+		WithPolicies(policies),
+		WithApprovals(newStubApprovalRepo()),
+		WithBroadcaster(broadcaster, stubRegistry{}),
+	)
+	broadcaster.svc = svc.(*walletService)
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(context.Background(), Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 1, Approvers: []string{"apikey:alice", "apikey:bob"}, Broadcast: true}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	request := submitForApproval(t, svc, actorContext("alice"), wallet.ID)
+	approved, err := svc.ApproveRequest(actorContext("bob"), request.ID, "")
+	if err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	if approved.Status != ApprovalStatusApproved || approved.TxHash != "0xhash" {
+		t.Fatalf("expected a broadcast request, got %+v", approved)
+	}
+	if broadcaster.locked {
+		t.Fatal("expected approvals to be unlocked while broadcasting")
+	}
+}
+
+func TestQuorumAllowsAllApproversButNotTheRequester(t *testing.T) {
+	svc := NewPolicyService(NewPolicyEngine(&stubPolicyRepo{}, nil), newStubRepo(), familyRegistry{})
+	_, err := svc.CreatePolicy(context.Background(), Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 3, Approvers: []string{"apikey:alice", "apikey:bob"}}},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for more required approvals than approvers, got %v", err)
+	}
+
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	wallets := NewWalletService(repo, &stubSigner{}, WithPolicies(policies), WithApprovals(newStubApprovalRepo()))
+	wallet, err := wallets.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(context.Background(), Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 2, Approvers: []string{"apikey:alice", "apikey:bob"}}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error for a 2-of-2 quorum: %v", err)
+	}
+
+	_, err = wallets.SignTransaction(actorContext("alice"), wallet.ID, policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x1", ""))
+	if !errors.Is(err, ErrPolicyViolation) || errors.Is(err, ErrApprovalRequired) {
+		t.Fatalf("expected an approver's request to be refused, got %v", err)
+	}
+
+	request := submitForApproval(t, wallets, actorContext("carol"), wallet.ID)
+	if _, err := wallets.ApproveRequest(actorContext("alice"), request.ID, ""); err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	approved, err := wallets.ApproveRequest(actorContext("bob"), request.ID, "")
+	if err != nil || approved.Status != ApprovalStatusApproved {
+		t.Fatalf("expected the 2-of-2 request to be approved, got %+v (%v)", approved, err)
+	}
+}
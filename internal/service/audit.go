@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	AuditOperationSignMessage     = "sign_message"
+	AuditOperationSignTransaction = "sign_transaction"
+
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+	// AuditOutcomePending marks a transaction queued for approval. The result
+	// holds the approval request ID.
+	AuditOutcomePending = "pending"
+)
+
+// AuditEntry is a single record in the append-only signing log. Each entry
+// commits to its predecessor through PrevHash, so editing or removing any
+// entry invalidates every hash that follows it.
+type AuditEntry struct {
+	Sequence    uint64    `json:"sequence"`
+	Timestamp   time.Time `json:"timestamp"`
+	Actor       string    `json:"actor"`
+	TenantID    string    `json:"tenantId"`
+	WalletID    string    `json:"walletId"`
+	Operation   string    `json:"operation"`
+	PayloadHash string    `json:"payloadHash"`
+	Result      string    `json:"result,omitempty"`
+	// Delegate is the code address an EIP-7702 authorization hands the
+	// wallet's account to.
+	Delegate string `json:"delegate,omitempty"`
+	Outcome  string `json:"outcome"`
+	Error    string `json:"error,omitempty"`
+	PrevHash string `json:"prevHash"`
+	Hash     string `json:"hash"`
+}
+
+// AuditFilter narrows audit queries. Zero values match everything.
+type AuditFilter struct {
+	TenantID  string
+	WalletID  string
+	Actor     string
+	Operation string
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+}
+
+// AuditVerification reports the outcome of checking the hash chain.
+type AuditVerification struct {
+	Valid        bool   `json:"valid"`
+	Entries      int    `json:"entries"`
+	HeadHash     string `json:"headHash,omitempty"`
+	FirstInvalid uint64 `json:"firstInvalid,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// AuditLog persists audit entries. Implementations must chain entries with
+// ChainAuditEntry while holding whatever lock serialises appends.
+type AuditLog interface {
+	Append(ctx context.Context, entry AuditEntry) (*AuditEntry, error)
+	Entries(ctx context.Context) ([]AuditEntry, error)
+}
+
+type AuditService interface {
+	ListEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error)
+	Verify(ctx context.Context) (*AuditVerification, error)
+}
+
+type auditService struct {
+	log AuditLog
+}
+
+func NewAuditService(log AuditLog) AuditService {
+	return &auditService{log: log}
+}
+
+// ListEntries returns entries matching filter. Callers without the platform
+// scope only see entries from their own tenant.
+func (s *auditService) ListEntries(ctx context.Context, filter AuditFilter) ([]AuditEntry, error) {
+	if !isPlatform(ctx) {
+		filter.TenantID = TenantFromContext(ctx)
+	}
+
+	entries, err := s.log.Entries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+
+	result := make([]AuditEntry, 0)
+	for _, entry := range entries {
+		if !filter.Matches(entry) {
+			continue
+		}
+		result = append(result, entry)
+		if filter.Limit > 0 && len(result) >= filter.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+func (s *auditService) Verify(ctx context.Context) (*AuditVerification, error) {
+	entries, err := s.log.Entries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	return VerifyAuditChain(entries), nil
+}
+
+// Matches reports whether the entry satisfies every populated filter field.
+func (f AuditFilter) Matches(entry AuditEntry) bool {
+	if f.TenantID != "" && entry.TenantID != f.TenantID {
+		return false
+	}
+	if f.WalletID != "" && entry.WalletID != f.WalletID {
+		return false
+	}
+	if f.Actor != "" && entry.Actor != f.Actor {
+		return false
+	}
+	if f.Operation != "" && entry.Operation != f.Operation {
+		return false
+	}
+	if !f.Since.IsZero() && entry.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.Timestamp.After(f.Until) {
+		return false
+	}
+	return true
+}
+
+// ChainAuditEntry links entry to prev (nil for the first entry) and seals it
+// with its hash.
+func ChainAuditEntry(prev *AuditEntry, entry AuditEntry) AuditEntry {
+	entry.Sequence = 1
+	entry.PrevHash = ""
+	if prev != nil {
+		entry.Sequence = prev.Sequence + 1
+		entry.PrevHash = prev.Hash
+	}
+	entry.Timestamp = entry.Timestamp.UTC()
+	entry.Hash = entry.ComputeHash()
+	return entry
+}
+
+// ComputeHash returns the SHA-256 over every field except Hash itself.
+func (e AuditEntry) ComputeHash() string {
+	sealed := e
+	sealed.Hash = ""
+	encoded, _ := json.Marshal(sealed)
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyAuditChain walks the entries in order and checks sequence numbers,
+// back-links and hashes.
+func VerifyAuditChain(entries []AuditEntry) *AuditVerification {
+	result := &AuditVerification{Valid: true, Entries: len(entries)}
+
+	prevHash := ""
+	for i, entry := range entries {
+		expectedSeq := uint64(i + 1)
+		switch {
+		case entry.Sequence != expectedSeq:
+			result.Reason = fmt.Sprintf("expected sequence %d, found %d", expectedSeq, entry.Sequence)
+		case entry.PrevHash != prevHash:
+			result.Reason = "previous hash mismatch"
+		case entry.ComputeHash() != entry.Hash:
+			result.Reason = "entry hash mismatch"
+		}
+		if result.Reason != "" {
+			result.Valid = false
+			result.FirstInvalid = expectedSeq
+			return result
+		}
+		prevHash = entry.Hash
+	}
+
+	result.HeadHash = prevHash
+	return result
+}
+
+type noopAuditLog struct{}
+
+func (noopAuditLog) Append(_ context.Context, entry AuditEntry) (*AuditEntry, error) {
+	return &entry, nil
+}
+
+func (noopAuditLog) Entries(context.Context) ([]AuditEntry, error) {
+	return nil, nil
+}
+
+// record appends an audit entry for a signing operation. A failure to write
+// the entry is returned so callers can refuse to hand out an unaudited
+// signature.
+func (s *walletService) record(ctx context.Context, walletID, operation, payloadHash, result string, opErr error) error {
+	return s.recordEntry(ctx, AuditEntry{WalletID: walletID, Operation: operation, PayloadHash: payloadHash, Result: result}, opErr)
+}
+
+// recordEntry completes entry with the caller, the time and the outcome of
+// opErr, and appends it.
+func (s *walletService) recordEntry(ctx context.Context, entry AuditEntry, opErr error) error {
+	entry.Timestamp = s.now()
+	entry.Actor = ActorFromContext(ctx)
+	entry.TenantID = TenantFromContext(ctx)
+	entry.Outcome = AuditOutcomeSuccess
+	var pending *ApprovalPendingError
+	switch {
+	case errors.As(opErr, &pending):
+		entry.Outcome = AuditOutcomePending
+		entry.Result = pending.Request.ID
+	case opErr != nil:
+		entry.Outcome = AuditOutcomeFailure
+		entry.Error = opErr.Error()
+		entry.Result = ""
+	}
+
+	if _, err := s.audit.Append(ctx, entry); err != nil {
+		return fmt.Errorf("append audit entry: %w", err)
+	}
+	return nil
+}
+
+func hashPayload(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return "0x" + hex.EncodeToString(sum[:])
+}
+
+func hashTransactionRequest(tx *Transaction) string {
+	encoded, _ := json.Marshal(tx)
+	return hashPayload(encoded)
+}
+
+// transactionHash derives the on-chain hash of a raw signed transaction.
+// Blob transactions are signed in their network form, whose sidecar is not
+// part of the hash, so the bytes are decoded rather than hashed directly.
+func transactionHash(signed string) string {
+	if signed == "" {
+		return ""
+	}
+	raw, err := hex.DecodeString(strings.TrimPrefix(signed, "0x"))
+	if err != nil {
+		return ""
+	}
+	var tx types.Transaction
+	if err := tx.UnmarshalBinary(raw); err != nil {
+		return ""
+	}
+	return tx.Hash().Hex()
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type stubAuditLog struct {
+	mu        sync.Mutex
+	entries   []AuditEntry
+	appendErr error
+}
+
+func (l *stubAuditLog) Append(_ context.Context, entry AuditEntry) (*AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.appendErr != nil {
+		return nil, l.appendErr
+	}
+	var prev *AuditEntry
+	if n := len(l.entries); n > 0 {
+		prev = &l.entries[n-1]
+	}
+	sealed := ChainAuditEntry(prev, entry)
+	l.entries = append(l.entries, sealed)
+	return &sealed, nil
+}
+
+func (l *stubAuditLog) Entries(context.Context) ([]AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]AuditEntry(nil), l.entries...), nil
+}
+
+func TestSigningOperationsAreAudited(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	log := &stubAuditLog{}
+	svc := NewWalletService(repo, signer, WithAuditLog(log))
+
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	ctx := ContextWithActor(context.Background(), "alice")
+	if _, err := svc.SignMessage(ctx, wallet.ID, []byte("gm")); err != nil {
+		t.Fatalf("SignMessage returned error: %v", err)
+	}
+	if _, err := svc.SignMessage(ctx, "missing", []byte("gm")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	entries, _ := log.Entries(context.Background())
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 audit entries, got %d", len(entries))
+	}
+	if entries[0].Actor != "alice" || entries[0].Outcome != AuditOutcomeSuccess || entries[0].Result != "signature" {
+		t.Fatalf("unexpected success entry: %+v", entries[0])
+	}
+	if entries[1].Outcome != AuditOutcomeFailure || entries[1].Error == "" {
+		t.Fatalf("unexpected failure entry: %+v", entries[1])
+	}
+	if entries[1].PrevHash != entries[0].Hash {
+		t.Fatal("expected entries to be hash-chained")
+	}
+}
+
+func TestSigningFailsWhenAuditUnavailable(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	svc := NewWalletService(repo, signer, WithAuditLog(&stubAuditLog{appendErr: errors.New("disk full")}))
+
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	if sig, err := svc.SignMessage(context.Background(), wallet.ID, []byte("gm")); err == nil || sig != nil {
+		t.Fatalf("expected signing to fail without audit, got %v, %v", sig, err)
+	}
+}
+
+func TestVerifyAuditChainDetectsTampering(t *testing.T) {
+	log := &stubAuditLog{}
+	for _, op := range []string{AuditOperationSignMessage, AuditOperationSignTransaction, AuditOperationSignMessage} {
+		if _, err := log.Append(context.Background(), AuditEntry{Operation: op, WalletID: "w1", Outcome: AuditOutcomeSuccess}); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	entries, _ := log.Entries(context.Background())
+	if result := VerifyAuditChain(entries); !result.Valid {
+		t.Fatalf("expected valid chain, got %+v", result)
+	}
+
+	entries[1].WalletID = "w2"
+	result := VerifyAuditChain(entries)
+	if result.Valid || result.FirstInvalid != 2 {
+		t.Fatalf("expected tampering at entry 2, got %+v", result)
+	}
+
+	entries, _ = log.Entries(context.Background())
+	result = VerifyAuditChain(append(entries[:1], entries[2:]...))
+	if result.Valid {
+		t.Fatal("expected removed entry to break the chain")
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+// Scope is a permission granted to an API key.
+type Scope string
+
+const (
+	ScopeWalletsRead   Scope = "wallets:read"
+	ScopeWalletsCreate Scope = "wallets:create"
+	ScopeSign          Scope = "sign"
+	ScopeAdmin         Scope = "admin"
+	// ScopePlatform manages tenants and keys across tenants. Unlike the other
+	// scopes it is not implied by admin.
+	ScopePlatform Scope = "platform"
+)
+
+const apiKeyPrefix = "wsk_"
+
+var knownScopes = map[Scope]struct{}{
+	ScopeWalletsRead:   {},
+	ScopeWalletsCreate: {},
+	ScopeSign:          {},
+	ScopeAdmin:         {},
+	ScopePlatform:      {},
+}
+
+// APIKey is the stored form of a key. Only the SHA-256 of the token is kept.
+type APIKey struct {
+	ID        string     `json:"id"`
+	TenantID  string     `json:"tenantId"`
+	Name      string     `json:"name"`
+	Hash      string     `json:"-"`
+	Scopes    []Scope    `json:"scopes"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+}
+
+// CreatedAPIKey is returned once, at creation, and carries the plaintext token.
+type CreatedAPIKey struct {
+	APIKey
+	Token string `json:"token"`
+}
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key APIKey) (*APIKey, error)
+	GetByHash(ctx context.Context, hash string) (*APIKey, error)
+	GetByID(ctx context.Context, id string) (*APIKey, error)
+	List(ctx context.Context) ([]APIKey, error)
+	Update(ctx context.Context, key APIKey) (*APIKey, error)
+}
+
+// Principal types distinguish service API keys from end users authenticated
+// with a bearer JWT.
+const (
+	PrincipalAPIKey = "apikey"
+	PrincipalUser   = "user"
+)
+
+// Principal is the authenticated caller attached to a request context.
+type Principal struct {
+	ID       string
+	Type     string
+	TenantID string
+	Name     string
+	Scopes   []Scope
+	// WalletIDs lists the wallets the principal may act on when
+	// RestrictWallets is set. API keys are unrestricted within their tenant.
+	WalletIDs       []string
+	RestrictWallets bool
+}
+
+// Allows reports whether the principal holds scope. Admin implies every
+// tenant-level scope; platform must be granted explicitly.
+func (p *Principal) Allows(scope Scope) bool {
+	for _, s := range p.Scopes {
+		if s == scope || (s == ScopeAdmin && scope != ScopePlatform) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsWallet reports whether the principal may act on the wallet.
+func (p *Principal) AllowsWallet(id string) bool {
+	if !p.RestrictWallets {
+		return true
+	}
+	for _, allowed := range p.WalletIDs {
+		if allowed == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Principal) actor() string {
+	if p.Type == "" {
+		return PrincipalAPIKey + ":" + p.ID
+	}
+	return p.Type + ":" + p.ID
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal attaches the authenticated caller, which also becomes
+// the actor recorded in audit entries.
+func ContextWithPrincipal(ctx context.Context, p *Principal) context.Context {
+	ctx = context.WithValue(ctx, principalKey{}, p)
+	return ContextWithActor(ctx, p.actor())
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok && p != nil
+}
+
+// TokenValidator verifies end-user bearer tokens such as OIDC-issued JWTs.
+type TokenValidator interface {
+	Validate(ctx context.Context, token string) (*Principal, error)
+}
+
+// Authorizer resolves bearer tokens to principals and checks scopes. The HTTP
+// middleware and the gRPC interceptor share one instance.
+type Authorizer struct {
+	keys   APIKeyRepository
+	tokens TokenValidator
+}
+
+// AuthorizerOption customises an Authorizer at construction time.
+type AuthorizerOption func(*Authorizer)
+
+// WithTokenValidator accepts JWT bearer tokens alongside API keys.
+func WithTokenValidator(v TokenValidator) AuthorizerOption {
+	return func(a *Authorizer) {
+		a.tokens = v
+	}
+}
+
+func NewAuthorizer(keys APIKeyRepository, opts ...AuthorizerOption) *Authorizer {
+	a := &Authorizer{keys: keys}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+func (a *Authorizer) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, fmt.Errorf("%w: missing API key", ErrUnauthenticated)
+	}
+	if a.tokens != nil && strings.Count(token, ".") == 2 {
+		p, err := a.tokens.Validate(ctx, token)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+		}
+		return p, nil
+	}
+
+	key, err := a.keys.GetByHash(ctx, HashAPIKey(token))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("%w: invalid API key", ErrUnauthenticated)
+		}
+		return nil, fmt.Errorf("lookup API key: %w", err)
+	}
+	if key.RevokedAt != nil {
+		return nil, fmt.Errorf("%w: API key revoked", ErrUnauthenticated)
+	}
+
+	return &Principal{ID: key.ID, Type: PrincipalAPIKey, TenantID: key.TenantID, Name: key.Name, Scopes: key.Scopes}, nil
+}
+
+// Authorize checks that the principal in ctx holds scope.
+func (a *Authorizer) Authorize(ctx context.Context, scope Scope) error {
+	p, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	if !p.Allows(scope) {
+		return fmt.Errorf("%w: %s scope required", ErrForbidden, scope)
+	}
+	return nil
+}
+
+func isPlatform(ctx context.Context) bool {
+	p, ok := PrincipalFromContext(ctx)
+	return ok && p.Allows(ScopePlatform)
+}
+
+// walletVisible reports whether the caller may see the wallet. Wallets outside
+// a restricted principal's allowlist behave as if they do not exist.
+func walletVisible(ctx context.Context, id string) bool {
+	p, ok := PrincipalFromContext(ctx)
+	return !ok || p.AllowsWallet(id)
+}
+
+func walletRestricted(ctx context.Context) bool {
+	p, ok := PrincipalFromContext(ctx)
+	return ok && p.RestrictWallets
+}
+
+// HashAPIKey returns the storage form of a plaintext token.
+func HashAPIKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// APIKeyService manages keys within the caller's tenant. Platform principals
+// may also issue keys for other tenants and grant the platform scope.
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, name string, tenantID string, scopes []Scope) (*CreatedAPIKey, error)
+	ListAPIKeys(ctx context.Context) ([]APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) (*APIKey, error)
+}
+
+type apiKeyService struct {
+	repo    APIKeyRepository
+	tenants TenantRepository
+}
+
+func NewAPIKeyService(repo APIKeyRepository, tenants TenantRepository) APIKeyService {
+	return &apiKeyService{repo: repo, tenants: tenants}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, name string, tenantID string, scopes []Scope) (*CreatedAPIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	if err := validateScopes(scopes); err != nil {
+		return nil, err
+	}
+
+	callerTenant := TenantFromContext(ctx)
+	tenantID = strings.TrimSpace(tenantID)
+	if tenantID == "" {
+		tenantID = callerTenant
+	}
+	platform := isPlatform(ctx)
+	if tenantID != callerTenant && !platform {
+		return nil, fmt.Errorf("%w: cannot issue keys for another tenant", ErrForbidden)
+	}
+	for _, scope := range scopes {
+		if scope == ScopePlatform && !platform {
+			return nil, fmt.Errorf("%w: cannot grant the platform scope", ErrForbidden)
+		}
+	}
+	if _, err := s.tenants.GetByID(ctx, tenantID); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("%w: unknown tenant %s", ErrValidation, tenantID)
+		}
+		return nil, fmt.Errorf("get tenant: %w", err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generate API key: %w", err)
+	}
+	token := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	stored, err := s.repo.Create(ctx, APIKey{
+		ID:        uuid.NewString(),
+		TenantID:  tenantID,
+		Name:      name,
+		Hash:      HashAPIKey(token),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("store API key: %w", err)
+	}
+
+	return &CreatedAPIKey{APIKey: *stored, Token: token}, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context) ([]APIKey, error) {
+	keys, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list API keys: %w", err)
+	}
+	if isPlatform(ctx) {
+		return keys, nil
+	}
+
+	tenantID := TenantFromContext(ctx)
+	visible := make([]APIKey, 0, len(keys))
+	for _, key := range keys {
+		if key.TenantID == tenantID {
+			visible = append(visible, key)
+		}
+	}
+	return visible, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id string) (*APIKey, error) {
+	key, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get API key: %w", err)
+	}
+	if key.TenantID != TenantFromContext(ctx) && !isPlatform(ctx) {
+		return nil, ErrNotFound
+	}
+	if key.RevokedAt == nil {
+		now := time.Now().UTC()
+		key.RevokedAt = &now
+	}
+
+	stored, err := s.repo.Update(ctx, *key)
+	if err != nil {
+		return nil, fmt.Errorf("update API key: %w", err)
+	}
+	return stored, nil
+}
+
+// SeedAPIKey stores a key for an operator-supplied token, used to bootstrap
+// the first admin key from configuration.
+func SeedAPIKey(ctx context.Context, repo APIKeyRepository, name string, tenantID string, token string, scopes []Scope) error {
+	if _, err := repo.GetByHash(ctx, HashAPIKey(token)); err == nil {
+		return nil
+	}
+	_, err := repo.Create(ctx, APIKey{
+		ID:        uuid.NewString(),
+		TenantID:  tenantID,
+		Name:      name,
+		Hash:      HashAPIKey(token),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+	})
+	return err
+}
+
+// ParseScopes converts scope names, rejecting unknown ones.
+func ParseScopes(names []string) ([]Scope, error) {
+	scopes := make([]Scope, 0, len(names))
+	for _, name := range names {
+		scopes = append(scopes, Scope(strings.TrimSpace(name)))
+	}
+	if err := validateScopes(scopes); err != nil {
+		return nil, err
+	}
+	return scopes, nil
+}
+
+// KnownScope reports whether name is a scope this service grants.
+func KnownScope(name string) (Scope, bool) {
+	scope := Scope(strings.TrimSpace(name))
+	_, ok := knownScopes[scope]
+	return scope, ok
+}
+
+func validateScopes(scopes []Scope) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrValidation)
+	}
+	for _, scope := range scopes {
+		if _, ok := knownScopes[scope]; !ok {
+			return fmt.Errorf("%w: unknown scope %q", ErrValidation, scope)
+		}
+	}
+	return nil
+}
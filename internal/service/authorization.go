@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const AuditOperationSignAuthorization = "sign_authorization"
+
+// Authorization is a signed EIP-7702 tuple that delegates the signing
+// account's code to Address. ChainID 0 would make it valid on every chain,
+// so the service only signs authorizations for the wallet's own chain, but
+// accepts chain-agnostic ones signed elsewhere in a transaction's list.
+type Authorization struct {
+	ChainID int64  `json:"chainId"`
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	YParity uint8  `json:"yParity"`
+	R       string `json:"r"`
+	S       string `json:"s"`
+}
+
+// AuthorizationRequest asks a wallet to delegate its code to Address.
+// ChainID defaults to the wallet's network and Nonce to the account's
+// pending nonce. When the wallet also sends the set-code transaction
+// carrying the authorization, its nonce is consumed first, so Nonce must
+// be one higher than the transaction's.
+type AuthorizationRequest struct {
+	Address string  `json:"address"`
+	ChainID int64   `json:"chainId,omitempty"`
+	Nonce   *uint64 `json:"nonce,omitempty"`
+}
+
+func (s *walletService) SignAuthorization(ctx context.Context, walletID string, req AuthorizationRequest) (*Authorization, error) {
+	authorization, err := s.signAuthorization(ctx, walletID, req)
+
+	var result string
+	if authorization != nil {
+		result = fmt.Sprintf("%s%s%02x", authorization.R, strings.TrimPrefix(authorization.S, "0x"), authorization.YParity)
+	}
+	encoded, _ := json.Marshal(req)
+	entry := AuditEntry{
+		WalletID:    walletID,
+		Operation:   AuditOperationSignAuthorization,
+		PayloadHash: hashPayload(encoded),
+		Result:      result,
+		Delegate:    strings.TrimSpace(req.Address),
+	}
+	if auditErr := s.recordEntry(ctx, entry, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return authorization, err
+}
+
+func (s *walletService) signAuthorization(ctx context.Context, walletID string, req AuthorizationRequest) (*Authorization, error) {
+	if s.registry == nil {
+		return nil, fmt.Errorf("%w: authorizations are not configured", ErrNotImplemented)
+	}
+	req.Address = strings.TrimSpace(req.Address)
+	if !addressPattern.MatchString(req.Address) {
+		return nil, fmt.Errorf("%w: invalid delegate address", ErrValidation)
+	}
+
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	if err := requireEthereum(record); err != nil {
+		return nil, err
+	}
+	if record.SmartAccount != nil {
+		return nil, fmt.Errorf("%w: smart account wallets cannot delegate their code", ErrValidation)
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	if req.ChainID == 0 {
+		req.ChainID = network.ChainID
+	}
+	if req.ChainID != network.ChainID {
+		return nil, fmt.Errorf("%w: chainId %d does not match network %s", ErrValidation, req.ChainID, record.Network)
+	}
+
+	// Delegating hands the account to the delegate's code, so the delegate
+	// must pass the wallet's destination rules like a transaction's
+	// recipient, and expressions see it in tx.delegates. Authorizations
+	// cannot wait for approval.
+	delegation := &Transaction{
+		ChainID:           req.ChainID,
+		From:              record.Address,
+		To:                req.Address,
+		Value:             "0x0",
+		AuthorizationList: []Authorization{{ChainID: req.ChainID, Address: req.Address}},
+	}
+	if _, err := s.checkUnqueuedPolicies(ctx, record, delegation, "authorizations"); err != nil {
+		return nil, err
+	}
+
+	authorization := &Authorization{ChainID: req.ChainID, Address: req.Address}
+	if req.Nonce != nil {
+		authorization.Nonce = *req.Nonce
+	} else {
+		if s.chain == nil {
+			return nil, fmt.Errorf("%w: nonce is required when chain access is not configured", ErrValidation)
+		}
+		nonce, err := s.chain.PendingNonce(ctx, network.RPCURL, record.Address)
+		if err != nil {
+			return nil, fmt.Errorf("read nonce: %w", err)
+		}
+		authorization.Nonce = nonce
+	}
+
+	signed, err := s.signer.SignAuthorization(record.PrivKey, authorization)
+	if err != nil {
+		return nil, fmt.Errorf("sign authorization: %w", err)
+	}
+	return signed, nil
+}
+
+// validateAuthorizationList checks the authorizations of a set-code
+// transaction.
+func validateAuthorizationList(tx *Transaction) error {
+	if len(tx.AuthorizationList) == 0 {
+		return nil
+	}
+	if strings.TrimSpace(tx.To) == "" {
+		return fmt.Errorf("%w: set-code transactions cannot create contracts", ErrValidation)
+	}
+	for i, authorization := range tx.AuthorizationList {
+		if authorization.ChainID != 0 && authorization.ChainID != tx.ChainID {
+			return fmt.Errorf("%w: authorization %d is for chain %d", ErrValidation, i, authorization.ChainID)
+		}
+		if !addressPattern.MatchString(strings.TrimSpace(authorization.Address)) {
+			return fmt.Errorf("%w: authorization %d has an invalid address", ErrValidation, i)
+		}
+		if authorization.YParity > 1 {
+			return fmt.Errorf("%w: authorization %d has an invalid yParity", ErrValidation, i)
+		}
+		if !signatureWordPattern.MatchString(authorization.R) || !signatureWordPattern.MatchString(authorization.S) {
+			return fmt.Errorf("%w: authorization %d has an invalid signature", ErrValidation, i)
+		}
+	}
+	return nil
+}
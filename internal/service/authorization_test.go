@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSignAuthorizationDefaultsChainAndNonce(t *testing.T) {
+	audit := &stubAuditLog{}
+	svc := NewWalletService(newStubRepo(), &stubSigner{},
+		WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}),
+		WithAuditLog(audit),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	authorization, err := svc.SignAuthorization(ctx, wallet.ID, AuthorizationRequest{
+		Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	})
+	if err != nil {
+		t.Fatalf("SignAuthorization returned error: %v", err)
+	}
+	if authorization.ChainID != 11155111 || authorization.Nonce != 7 {
+		t.Fatalf("unexpected authorization: %+v", authorization)
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Operation != AuditOperationSignAuthorization {
+		t.Fatalf("expected one audit entry, got %+v", audit.entries)
+	}
+
+	nonce := uint64(8)
+	authorization, err = svc.SignAuthorization(ctx, wallet.ID, AuthorizationRequest{
+		Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Nonce:   &nonce,
+	})
+	if err != nil || authorization.Nonce != 8 {
+		t.Fatalf("expected explicit nonce, got %+v, %v", authorization, err)
+	}
+
+	_, err = svc.SignAuthorization(ctx, wallet.ID, AuthorizationRequest{
+		Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		ChainID: 1,
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for another chain, got %v", err)
+	}
+}
+
+func TestSignAuthorizationChecksTheDelegate(t *testing.T) {
+	repo := newStubRepo()
+	audit := &stubAuditLog{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{},
+		WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}),
+		WithPolicies(policies),
+		WithAuditLog(audit),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "delegates",
+		Rules: PolicyRules{AllowedDestinations: []string{"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	var violation *PolicyViolation
+	_, err = svc.SignAuthorization(ctx, wallet.ID, AuthorizationRequest{Address: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"})
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleAllowedDestinations {
+		t.Fatalf("expected an unlisted delegate to be refused, got %v", err)
+	}
+	if _, err := svc.SignAuthorization(ctx, wallet.ID, AuthorizationRequest{Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"}); err != nil {
+		t.Fatalf("SignAuthorization returned error: %v", err)
+	}
+	if len(audit.entries) != 2 || audit.entries[0].Outcome != AuditOutcomeFailure || audit.entries[1].Delegate != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Fatalf("expected the delegate in the audit log, got %+v", audit.entries)
+	}
+}
+
+func TestValidateTransactionChecksAuthorizationList(t *testing.T) {
+	word := "0x" + strings.Repeat("11", 32)
+	valid := func() *Transaction {
+		return &Transaction{
+			ChainID:  11155111,
+			To:       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Value:    "0x0",
+			GasLimit: 100000,
+			GasPrice: "0x3b9aca00",
+			Nonce:    1,
+			AuthorizationList: []Authorization{{
+				ChainID: 11155111,
+				Address: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				Nonce:   2,
+				R:       word,
+				S:       word,
+			}},
+		}
+	}
+	if err := ValidateTransaction(valid()); err != nil {
+		t.Fatalf("expected valid set-code transaction, got %v", err)
+	}
+
+	cases := map[string]func(tx *Transaction){
+		"other chain":   func(tx *Transaction) { tx.AuthorizationList[0].ChainID = 1 },
+		"bad address":   func(tx *Transaction) { tx.AuthorizationList[0].Address = "0x1234" },
+		"bad y parity":  func(tx *Transaction) { tx.AuthorizationList[0].YParity = 27 },
+		"missing r":     func(tx *Transaction) { tx.AuthorizationList[0].R = "" },
+		"tip above cap": func(tx *Transaction) { tx.MaxPriorityFeePerGas = "0x3b9aca01" },
+		"tip without list": func(tx *Transaction) {
+			tx.AuthorizationList = nil
+			tx.MaxPriorityFeePerGas = "0x1"
+		},
+	}
+	for name, mutate := range cases {
+		tx := valid()
+		mutate(tx)
+		if err := ValidateTransaction(tx); !errors.Is(err, ErrValidation) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+
+	tx := valid()
+	tx.AuthorizationList[0].ChainID = 0
+	if err := ValidateTransaction(tx); err != nil {
+		t.Fatalf("expected chain-agnostic authorization to be accepted, got %v", err)
+	}
+}
@@ -3,16 +3,30 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
 type BalanceRepository interface {
-	GetByID(ctx context.Context, id string) (*WalletRecord, error)
+	GetByID(ctx context.Context, tenantID string, id string) (*WalletRecord, error)
 }
 
 type BalanceFetcher interface {
 	FetchBalance(ctx context.Context, rpcURL string, address string) (string, error)
 }
 
+// DenomBalanceFetcher reads the balance of one denomination, on chains
+// whose accounts hold several. The denomination is the network's
+// NativeAsset.
+type DenomBalanceFetcher interface {
+	FetchDenomBalance(ctx context.Context, apiURL string, address string, denom string) (string, error)
+}
+
+// TokenBalanceFetcher reads the balance of a token contract, on chain
+// families that support token balances.
+type TokenBalanceFetcher interface {
+	FetchTokenBalance(ctx context.Context, apiURL string, address string, contract string) (string, error)
+}
+
 type NetworkRegistry interface {
 	Lookup(network string) (*Network, error)
 }
@@ -22,44 +36,155 @@ type Network struct {
 	ChainID     int64
 	RPCURL      string
 	NativeAsset string
+	// Family is the chain family of the network. ChainID only applies to
+	// Ethereum networks.
+	Family ChainFamily
+	// CosmosChainID and Bech32Prefix are set on Cosmos networks, whose
+	// NativeAsset is the staking denomination.
+	CosmosChainID string
+	Bech32Prefix  string
+	// BitcoinChain names the chain parameters of Bitcoin networks, such as
+	// "signet".
+	BitcoinChain string
+	// Decimals is the number of decimals of NativeAsset.
+	Decimals    uint8
+	ExplorerURL string
+	// Disabled networks accept no new wallets.
+	Disabled bool
+	// BundlerURL is the ERC-4337 bundler RPC, if one is configured.
+	BundlerURL string
+	// Tokens maps upper-case symbols to known ERC-20 contracts.
+	Tokens map[string]Token
+	// AccountFactories maps names to smart account factories.
+	AccountFactories map[string]AccountFactory
 }
 
 type balanceService struct {
 	repo     BalanceRepository
 	fetcher  BalanceFetcher
 	registry NetworkRegistry
+	families map[ChainFamily]fetchBalanceFunc
+	tokens   map[ChainFamily]TokenBalanceFetcher
+}
+
+type fetchBalanceFunc func(ctx context.Context, network *Network, address string) (string, error)
+
+// BalanceServiceOption customises a balance service at construction time.
+type BalanceServiceOption func(*balanceService)
+
+// WithFamilyBalanceFetcher reads balances on networks of family through
+// fetcher instead of the default one.
+func WithFamilyBalanceFetcher(family ChainFamily, fetcher BalanceFetcher) BalanceServiceOption {
+	return func(s *balanceService) {
+		s.families[family] = func(ctx context.Context, network *Network, address string) (string, error) {
+			return fetcher.FetchBalance(ctx, network.RPCURL, address)
+		}
+	}
+}
+
+// WithFamilyDenomBalanceFetcher reads balances on networks of family
+// through fetcher, in the network's native denomination.
+func WithFamilyDenomBalanceFetcher(family ChainFamily, fetcher DenomBalanceFetcher) BalanceServiceOption {
+	return func(s *balanceService) {
+		s.families[family] = func(ctx context.Context, network *Network, address string) (string, error) {
+			return fetcher.FetchDenomBalance(ctx, network.RPCURL, address, network.NativeAsset)
+		}
+	}
+}
+
+// WithFamilyTokenBalanceFetcher reads token balances on networks of family
+// through fetcher.
+func WithFamilyTokenBalanceFetcher(family ChainFamily, fetcher TokenBalanceFetcher) BalanceServiceOption {
+	return func(s *balanceService) {
+		s.tokens[family] = fetcher
+	}
+}
+
+// BalanceOption selects what GetBalance reads.
+type BalanceOption func(*balanceOptions)
+
+type balanceOptions struct {
+	token string
+}
+
+// WithBalanceToken reads the balance of token instead of the native asset.
+// Token is a symbol from the network's registry or a contract address.
+func WithBalanceToken(token string) BalanceOption {
+	return func(o *balanceOptions) {
+		o.token = strings.TrimSpace(token)
+	}
 }
 
 type BalanceService interface {
-	GetBalance(ctx context.Context, walletID string) (*Balance, error)
+	GetBalance(ctx context.Context, walletID string, opts ...BalanceOption) (*Balance, error)
 }
 
-func NewBalanceService(repo BalanceRepository, fetcher BalanceFetcher, registry NetworkRegistry) BalanceService {
-	return &balanceService{
+func NewBalanceService(repo BalanceRepository, fetcher BalanceFetcher, registry NetworkRegistry, opts ...BalanceServiceOption) BalanceService {
+	svc := &balanceService{
 		repo:     repo,
 		fetcher:  fetcher,
 		registry: registry,
+		families: make(map[ChainFamily]fetchBalanceFunc),
+		tokens:   make(map[ChainFamily]TokenBalanceFetcher),
+	}
+	for _, opt := range opts {
+		opt(svc)
 	}
+	return svc
 }
 
-func (s *balanceService) GetBalance(ctx context.Context, walletID string) (*Balance, error) {
-	record, err := s.repo.GetByID(ctx, walletID)
+func (s *balanceService) GetBalance(ctx context.Context, walletID string, opts ...BalanceOption) (*Balance, error) {
+	var options balanceOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	record, err := s.repo.GetByID(ctx, TenantFromContext(ctx), walletID)
 	if err != nil {
 		if err == ErrNotFound {
 			return nil, ErrNotFound
 		}
 		return nil, fmt.Errorf("get wallet: %w", err)
 	}
+	if !walletVisible(ctx, record.ID) {
+		return nil, ErrNotFound
+	}
 
 	network, err := s.registry.Lookup(record.Network)
 	if err != nil {
 		return nil, fmt.Errorf("lookup network: %w", err)
 	}
 
-	amount, err := s.fetcher.FetchBalance(ctx, network.RPCURL, record.Address)
+	if options.token != "" {
+		return s.tokenBalance(ctx, network, record.Address, options.token)
+	}
+
+	var amount string
+	if fetch, ok := s.families[network.Family]; ok {
+		amount, err = fetch(ctx, network, record.Address)
+	} else {
+		amount, err = s.fetcher.FetchBalance(ctx, network.RPCURL, record.Address)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("fetch balance: %w", err)
 	}
 
 	return &Balance{Asset: network.NativeAsset, Amount: amount}, nil
 }
+
+func (s *balanceService) tokenBalance(ctx context.Context, network *Network, address string, ref string) (*Balance, error) {
+	fetcher, ok := s.tokens[network.Family]
+	if !ok {
+		return nil, fmt.Errorf("%w: token balances are not supported on %s networks", ErrValidation, network.Family)
+	}
+
+	asset, contract := ref, ref
+	if token, ok := network.Tokens[strings.ToUpper(ref)]; ok {
+		asset, contract = token.Symbol, token.Address
+	}
+	amount, err := fetcher.FetchTokenBalance(ctx, network.RPCURL, address, contract)
+	if err != nil {
+		return nil, fmt.Errorf("fetch token balance: %w", err)
+	}
+	return &Balance{Asset: asset, Amount: amount}, nil
+}
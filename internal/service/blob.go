@@ -0,0 +1,49 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// BlobSize is the size of an EIP-4844 blob in bytes. Shorter blob data
+	// is zero-padded.
+	BlobSize = 131072
+	// MaxBlobsPerTransaction is the per-transaction blob limit since the
+	// Osaka upgrade.
+	MaxBlobsPerTransaction = 6
+)
+
+// validateBlobs checks the blobs and blob fee of a blob transaction.
+func validateBlobs(tx *Transaction) error {
+	if len(tx.Blobs) == 0 {
+		if strings.TrimSpace(tx.MaxFeePerBlobGas) != "" {
+			return fmt.Errorf("%w: maxFeePerBlobGas only applies to blob transactions", ErrValidation)
+		}
+		return nil
+	}
+	if len(tx.AuthorizationList) > 0 {
+		return fmt.Errorf("%w: a transaction cannot carry both blobs and an authorization list", ErrValidation)
+	}
+	if strings.TrimSpace(tx.To) == "" {
+		return fmt.Errorf("%w: blob transactions cannot create contracts", ErrValidation)
+	}
+	if len(tx.Blobs) > MaxBlobsPerTransaction {
+		return fmt.Errorf("%w: at most %d blobs per transaction", ErrValidation, MaxBlobsPerTransaction)
+	}
+	if strings.TrimSpace(tx.MaxFeePerBlobGas) == "" {
+		return fmt.Errorf("%w: maxFeePerBlobGas required", ErrValidation)
+	}
+	if _, err := parseHexQuantity(tx.MaxFeePerBlobGas); err != nil {
+		return fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	for i, blob := range tx.Blobs {
+		if !hexDataPattern.MatchString(blob) || blob == "0x" {
+			return fmt.Errorf("%w: blob %d must be non-empty 0x hex", ErrValidation, i)
+		}
+		if len(blob)/2-1 > BlobSize {
+			return fmt.Errorf("%w: blob %d exceeds %d bytes", ErrValidation, i, BlobSize)
+		}
+	}
+	return nil
+}
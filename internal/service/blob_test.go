@@ -0,0 +1,84 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+)
+
+func TestValidateTransactionChecksBlobs(t *testing.T) {
+	valid := func() *Transaction {
+		return &Transaction{
+			ChainID:              11155111,
+			To:                   "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Value:                "0x0",
+			GasLimit:             21000,
+			GasPrice:             "0x3b9aca00",
+			MaxPriorityFeePerGas: "0x1",
+			Nonce:                1,
+			MaxFeePerBlobGas:     "0x1",
+			Blobs:                []string{"0x0001"},
+		}
+	}
+	if err := ValidateTransaction(valid()); err != nil {
+		t.Fatalf("expected valid blob transaction, got %v", err)
+	}
+
+	cases := map[string]func(tx *Transaction){
+		"missing blob fee": func(tx *Transaction) { tx.MaxFeePerBlobGas = "" },
+		"blob fee without blobs": func(tx *Transaction) {
+			tx.Blobs = nil
+			tx.MaxPriorityFeePerGas = ""
+		},
+		"empty blob":    func(tx *Transaction) { tx.Blobs = []string{"0x"} },
+		"odd blob":      func(tx *Transaction) { tx.Blobs = []string{"0x123"} },
+		"oversize blob": func(tx *Transaction) { tx.Blobs = []string{"0x" + strings.Repeat("00", BlobSize+1)} },
+		"too many blobs": func(tx *Transaction) {
+			tx.Blobs = make([]string, MaxBlobsPerTransaction+1)
+			for i := range tx.Blobs {
+				tx.Blobs[i] = "0x00"
+			}
+		},
+		"with authorizations": func(tx *Transaction) {
+			tx.AuthorizationList = []Authorization{{
+				ChainID: 11155111,
+				Address: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				R:       "0x1",
+				S:       "0x1",
+			}}
+		},
+	}
+	for name, mutate := range cases {
+		tx := valid()
+		mutate(tx)
+		if err := ValidateTransaction(tx); !errors.Is(err, ErrValidation) {
+			t.Errorf("%s: expected validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestTransactionHashIgnoresBlobSidecar(t *testing.T) {
+	tx := types.NewTx(&types.BlobTx{
+		ChainID:    uint256.NewInt(11155111),
+		Nonce:      1,
+		GasTipCap:  uint256.NewInt(1),
+		GasFeeCap:  uint256.NewInt(1_000_000_000),
+		Gas:        21000,
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: []common.Hash{{0x01}},
+		Sidecar:    &types.BlobTxSidecar{Blobs: []kzg4844.Blob{{}}, Commitments: []kzg4844.Commitment{{}}, Proofs: []kzg4844.Proof{{}}},
+	})
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned error: %v", err)
+	}
+	if got := transactionHash(hexutil.Encode(raw)); got != tx.Hash().Hex() {
+		t.Fatalf("expected hash %s, got %s", tx.Hash().Hex(), got)
+	}
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ChainFamily groups networks that share a key type, address format and
+// transaction encoding.
+type ChainFamily string
+
+const (
+	ChainFamilyEthereum ChainFamily = "ethereum"
+	ChainFamilySolana   ChainFamily = "solana"
+	ChainFamilyBitcoin  ChainFamily = "bitcoin"
+	ChainFamilyCosmos   ChainFamily = "cosmos"
+	ChainFamilyTron     ChainFamily = "tron"
+
+	AuditOperationSignSerializedTransaction = "sign_serialized_transaction"
+)
+
+// AddressType selects the script a wallet's address pays to, on chain
+// families that have more than one.
+type AddressType string
+
+const (
+	AddressTypeP2WPKH AddressType = "p2wpkh"
+	AddressTypeP2TR   AddressType = "p2tr"
+)
+
+// WithAddressType selects the address type of a wallet. It defaults to the
+// family's preferred type and is rejected on families with only one.
+func WithAddressType(addressType AddressType) CreateWalletOption {
+	return func(o *createWalletOptions) {
+		o.addressType = AddressType(strings.ToLower(strings.TrimSpace(string(addressType))))
+	}
+}
+
+// FamilySigner creates wallets and signs for a chain family other than
+// Ethereum, whose signer is the one passed to NewWalletService. Signing
+// methods receive the whole record because some families sign differently
+// depending on the address type.
+type FamilySigner interface {
+	// NewWallet creates a key whose address is of addressType, or of the
+	// family's default type when it is empty.
+	NewWallet(network string, addressType AddressType) (*WalletRecord, error)
+	SignMessage(record *WalletRecord, payload []byte) (*SignatureOutput, error)
+	// SignSerializedTransaction adds the wallet's signature to a transaction
+	// in the family's wire encoding.
+	SignSerializedTransaction(record *WalletRecord, tx string) (*SerializedTransactionResult, error)
+}
+
+// SerializedTransactionRequest carries a transaction in its chain's wire
+// encoding. Solana transactions are base64, with a placeholder for every
+// required signature. Cosmos transactions are base64 SignDocs, and Tron
+// transactions the hex raw_data.
+type SerializedTransactionRequest struct {
+	Transaction string `json:"transaction"`
+}
+
+// SerializedTransactionResult holds the signed transaction, in the encoding
+// it was submitted in, and the wallet's signature over it. TxID is set on
+// chains that identify transactions by a hash other than the signature.
+type SerializedTransactionResult struct {
+	Transaction string `json:"transaction"`
+	Signature   string `json:"signature"`
+	TxID        string `json:"txId,omitempty"`
+}
+
+// WithChainFamily lets wallets be created on networks of family, which the
+// registry reports through Network.Family.
+func WithChainFamily(family ChainFamily, signer FamilySigner, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		if s.families == nil {
+			s.families = make(map[ChainFamily]FamilySigner)
+		}
+		s.families[family] = signer
+		s.registry = registry
+	}
+}
+
+// networkFamily returns the chain family of a network wallets may be
+// created on. Without a registry every network is treated as Ethereum.
+func (s *walletService) networkFamily(network string) (ChainFamily, error) {
+	if s.registry == nil {
+		return ChainFamilyEthereum, nil
+	}
+	resolved, err := s.registry.Lookup(network)
+	if err != nil {
+		return "", fmt.Errorf("%w: network %s is not registered", ErrValidation, network)
+	}
+	if resolved.Disabled {
+		return "", fmt.Errorf("%w: network %s is disabled", ErrValidation, network)
+	}
+	if resolved.Family == "" {
+		return ChainFamilyEthereum, nil
+	}
+	return resolved.Family, nil
+}
+
+func (s *walletService) newWallet(family ChainFamily, network string, addressType AddressType) (*WalletRecord, error) {
+	if family == ChainFamilyEthereum {
+		return s.signer.NewWallet(network)
+	}
+	signer, err := s.familySigner(family)
+	if err != nil {
+		return nil, err
+	}
+	return signer.NewWallet(network, addressType)
+}
+
+func (s *walletService) familySigner(family ChainFamily) (FamilySigner, error) {
+	signer, ok := s.families[family]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s networks are not configured", ErrNotImplemented, family)
+	}
+	return signer, nil
+}
+
+// walletFamily returns the chain family of a wallet. Records without a
+// family predate them and are Ethereum wallets.
+func walletFamily(record *WalletRecord) ChainFamily {
+	if record.Family == "" {
+		return ChainFamilyEthereum
+	}
+	return record.Family
+}
+
+// requireEthereum rejects wallets of other chain families from operations
+// that only exist on Ethereum. Records without a family predate them.
+func requireEthereum(record *WalletRecord) error {
+	if record.Family != "" && record.Family != ChainFamilyEthereum {
+		return fmt.Errorf("%w: %s wallets do not support this operation", ErrValidation, record.Family)
+	}
+	return nil
+}
+
+func (s *walletService) SignSerializedTransaction(ctx context.Context, walletID string, req SerializedTransactionRequest) (*SerializedTransactionResult, error) {
+	result, err := s.signSerializedTransaction(ctx, walletID, req)
+
+	var signature string
+	if result != nil {
+		signature = result.Signature
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignSerializedTransaction, hashPayload([]byte(req.Transaction)), signature, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return result, err
+}
+
+func (s *walletService) signSerializedTransaction(ctx context.Context, walletID string, req SerializedTransactionRequest) (*SerializedTransactionResult, error) {
+	req.Transaction = strings.TrimSpace(req.Transaction)
+	if req.Transaction == "" {
+		return nil, fmt.Errorf("%w: transaction is required", ErrValidation)
+	}
+
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	if requireEthereum(record) == nil {
+		return nil, fmt.Errorf("%w: ethereum wallets sign transactions through SignTransaction", ErrValidation)
+	}
+	signer, err := s.familySigner(record.Family)
+	if err != nil {
+		return nil, err
+	}
+	// Serialized transactions are not decoded, so policies governing the
+	// wallet cannot be evaluated and refuse them instead.
+	if s.policies != nil {
+		if err := s.policies.CheckUndecoded(ctx, record, "serialized "+string(record.Family)+" transactions"); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := signer.SignSerializedTransaction(record, req.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("sign transaction: %w", err)
+	}
+	return result, nil
+}
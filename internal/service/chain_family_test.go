@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type familyRegistry struct{}
+
+func (familyRegistry) Lookup(network string) (*Network, error) {
+	if network == "solana-devnet" {
+		return &Network{Name: network, Family: ChainFamilySolana, RPCURL: "http://solana", NativeAsset: "SOL"}, nil
+	}
+	if network == "bitcoin-signet" {
+		return &Network{Name: network, Family: ChainFamilyBitcoin, RPCURL: "http://esplora", NativeAsset: "BTC"}, nil
+	}
+	if network == "tron-nile" {
+		return &Network{Name: network, Family: ChainFamilyTron, RPCURL: "http://tron", NativeAsset: "TRX", Tokens: map[string]Token{
+			"USDT": {Symbol: "USDT", Address: "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf", Decimals: 6},
+		}}, nil
+	}
+	if network == "osmosis-testnet" {
+		return &Network{Name: network, Family: ChainFamilyCosmos, CosmosChainID: "osmo-test-5", Bech32Prefix: "osmo", RPCURL: "http://lcd", NativeAsset: "uosmo"}, nil
+	}
+	return &Network{Name: network, Family: ChainFamilyEthereum, ChainID: 11155111, RPCURL: "http://" + network, NativeAsset: "ETH"}, nil
+}
+
+type stubFamilySigner struct {
+	signedMessage []byte
+}
+
+func (s *stubFamilySigner) NewWallet(network string, _ AddressType) (*WalletRecord, error) {
+	return &WalletRecord{
+		Network:   network,
+		Address:   "7EcDhSYGxXyscszYEp35KHN8vvw3svAuLKTzXwCFLtV",
+		PublicKey: "7EcDhSYGxXyscszYEp35KHN8vvw3svAuLKTzXwCFLtV",
+		PrivKey:   "seed",
+	}, nil
+}
+
+func (s *stubFamilySigner) SignMessage(_ *WalletRecord, payload []byte) (*SignatureOutput, error) {
+	s.signedMessage = payload
+	return &SignatureOutput{Signature: "base58-signature"}, nil
+}
+
+func (s *stubFamilySigner) SignSerializedTransaction(_ *WalletRecord, tx string) (*SerializedTransactionResult, error) {
+	return &SerializedTransactionResult{Transaction: tx + "-signed", Signature: "base58-signature"}, nil
+}
+
+type stubBalanceFetcher struct {
+	address string
+	amount  string
+}
+
+func (f *stubBalanceFetcher) FetchBalance(_ context.Context, _ string, address string) (string, error) {
+	f.address = address
+	return f.amount, nil
+}
+
+type stubTokenBalanceFetcher struct {
+	stubBalanceFetcher
+	contract string
+}
+
+func (f *stubTokenBalanceFetcher) FetchTokenBalance(_ context.Context, _ string, address string, contract string) (string, error) {
+	f.address, f.contract = address, contract
+	return f.amount, nil
+}
+
+type stubDenomBalanceFetcher struct {
+	stubBalanceFetcher
+	apiURL string
+	denom  string
+}
+
+func (f *stubDenomBalanceFetcher) FetchDenomBalance(_ context.Context, apiURL string, address string, denom string) (string, error) {
+	f.apiURL, f.address, f.denom = apiURL, address, denom
+	return f.amount, nil
+}
+
+func TestChainFamilyDispatchesToFamilySigner(t *testing.T) {
+	signer := &stubSigner{}
+	solana := &stubFamilySigner{}
+	audit := &stubAuditLog{}
+	svc := NewWalletService(newStubRepo(), signer,
+		WithChainFamily(ChainFamilySolana, solana, familyRegistry{}),
+		WithAuditLog(audit),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "solana-devnet")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if wallet.Family != ChainFamilySolana || wallet.Address != "7EcDhSYGxXyscszYEp35KHN8vvw3svAuLKTzXwCFLtV" {
+		t.Fatalf("unexpected wallet: %+v", wallet)
+	}
+	if signer.lastNetwork != "" {
+		t.Fatal("expected the ethereum signer not to be used")
+	}
+
+	output, err := svc.SignMessage(ctx, wallet.ID, []byte("hello"))
+	if err != nil || output.Signature != "base58-signature" || string(solana.signedMessage) != "hello" {
+		t.Fatalf("expected the family signer to sign, got %+v, %v", output, err)
+	}
+
+	result, err := svc.SignSerializedTransaction(ctx, wallet.ID, SerializedTransactionRequest{Transaction: "AQID"})
+	if err != nil || result.Transaction != "AQID-signed" {
+		t.Fatalf("unexpected serialized result %+v, %v", result, err)
+	}
+	if last := audit.entries[len(audit.entries)-1]; last.Operation != AuditOperationSignSerializedTransaction {
+		t.Fatalf("expected a serialized transaction audit entry, got %s", last.Operation)
+	}
+
+	tx := &Transaction{ChainID: 1, To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "0x0", GasLimit: 21000, GasPrice: "0x1", Nonce: 1}
+	if _, err := svc.SignTransaction(ctx, wallet.ID, tx); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ethereum signing to be refused, got %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "solana-devnet", WithAccountType(AccountTypeSmart)); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected smart accounts to be refused on solana, got %v", err)
+	}
+
+	eth, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil || eth.Family != ChainFamilyEthereum {
+		t.Fatalf("expected an ethereum wallet, got %+v, %v", eth, err)
+	}
+	if _, err := svc.SignSerializedTransaction(ctx, eth.ID, SerializedTransactionRequest{Transaction: "AQID"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected serialized signing to be refused for ethereum wallets, got %v", err)
+	}
+}
+
+func TestSerializedTransactionsRefuseGovernedWallets(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{},
+		WithChainFamily(ChainFamilySolana, &stubFamilySigner{}, familyRegistry{}),
+		WithPolicies(policies),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "solana-devnet")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := svc.SignSerializedTransaction(ctx, wallet.ID, SerializedTransactionRequest{Transaction: "AQID"}); err != nil {
+		t.Fatalf("expected an ungoverned wallet to sign, got %v", err)
+	}
+
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{Name: "cap", WalletID: wallet.ID, Rules: PolicyRules{MaxValue: "1000"}}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	var violation *PolicyViolation
+	if _, err := svc.SignSerializedTransaction(ctx, wallet.ID, SerializedTransactionRequest{Transaction: "AQID"}); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected the governed wallet to refuse, got %v", err)
+	}
+}
+
+func TestCreateWalletRequiresConfiguredFamily(t *testing.T) {
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithTokenChain(&stubTokenChain{}, familyRegistry{}))
+
+	if _, err := svc.CreateWallet(context.Background(), "solana-devnet"); !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("expected ErrNotImplemented without a solana signer, got %v", err)
+	}
+}
+
+func TestGetBalanceUsesFamilyFetcher(t *testing.T) {
+	repo := newStubRepo()
+	svc := NewWalletService(repo, &stubSigner{}, WithChainFamily(ChainFamilySolana, &stubFamilySigner{}, familyRegistry{}))
+	ctx := context.Background()
+	wallet, err := svc.CreateWallet(ctx, "solana-devnet")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	ethereum := &stubBalanceFetcher{amount: "1"}
+	solana := &stubBalanceFetcher{amount: "1500000000"}
+	balances := NewBalanceService(repo, ethereum, familyRegistry{}, WithFamilyBalanceFetcher(ChainFamilySolana, solana))
+
+	balance, err := balances.GetBalance(ctx, wallet.ID)
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if balance.Asset != "SOL" || balance.Amount != "1500000000" || solana.address != wallet.Address || ethereum.address != "" {
+		t.Fatalf("unexpected balance %+v", balance)
+	}
+}
+
+func TestGetBalanceUsesDenomFetcher(t *testing.T) {
+	repo := newStubRepo()
+	svc := NewWalletService(repo, &stubSigner{}, WithChainFamily(ChainFamilyCosmos, &stubFamilySigner{}, familyRegistry{}))
+	ctx := context.Background()
+	wallet, err := svc.CreateWallet(ctx, "osmosis-testnet")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	cosmos := &stubDenomBalanceFetcher{stubBalanceFetcher: stubBalanceFetcher{amount: "2500000"}}
+	balances := NewBalanceService(repo, &stubBalanceFetcher{}, familyRegistry{}, WithFamilyDenomBalanceFetcher(ChainFamilyCosmos, cosmos))
+
+	balance, err := balances.GetBalance(ctx, wallet.ID)
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if balance.Asset != "uosmo" || balance.Amount != "2500000" {
+		t.Fatalf("unexpected balance %+v", balance)
+	}
+	if cosmos.apiURL != "http://lcd" || cosmos.address != wallet.Address || cosmos.denom != "uosmo" {
+		t.Fatalf("unexpected fetch of %s from %s in %s", cosmos.address, cosmos.apiURL, cosmos.denom)
+	}
+}
+
+func TestGetBalanceReadsTokens(t *testing.T) {
+	repo := newStubRepo()
+	svc := NewWalletService(repo, &stubSigner{}, WithChainFamily(ChainFamilyTron, &stubFamilySigner{}, familyRegistry{}))
+	ctx := context.Background()
+	wallet, err := svc.CreateWallet(ctx, "tron-nile")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	ethereumWallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	tokens := &stubTokenBalanceFetcher{stubBalanceFetcher: stubBalanceFetcher{amount: "5000000"}}
+	balances := NewBalanceService(repo, &stubBalanceFetcher{}, familyRegistry{}, WithFamilyTokenBalanceFetcher(ChainFamilyTron, tokens))
+
+	balance, err := balances.GetBalance(ctx, wallet.ID, WithBalanceToken("usdt"))
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if balance.Asset != "USDT" || balance.Amount != "5000000" || tokens.contract != "TXYZopYRdj2D9XRtbG411XZZ3kM5VkAeBf" || tokens.address != wallet.Address {
+		t.Fatalf("unexpected balance %+v from %s", balance, tokens.contract)
+	}
+
+	balance, err = balances.GetBalance(ctx, wallet.ID, WithBalanceToken("TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t"))
+	if err != nil {
+		t.Fatalf("GetBalance returned error: %v", err)
+	}
+	if balance.Asset != "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t" || tokens.contract != "TR7NHqjeKQxGTCi8q8ZY4pL8otSzgjLj6t" {
+		t.Fatalf("unexpected balance %+v from %s", balance, tokens.contract)
+	}
+
+	if _, err := balances.GetBalance(ctx, ethereumWallet.ID, WithBalanceToken("USDC")); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for token balances on ethereum, got %v", err)
+	}
+}
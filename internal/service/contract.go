@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ContractCallMode string
+
+const (
+	// ContractCallModeSend signs a transaction that calls the contract.
+	ContractCallModeSend ContractCallMode = "send"
+	// ContractCallModeCall runs the call with eth_call and decodes the
+	// return values. Nothing is signed.
+	ContractCallModeCall ContractCallMode = "call"
+)
+
+// ContractCallRequest describes a call to Method of the contract at
+// Contract. ABI is a JSON ABI, a single JSON fragment, or a human-readable
+// signature such as "transfer(address,uint256)"; Method may be left empty
+// when it describes a single function. The transaction fields are used in
+// send mode.
+type ContractCallRequest struct {
+	Contract string            `json:"contract"`
+	ABI      string            `json:"abi"`
+	Method   string            `json:"method,omitempty"`
+	Args     []json.RawMessage `json:"args"`
+	Mode     ContractCallMode  `json:"mode,omitempty"`
+
+	ChainID  int64  `json:"chainId,omitempty"`
+	Value    string `json:"value,omitempty"`
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+	GasPrice string `json:"gasPrice,omitempty"`
+	Nonce    uint64 `json:"nonce,omitempty"`
+	// Force signs even when simulation shows the call reverting.
+	Force bool `json:"force,omitempty"`
+}
+
+// ContractOutput is a decoded return value. Value is JSON friendly: large
+// integers are decimal strings and bytes are 0x hex.
+type ContractOutput struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type ContractCallResult struct {
+	// Data is the encoded calldata.
+	Data              string           `json:"data"`
+	SignedTransaction string           `json:"signedTransaction,omitempty"`
+	Outputs           []ContractOutput `json:"outputs,omitempty"`
+}
+
+// ContractCodec encodes calldata from JSON arguments and decodes return data.
+type ContractCodec interface {
+	Encode(abi string, method string, args []json.RawMessage) (string, error)
+	Decode(abi string, method string, data string) ([]ContractOutput, error)
+}
+
+// ContractCaller executes a read-only call from the given address and
+// returns the raw return data. A revert is reported as a
+// *SimulationRevertedError.
+type ContractCaller interface {
+	Call(ctx context.Context, rpcURL string, from string, tx *Transaction) (string, error)
+}
+
+type ContractService interface {
+	ContractCall(ctx context.Context, walletID string, req ContractCallRequest) (*ContractCallResult, error)
+}
+
+type contractService struct {
+	wallets  WalletService
+	codec    ContractCodec
+	caller   ContractCaller
+	registry NetworkRegistry
+}
+
+// NewContractService signs contract calls through wallets, so they go through
+// the same policy, approval, simulation and audit checks as any transaction.
+func NewContractService(wallets WalletService, codec ContractCodec, caller ContractCaller, registry NetworkRegistry) ContractService {
+	return &contractService{wallets: wallets, codec: codec, caller: caller, registry: registry}
+}
+
+func (s *contractService) ContractCall(ctx context.Context, walletID string, req ContractCallRequest) (*ContractCallResult, error) {
+	req.Contract = strings.TrimSpace(req.Contract)
+	if !addressPattern.MatchString(req.Contract) {
+		return nil, fmt.Errorf("%w: invalid contract address", ErrValidation)
+	}
+	data, err := s.codec.Encode(req.ABI, req.Method, req.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	wallet, err := s.wallets.GetWallet(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	network, err := s.registry.Lookup(wallet.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+
+	tx := &Transaction{
+		ChainID:  req.ChainID,
+		From:     wallet.Address,
+		To:       req.Contract,
+		Value:    req.Value,
+		Data:     data,
+		GasLimit: req.GasLimit,
+		GasPrice: req.GasPrice,
+		Nonce:    req.Nonce,
+	}
+	if tx.ChainID == 0 {
+		tx.ChainID = network.ChainID
+	}
+	if tx.Value == "" {
+		tx.Value = "0x0"
+	}
+
+	switch req.Mode {
+	case ContractCallModeCall:
+		returned, err := s.caller.Call(ctx, network.RPCURL, wallet.Address, tx)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := s.codec.Decode(req.ABI, req.Method, returned)
+		if err != nil {
+			return nil, err
+		}
+		return &ContractCallResult{Data: data, Outputs: outputs}, nil
+	case "", ContractCallModeSend:
+		var opts []SignOption
+		if req.Force {
+			opts = append(opts, ForceSign())
+		}
+		signed, err := s.wallets.SignTransaction(ctx, walletID, tx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &ContractCallResult{Data: data, SignedTransaction: signed}, nil
+	default:
+		return nil, fmt.Errorf("%w: unknown mode %q", ErrValidation, req.Mode)
+	}
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type stubCodec struct{}
+
+func (stubCodec) Encode(abi string, method string, args []json.RawMessage) (string, error) {
+	if abi == "" {
+		return "", ErrValidation
+	}
+	return "0x70a08231", nil
+}
+
+func (stubCodec) Decode(abi string, method string, data string) ([]ContractOutput, error) {
+	return []ContractOutput{{Type: "uint256", Value: data}}, nil
+}
+
+type stubCaller struct {
+	err error
+	tx  *Transaction
+}
+
+func (c *stubCaller) Call(_ context.Context, _ string, _ string, tx *Transaction) (string, error) {
+	c.tx = tx
+	return "0x2a", c.err
+}
+
+func TestContractCallModes(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	wallets := NewWalletService(repo, signer)
+	caller := &stubCaller{}
+	svc := NewContractService(wallets, stubCodec{}, caller, stubRegistry{})
+	ctx := context.Background()
+
+	wallet, err := wallets.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	req := ContractCallRequest{
+		Contract: "0xcccccccccccccccccccccccccccccccccccccccc",
+		ABI:      "balanceOf(address) returns (uint256)",
+		Mode:     ContractCallModeCall,
+	}
+
+	result, err := svc.ContractCall(ctx, wallet.ID, req)
+	if err != nil {
+		t.Fatalf("ContractCall returned error: %v", err)
+	}
+	if result.Data != "0x70a08231" || len(result.Outputs) != 1 || result.Outputs[0].Value != "0x2a" || signer.lastTx != nil {
+		t.Fatalf("unexpected call result %+v", result)
+	}
+	if caller.tx.To != req.Contract || caller.tx.Data != result.Data {
+		t.Fatalf("unexpected call transaction %+v", caller.tx)
+	}
+
+	caller.err = &SimulationRevertedError{Result: &SimulationResult{RevertReason: "paused"}}
+	if _, err := svc.ContractCall(ctx, wallet.ID, req); !errors.Is(err, ErrSimulationReverted) {
+		t.Fatalf("expected a revert, got %v", err)
+	}
+
+	req.Mode = ContractCallModeSend
+	req.GasLimit, req.GasPrice, req.Nonce = 60000, "0x1", 1
+	result, err = svc.ContractCall(ctx, wallet.ID, req)
+	if err != nil {
+		t.Fatalf("ContractCall returned error: %v", err)
+	}
+	if result.SignedTransaction != "signed-tx" || signer.lastTx.Data != "0x70a08231" || signer.lastTx.Value != "0x0" {
+		t.Fatalf("unexpected send result %+v (tx %+v)", result, signer.lastTx)
+	}
+
+	req.Contract = "not-an-address"
+	if _, err := svc.ContractCall(ctx, wallet.ID, req); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for a bad contract address, got %v", err)
+	}
+}
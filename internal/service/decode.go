@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DecodedTransaction describes a raw signed transaction. Amounts are decimal
+// wei strings; fee fields that do not apply to the transaction type are empty.
+type DecodedTransaction struct {
+	Type                 int          `json:"type"`
+	Hash                 string       `json:"hash"`
+	From                 string       `json:"from"`
+	To                   string       `json:"to,omitempty"`
+	ChainID              int64        `json:"chainId"`
+	Nonce                uint64       `json:"nonce"`
+	Value                string       `json:"value"`
+	GasLimit             uint64       `json:"gasLimit"`
+	GasPrice             string       `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string       `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string       `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     string       `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []string     `json:"blobVersionedHashes,omitempty"`
+	Data                 string       `json:"data,omitempty"`
+	Call                 *DecodedCall `json:"call,omitempty"`
+}
+
+// DecodedCall is calldata matched against a known or supplied ABI. Standards
+// lists every registry standard that defines the selector, since ERC-20 and
+// ERC-721 share some. Calls holds decoded inner calls of multicalls.
+type DecodedCall struct {
+	Selector  string           `json:"selector"`
+	Signature string           `json:"signature,omitempty"`
+	Method    string           `json:"method,omitempty"`
+	Standards []string         `json:"standards,omitempty"`
+	Args      []ContractOutput `json:"args,omitempty"`
+	Calls     []DecodedCall    `json:"calls,omitempty"`
+}
+
+// TransactionDecoder parses signed transactions and calldata. abi is an
+// optional JSON ABI or signature tried before the built-in registry. Calldata
+// that matches nothing still yields its selector.
+type TransactionDecoder interface {
+	DecodeTransaction(raw string, abi string) (*DecodedTransaction, error)
+	DecodeCalldata(data string, abi string) (*DecodedCall, error)
+}
+
+type DecoderService interface {
+	DecodeTransaction(ctx context.Context, raw string, abi string) (*DecodedTransaction, error)
+	DecodeCalldata(ctx context.Context, data string, abi string) (*DecodedCall, error)
+}
+
+type decoderService struct {
+	decoder TransactionDecoder
+}
+
+func NewDecoderService(decoder TransactionDecoder) DecoderService {
+	return &decoderService{decoder: decoder}
+}
+
+func (s *decoderService) DecodeTransaction(_ context.Context, raw string, abi string) (*DecodedTransaction, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, fmt.Errorf("%w: rawTransaction is required", ErrValidation)
+	}
+	return s.decoder.DecodeTransaction(strings.TrimSpace(raw), abi)
+}
+
+func (s *decoderService) DecodeCalldata(_ context.Context, data string, abi string) (*DecodedCall, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, fmt.Errorf("%w: data is required", ErrValidation)
+	}
+	return s.decoder.DecodeCalldata(strings.TrimSpace(data), abi)
+}
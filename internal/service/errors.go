@@ -6,4 +6,6 @@ var (
 	ErrNotFound       = errors.New("not found")
 	ErrValidation     = errors.New("validation failed")
 	ErrNotImplemented = errors.New("not implemented")
+	ErrWalletInactive = errors.New("wallet is not active")
+	ErrConflict       = errors.New("conflict")
 )
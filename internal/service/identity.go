@@ -0,0 +1,20 @@
+package service
+
+import "context"
+
+// AnonymousActor is recorded when a request carries no caller identity.
+const AnonymousActor = "anonymous"
+
+type actorKey struct{}
+
+// ContextWithActor attaches the caller identity used in audit entries.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
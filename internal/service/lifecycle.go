@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (s *walletService) DisableWallet(ctx context.Context, id string) (*Wallet, error) {
+	return s.transition(ctx, id, func(record *WalletRecord) error {
+		switch record.Status {
+		case WalletStatusActive, WalletStatusDisabled:
+			record.Status = WalletStatusDisabled
+			return nil
+		default:
+			return fmt.Errorf("%w: cannot disable %s wallet", ErrConflict, record.Status)
+		}
+	})
+}
+
+// EnableWallet reactivates a disabled or archived wallet. A deleted wallet can
+// also be restored as long as its key has not been removed yet.
+func (s *walletService) EnableWallet(ctx context.Context, id string) (*Wallet, error) {
+	return s.transition(ctx, id, func(record *WalletRecord) error {
+		if record.KeyDestroyedAt != nil {
+			return fmt.Errorf("%w: wallet key has been removed", ErrConflict)
+		}
+		record.Status = WalletStatusActive
+		record.DeleteAfter = nil
+		return nil
+	})
+}
+
+func (s *walletService) ArchiveWallet(ctx context.Context, id string) (*Wallet, error) {
+	return s.transition(ctx, id, func(record *WalletRecord) error {
+		if record.Status == WalletStatusDeleted {
+			return fmt.Errorf("%w: cannot archive deleted wallet", ErrConflict)
+		}
+		record.Status = WalletStatusArchived
+		return nil
+	})
+}
+
+// DeleteWallet marks the wallet as deleted and schedules its key for removal
+// once the grace period has elapsed. With a zero grace period the key is
+// removed immediately.
+func (s *walletService) DeleteWallet(ctx context.Context, id string) (*Wallet, error) {
+	return s.transition(ctx, id, func(record *WalletRecord) error {
+		if record.Status == WalletStatusDeleted {
+			return nil
+		}
+		deleteAfter := s.now().Add(s.deletionGracePeriod)
+		record.Status = WalletStatusDeleted
+		record.DeleteAfter = &deleteAfter
+		if s.deletionGracePeriod <= 0 {
+			removeKey(record, s.now())
+		}
+		return nil
+	})
+}
+
+// PurgeDeletedWallets removes the key from the record of every deleted wallet
+// whose grace period has elapsed and returns how many keys were removed.
+func (s *walletService) PurgeDeletedWallets(ctx context.Context) (int, error) {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
+	records, err := s.repo.ListAll(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list wallets: %w", err)
+	}
+
+	now := s.now()
+	purged := 0
+	for i := range records {
+		record := records[i]
+		if record.Status != WalletStatusDeleted || record.KeyDestroyedAt != nil {
+			continue
+		}
+		if record.DeleteAfter != nil && now.Before(*record.DeleteAfter) {
+			continue
+		}
+		removeKey(&record, now)
+		record.UpdatedAt = now
+		if _, err := s.repo.Update(ctx, record); err != nil {
+			return purged, fmt.Errorf("update wallet %s: %w", record.ID, err)
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func (s *walletService) transition(ctx context.Context, id string, apply func(*WalletRecord) error) (*Wallet, error) {
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+
+	record, err := s.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := apply(record); err != nil {
+		return nil, err
+	}
+	record.UpdatedAt = s.now()
+
+	stored, err := s.repo.Update(ctx, *record)
+	if err != nil {
+		return nil, fmt.Errorf("update wallet: %w", err)
+	}
+	return toWallet(stored), nil
+}
+
+// removeKey drops the private key from the record. Once the record is
+// stored the repository no longer returns the key, so the service cannot sign
+// for the wallet. Copies outside the active record, such as backups of the
+// repository, are not touched.
+func removeKey(record *WalletRecord, at time.Time) {
+	record.PrivKey = ""
+	record.KeyDestroyedAt = &at
+}
@@ -2,12 +2,31 @@ package service
 
 import "time"
 
+// WalletStatus describes where a wallet is in its lifecycle.
+type WalletStatus string
+
+const (
+	WalletStatusActive   WalletStatus = "active"
+	WalletStatusDisabled WalletStatus = "disabled"
+	WalletStatusArchived WalletStatus = "archived"
+	WalletStatusDeleted  WalletStatus = "deleted"
+)
+
 type Wallet struct {
-	ID        string
-	Network   string
-	Address   string
-	PublicKey string
-	CreatedAt time.Time
+	ID             string
+	TenantID       string
+	Network        string
+	Address        string
+	PublicKey      string
+	Status         WalletStatus
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeleteAfter    *time.Time `json:",omitempty"`
+	KeyDestroyedAt *time.Time `json:",omitempty"`
+	AccountType    AccountType
+	SmartAccount   *SmartAccount `json:",omitempty"`
+	Family         ChainFamily
+	AddressType    AddressType `json:",omitempty"`
 }
 
 type Balance struct {
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/rickyreddygari/walletsdk/internal/config"
+)
+
+// NetworkState is what runtime network changes persist: the networks added
+// through the API and the keys of disabled networks, wherever they were
+// configured.
+type NetworkState struct {
+	Added    []config.ChainlistNetwork `json:"added"`
+	Disabled []string                  `json:"disabled"`
+}
+
+// NetworkStore keeps the network state across restarts.
+type NetworkStore interface {
+	Load(ctx context.Context) (*NetworkState, error)
+	Save(ctx context.Context, state NetworkState) error
+}
+
+// NetworkInfo describes a registered network. RPC URLs are left out since
+// they often carry API keys.
+type NetworkInfo struct {
+	Network     string      `json:"network"`
+	Name        string      `json:"name"`
+	Family      ChainFamily `json:"family"`
+	ChainID     int64       `json:"chainId,omitempty"`
+	NativeAsset string      `json:"nativeAsset"`
+	Decimals    uint8       `json:"decimals"`
+	ExplorerURL string      `json:"explorerUrl,omitempty"`
+	Disabled    bool        `json:"disabled"`
+}
+
+// NetworkService manages the network registry at runtime. Networks are
+// global, so changes need the platform scope.
+type NetworkService interface {
+	ListNetworks(ctx context.Context) ([]NetworkInfo, error)
+	AddNetwork(ctx context.Context, network config.ChainlistNetwork) (*NetworkInfo, error)
+	DisableNetwork(ctx context.Context, key string) (*NetworkInfo, error)
+	EnableNetwork(ctx context.Context, key string) (*NetworkInfo, error)
+}
+
+type networkService struct {
+	mu       sync.Mutex
+	registry *ConfigRegistry
+	store    NetworkStore
+	state    NetworkState
+}
+
+// NewNetworkService applies the stored state to registry. Stored networks
+// replace configured ones with the same key, and disabled keys that are no
+// longer registered are ignored.
+func NewNetworkService(ctx context.Context, registry *ConfigRegistry, store NetworkStore) (NetworkService, error) {
+	state, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load networks: %w", err)
+	}
+	for _, network := range state.Added {
+		cfg, err := network.NetworkConfig()
+		if err != nil {
+			return nil, fmt.Errorf("load networks: %w", err)
+		}
+		registry.Register(network.Key(), cfg)
+	}
+	for _, key := range state.Disabled {
+		if err := registry.SetDisabled(key, true); err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+	}
+	return &networkService{registry: registry, store: store, state: *state}, nil
+}
+
+func (s *networkService) ListNetworks(_ context.Context) ([]NetworkInfo, error) {
+	keys := s.registry.Keys()
+	networks := make([]NetworkInfo, 0, len(keys))
+	for _, key := range keys {
+		info, err := s.info(key)
+		if err != nil {
+			continue
+		}
+		networks = append(networks, *info)
+	}
+	return networks, nil
+}
+
+func (s *networkService) AddNetwork(ctx context.Context, network config.ChainlistNetwork) (*NetworkInfo, error) {
+	cfg, err := network.NetworkConfig()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	key := network.Key()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.registry.Lookup(key); err == nil {
+		return nil, fmt.Errorf("%w: network %s is already registered", ErrConflict, key)
+	}
+	next := s.state
+	next.Added = append(slices.Clone(s.state.Added), network)
+	if cfg.Disabled {
+		next.Disabled = appendKey(s.state.Disabled, key)
+	}
+	if err := s.save(ctx, next); err != nil {
+		return nil, err
+	}
+
+	s.registry.Register(key, cfg)
+	return s.info(key)
+}
+
+func (s *networkService) DisableNetwork(ctx context.Context, key string) (*NetworkInfo, error) {
+	return s.setDisabled(ctx, key, true)
+}
+
+func (s *networkService) EnableNetwork(ctx context.Context, key string) (*NetworkInfo, error) {
+	return s.setDisabled(ctx, key, false)
+}
+
+func (s *networkService) setDisabled(ctx context.Context, key string, disabled bool) (*NetworkInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.registry.Lookup(key); err != nil {
+		return nil, ErrNotFound
+	}
+	next := s.state
+	if disabled {
+		next.Disabled = appendKey(s.state.Disabled, key)
+	} else {
+		next.Disabled = slices.DeleteFunc(slices.Clone(s.state.Disabled), func(k string) bool { return k == key })
+	}
+	if err := s.save(ctx, next); err != nil {
+		return nil, err
+	}
+
+	if err := s.registry.SetDisabled(key, disabled); err != nil {
+		return nil, err
+	}
+	return s.info(key)
+}
+
+func (s *networkService) save(ctx context.Context, state NetworkState) error {
+	if err := s.store.Save(ctx, state); err != nil {
+		return fmt.Errorf("save networks: %w", err)
+	}
+	s.state = state
+	return nil
+}
+
+func (s *networkService) info(key string) (*NetworkInfo, error) {
+	network, err := s.registry.Lookup(key)
+	if err != nil {
+		return nil, err
+	}
+	return &NetworkInfo{
+		Network:     key,
+		Name:        network.Name,
+		Family:      network.Family,
+		ChainID:     network.ChainID,
+		NativeAsset: network.NativeAsset,
+		Decimals:    network.Decimals,
+		ExplorerURL: network.ExplorerURL,
+		Disabled:    network.Disabled,
+	}, nil
+}
+
+func appendKey(keys []string, key string) []string {
+	if slices.Contains(keys, key) {
+		return keys
+	}
+	return append(slices.Clone(keys), key)
+}
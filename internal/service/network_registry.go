@@ -2,16 +2,25 @@ package service
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/rickyreddygari/walletsdk/internal/config"
 )
 
+// ConfigRegistry serves the configured networks and those added at runtime
+// through the network service.
 type ConfigRegistry struct {
-	cfg *config.AppConfig
+	mu       sync.RWMutex
+	networks map[string]config.NetworkConfig
 }
 
 func NewConfigRegistry(cfg *config.AppConfig) *ConfigRegistry {
-	return &ConfigRegistry{cfg: cfg}
+	networks := make(map[string]config.NetworkConfig, len(cfg.Networks))
+	for key, network := range cfg.Networks {
+		networks[key] = network
+	}
+	return &ConfigRegistry{networks: networks}
 }
 
 func (r *ConfigRegistry) Lookup(network string) (*Network, error) {
@@ -19,15 +28,85 @@ func (r *ConfigRegistry) Lookup(network string) (*Network, error) {
 		return nil, fmt.Errorf("%w: network required", ErrValidation)
 	}
 
-	cfg, err := r.cfg.Lookup(network)
-	if err != nil {
-		return nil, err
+	r.mu.RLock()
+	cfg, ok := r.networks[network]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: network %s is not registered", ErrValidation, network)
+	}
+	return toNetwork(cfg), nil
+}
+
+// Keys returns the key of every registered network in order.
+func (r *ConfigRegistry) Keys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]string, 0, len(r.networks))
+	for key := range r.networks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Register adds a network, or replaces the one registered under key.
+func (r *ConfigRegistry) Register(key string, cfg config.NetworkConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.networks[key] = cfg
+}
+
+// SetDisabled marks a registered network as disabled or enabled again.
+func (r *ConfigRegistry) SetDisabled(key string, disabled bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, ok := r.networks[key]
+	if !ok {
+		return ErrNotFound
+	}
+	cfg.Disabled = disabled
+	r.networks[key] = cfg
+	return nil
+}
+
+func toNetwork(cfg config.NetworkConfig) *Network {
+	tokens := make(map[string]Token, len(cfg.Tokens))
+	for symbol, token := range cfg.Tokens {
+		tokens[symbol] = Token{Symbol: symbol, Address: token.Address, Decimals: token.Decimals}
+	}
+
+	factories := make(map[string]AccountFactory, len(cfg.AccountFactories))
+	for name, factory := range cfg.AccountFactories {
+		factories[name] = AccountFactory{
+			Name:         name,
+			Address:      factory.Address,
+			InitCodeHash: factory.InitCodeHash,
+			EntryPoint:   EntryPointVersion(factory.EntryPoint),
+		}
+	}
+
+	family := ChainFamily(cfg.Family)
+	if family == "" {
+		family = ChainFamilyEthereum
 	}
 
 	return &Network{
-		Name:        cfg.Name,
-		ChainID:     cfg.ChainID,
-		RPCURL:      cfg.RPCURL,
-		NativeAsset: cfg.NativeAsset,
-	}, nil
+		Name:             cfg.Name,
+		Family:           family,
+		ChainID:          cfg.ChainID,
+		CosmosChainID:    cfg.CosmosChainID,
+		Bech32Prefix:     cfg.Bech32Prefix,
+		BitcoinChain:     cfg.BitcoinChain,
+		RPCURL:           cfg.RPCURL,
+		NativeAsset:      cfg.NativeAsset,
+		Decimals:         cfg.Decimals,
+		ExplorerURL:      cfg.ExplorerURL,
+		Disabled:         cfg.Disabled,
+		BundlerURL:       cfg.BundlerURL,
+		Tokens:           tokens,
+		AccountFactories: factories,
+	}
 }
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rickyreddygari/walletsdk/internal/config"
+)
+
+type stubNetworkStore struct {
+	state NetworkState
+	err   error
+}
+
+func (s *stubNetworkStore) Load(context.Context) (*NetworkState, error) {
+	state := s.state
+	return &state, nil
+}
+
+func (s *stubNetworkStore) Save(_ context.Context, state NetworkState) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.state = state
+	return nil
+}
+
+func testNetworkRegistry() *ConfigRegistry {
+	return NewConfigRegistry(&config.AppConfig{Networks: map[string]config.NetworkConfig{
+		"eth-sepolia": {Name: "Ethereum Sepolia", Family: "ethereum", ChainID: 11155111, RPCURL: "http://sepolia", NativeAsset: "ETH", Decimals: 18},
+	}})
+}
+
+var arbitrumSepolia = config.ChainlistNetwork{
+	Network:        "arbitrum-sepolia",
+	Name:           "Arbitrum Sepolia",
+	ChainID:        421614,
+	RPC:            []string{"https://sepolia-rollup.arbitrum.io/rpc"},
+	NativeCurrency: config.NativeCurrency{Symbol: "ETH", Decimals: 18},
+	Explorers:      []config.Explorer{{URL: "https://sepolia.arbiscan.io"}},
+}
+
+func TestCreateWalletRejectsUnregisteredNetworks(t *testing.T) {
+	registry := testNetworkRegistry()
+	networks, err := NewNetworkService(context.Background(), registry, &stubNetworkStore{})
+	if err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithTokenChain(&stubTokenChain{}, registry))
+	ctx := context.Background()
+
+	if _, err := svc.CreateWallet(ctx, "arbitrum-sepolia"); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for an unregistered network, got %v", err)
+	}
+
+	if _, err := networks.AddNetwork(ctx, arbitrumSepolia); err != nil {
+		t.Fatalf("AddNetwork returned error: %v", err)
+	}
+	wallet, err := svc.CreateWallet(ctx, "arbitrum-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if wallet.Network != "arbitrum-sepolia" || wallet.Family != ChainFamilyEthereum {
+		t.Fatalf("unexpected wallet %+v", wallet)
+	}
+}
+
+func TestAddNetworkPersists(t *testing.T) {
+	store := &stubNetworkStore{}
+	networks, err := NewNetworkService(context.Background(), testNetworkRegistry(), store)
+	if err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	info, err := networks.AddNetwork(ctx, arbitrumSepolia)
+	if err != nil {
+		t.Fatalf("AddNetwork returned error: %v", err)
+	}
+	if info.Network != "arbitrum-sepolia" || info.ChainID != 421614 || info.ExplorerURL != "https://sepolia.arbiscan.io" || info.Disabled {
+		t.Fatalf("unexpected network %+v", info)
+	}
+	if len(store.state.Added) != 1 || store.state.Added[0].Key() != "arbitrum-sepolia" {
+		t.Fatalf("expected the network to be stored, got %+v", store.state)
+	}
+
+	if _, err := networks.AddNetwork(ctx, arbitrumSepolia); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected conflict for a registered key, got %v", err)
+	}
+	invalid := arbitrumSepolia
+	invalid.Network, invalid.ChainID = "optimism-sepolia", 0
+	if _, err := networks.AddNetwork(ctx, invalid); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	restored := testNetworkRegistry()
+	if _, err := NewNetworkService(ctx, restored, store); err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	if network, err := restored.Lookup("arbitrum-sepolia"); err != nil || network.ChainID != 421614 {
+		t.Fatalf("expected the stored network after a restart, got %+v, %v", network, err)
+	}
+}
+
+func TestDisableNetworkStopsWalletCreation(t *testing.T) {
+	store := &stubNetworkStore{}
+	registry := testNetworkRegistry()
+	networks, err := NewNetworkService(context.Background(), registry, store)
+	if err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	repo := newStubRepo()
+	svc := NewWalletService(repo, &stubSigner{}, WithTokenChain(&stubTokenChain{}, registry))
+	ctx := context.Background()
+
+	existing, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	info, err := networks.DisableNetwork(ctx, "eth-sepolia")
+	if err != nil || !info.Disabled {
+		t.Fatalf("DisableNetwork returned %+v, %v", info, err)
+	}
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia"); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error on a disabled network, got %v", err)
+	}
+	balances := NewBalanceService(repo, &stubBalanceFetcher{amount: "1"}, registry)
+	if _, err := balances.GetBalance(ctx, existing.ID); err != nil {
+		t.Fatalf("expected existing wallets to keep working, got %v", err)
+	}
+
+	restored := testNetworkRegistry()
+	if _, err := NewNetworkService(ctx, restored, store); err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	if network, _ := restored.Lookup("eth-sepolia"); !network.Disabled {
+		t.Fatal("expected the network to stay disabled after a restart")
+	}
+
+	if _, err := networks.EnableNetwork(ctx, "eth-sepolia"); err != nil {
+		t.Fatalf("EnableNetwork returned error: %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia"); err != nil {
+		t.Fatalf("CreateWallet returned error after enabling: %v", err)
+	}
+	if len(store.state.Disabled) != 0 {
+		t.Fatalf("expected no disabled networks, got %v", store.state.Disabled)
+	}
+	if _, err := networks.DisableNetwork(ctx, "base-mainnet"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected not found for an unknown network, got %v", err)
+	}
+}
+
+func TestNetworkChangesFailWhenTheStoreDoes(t *testing.T) {
+	store := &stubNetworkStore{err: errors.New("disk full")}
+	registry := testNetworkRegistry()
+	networks, err := NewNetworkService(context.Background(), registry, store)
+	if err != nil {
+		t.Fatalf("NewNetworkService returned error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := networks.AddNetwork(ctx, arbitrumSepolia); err == nil {
+		t.Fatal("expected AddNetwork to fail")
+	}
+	if _, err := registry.Lookup("arbitrum-sepolia"); err == nil {
+		t.Fatal("expected the network not to be registered")
+	}
+	if _, err := networks.DisableNetwork(ctx, "eth-sepolia"); err == nil {
+		t.Fatal("expected DisableNetwork to fail")
+	}
+	if network, _ := registry.Lookup("eth-sepolia"); network.Disabled {
+		t.Fatal("expected the network to stay enabled")
+	}
+}
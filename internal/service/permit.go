@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PermitKind string
+
+const (
+	// PermitKindEIP2612 signs the token's own permit.
+	PermitKindEIP2612 PermitKind = "eip2612"
+	// PermitKindPermit2 signs a Uniswap Permit2 PermitSingle allowance.
+	PermitKindPermit2 PermitKind = "permit2"
+
+	AuditOperationSignPermit = "sign_permit"
+
+	// DefaultPermitTTL is how long a permit stays valid when the request
+	// does not set a deadline.
+	DefaultPermitTTL = time.Hour
+)
+
+var maxUint160 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+
+// PermitRequest asks for a signature that lets Spender move Value of Token.
+// Value is in whole tokens, or TokenAmountMax. Deadline is a unix time in
+// seconds; Expiration is when a Permit2 allowance lapses and defaults to the
+// deadline.
+type PermitRequest struct {
+	Kind       PermitKind `json:"kind,omitempty"`
+	Token      string     `json:"token"`
+	Spender    string     `json:"spender"`
+	Value      string     `json:"value"`
+	Deadline   int64      `json:"deadline,omitempty"`
+	Expiration int64      `json:"expiration,omitempty"`
+}
+
+// Permit is a resolved permit request handed to a PermitBuilder. Value is in
+// base units.
+type Permit struct {
+	Kind       PermitKind
+	Owner      string
+	Token      Token
+	Spender    string
+	Value      *big.Int
+	Deadline   int64
+	Expiration int64
+}
+
+type PermitResult struct {
+	Token Token `json:"token"`
+	// Value is in the token's base units.
+	Value     string     `json:"value"`
+	Deadline  int64      `json:"deadline"`
+	Signature string     `json:"signature"`
+	V         uint8      `json:"v"`
+	R         string     `json:"r"`
+	S         string     `json:"s"`
+	TypedData *TypedData `json:"typedData"`
+}
+
+// PermitBuilder reads the nonce and domain of a permit from the chain and
+// returns the typed data to sign.
+type PermitBuilder interface {
+	BuildPermit(ctx context.Context, rpcURL string, chainID int64, permit Permit) (*TypedData, error)
+}
+
+// WithPermits enables SignPermit. Tokens are resolved through the chain set
+// by WithTokenChain.
+func WithPermits(builder PermitBuilder) WalletServiceOption {
+	return func(s *walletService) {
+		s.permits = builder
+	}
+}
+
+func (s *walletService) SignPermit(ctx context.Context, walletID string, req PermitRequest) (*PermitResult, error) {
+	typedData, signature, err := s.signPermit(ctx, walletID, req)
+
+	var payloadHash, result string
+	if typedData != nil {
+		encoded, _ := json.Marshal(typedData)
+		payloadHash = hashPayload(encoded)
+	}
+	if signature != nil {
+		result = signature.Signature
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignPermit, payloadHash, result, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return signature, err
+}
+
+func (s *walletService) signPermit(ctx context.Context, walletID string, req PermitRequest) (*TypedData, *PermitResult, error) {
+	if s.permits == nil || s.chain == nil || s.registry == nil {
+		return nil, nil, fmt.Errorf("%w: permits are not configured", ErrNotImplemented)
+	}
+	if req.Kind == "" {
+		req.Kind = PermitKindEIP2612
+	}
+	if req.Kind != PermitKindEIP2612 && req.Kind != PermitKindPermit2 {
+		return nil, nil, fmt.Errorf("%w: unknown permit kind %q", ErrValidation, req.Kind)
+	}
+	req.Spender = strings.TrimSpace(req.Spender)
+	if !addressPattern.MatchString(req.Spender) {
+		return nil, nil, fmt.Errorf("%w: invalid spender address", ErrValidation)
+	}
+
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, nil, ErrWalletInactive
+	}
+	if err := requireEthereum(record); err != nil {
+		return nil, nil, err
+	}
+	if record.SmartAccount != nil {
+		return nil, nil, errSmartAccountTransaction
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, nil, fmt.Errorf("lookup network: %w", err)
+	}
+	token, err := s.resolveToken(ctx, network, record.Address, req.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limit := maxUint256
+	if req.Kind == PermitKindPermit2 {
+		limit = maxUint160
+	}
+	value := new(big.Int).Set(limit)
+	if !strings.EqualFold(strings.TrimSpace(req.Value), TokenAmountMax) {
+		if value, err = parseTokenAmount(req.Value, token.Decimals, true); err != nil {
+			return nil, nil, err
+		}
+		if value.Cmp(limit) > 0 {
+			return nil, nil, fmt.Errorf("%w: value exceeds the %s maximum", ErrValidation, req.Kind)
+		}
+	}
+
+	if req.Deadline == 0 {
+		req.Deadline = s.now().Add(DefaultPermitTTL).Unix()
+	}
+	if req.Deadline <= s.now().Unix() {
+		return nil, nil, fmt.Errorf("%w: deadline is in the past", ErrValidation)
+	}
+	if req.Expiration == 0 {
+		req.Expiration = req.Deadline
+	}
+	if err := s.checkPermitPolicies(ctx, record, network, token, req.Spender, value, limit); err != nil {
+		return nil, nil, err
+	}
+
+	typedData, err := s.permits.BuildPermit(ctx, network.RPCURL, network.ChainID, Permit{
+		Kind:       req.Kind,
+		Owner:      record.Address,
+		Token:      *token,
+		Spender:    req.Spender,
+		Value:      value,
+		Deadline:   req.Deadline,
+		Expiration: req.Expiration,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("build permit: %w", err)
+	}
+
+	signature, err := s.signer.SignTypedData(record.Network, record.PrivKey, typedData)
+	if err != nil {
+		return typedData, nil, fmt.Errorf("sign permit: %w", err)
+	}
+	v, r, sig, err := splitSignature(signature.Signature)
+	if err != nil {
+		return typedData, nil, err
+	}
+
+	return typedData, &PermitResult{
+		Token:     *token,
+		Value:     value.String(),
+		Deadline:  req.Deadline,
+		Signature: signature.Signature,
+		V:         v,
+		R:         r,
+		S:         sig,
+		TypedData: typedData,
+	}, nil
+}
+
+// checkPermitPolicies evaluates a permit as the approve(spender, value) call
+// to the token that ApproveToken would send, so destination rules apply to
+// the token on both paths and the spender is a calldata argument. A permit
+// moves no ether, but an unlimited allowance is evaluated at its full value
+// so that it fails every value cap. Permits cannot be queued for approval.
+func (s *walletService) checkPermitPolicies(ctx context.Context, record *WalletRecord, network *Network, token *Token, spender string, value *big.Int, limit *big.Int) error {
+	tx := &Transaction{
+		ChainID: network.ChainID,
+		From:    record.Address,
+		To:      token.Address,
+		Value:   "0x0",
+		Data:    erc20ApproveSelector + padWord(strings.TrimPrefix(strings.ToLower(spender), "0x")) + padWord(value.Text(16)),
+	}
+	if value.Cmp(limit) == 0 {
+		tx.Value = "0x" + value.Text(16)
+	}
+	_, err := s.checkUnqueuedPolicies(ctx, record, tx, "permits")
+	return err
+}
+
+// splitSignature splits a 65-byte 0x signature into v, r and s.
+func splitSignature(signature string) (uint8, string, string, error) {
+	digits := strings.TrimPrefix(signature, "0x")
+	if len(digits) != 130 {
+		return 0, "", "", fmt.Errorf("unexpected signature length %d", len(digits)/2)
+	}
+	v, err := strconv.ParseUint(digits[128:], 16, 8)
+	if err != nil {
+		return 0, "", "", fmt.Errorf("parse signature v: %w", err)
+	}
+	return uint8(v), "0x" + digits[:64], "0x" + digits[64:128], nil
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+type stubPermitBuilder struct {
+	last Permit
+}
+
+func (b *stubPermitBuilder) BuildPermit(_ context.Context, _ string, chainID int64, permit Permit) (*TypedData, error) {
+	b.last = permit
+	return &TypedData{PrimaryType: "Permit", Domain: TypedDataDomain{Name: "USD Coin", ChainID: chainID}}, nil
+}
+
+func TestSignPermitSplitsSignature(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	builder := &stubPermitBuilder{}
+	signer := &stubSigner{}
+	audit := &stubAuditLog{}
+	svc := NewWalletService(newStubRepo(), signer,
+		WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}),
+		WithPermits(builder),
+		WithAuditLog(audit),
+		WithClock(func() time.Time { return now }),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	result, err := svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1.5"})
+	if err != nil {
+		t.Fatalf("SignPermit returned error: %v", err)
+	}
+	if builder.last.Kind != PermitKindEIP2612 || builder.last.Value.String() != "1500000" || builder.last.Owner != wallet.Address {
+		t.Fatalf("unexpected permit %+v", builder.last)
+	}
+	if result.Deadline != now.Add(DefaultPermitTTL).Unix() || builder.last.Expiration != result.Deadline {
+		t.Fatalf("expected the default deadline, got %d", result.Deadline)
+	}
+	if result.V != 27 || result.R != "0x"+strings.Repeat("11", 32) || result.S != "0x"+strings.Repeat("22", 32) {
+		t.Fatalf("unexpected signature parts %+v", result)
+	}
+	if result.TypedData == nil || signer.lastTypedData != result.TypedData {
+		t.Fatalf("expected the signed typed data in the result")
+	}
+
+	entries, err := audit.Entries(ctx)
+	if err != nil || len(entries) != 1 || entries[0].Operation != AuditOperationSignPermit || entries[0].Result != result.Signature {
+		t.Fatalf("expected one permit audit entry, got %+v (%v)", entries, err)
+	}
+
+	max, err := svc.SignPermit(ctx, wallet.ID, PermitRequest{Kind: PermitKindPermit2, Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "max"})
+	if err != nil || max.Value != maxUint160.String() {
+		t.Fatalf("expected a uint160 max for Permit2, got %+v (%v)", max, err)
+	}
+}
+
+func TestSignPermitValidatesInput(t *testing.T) {
+	now := time.Unix(1700000000, 0).UTC()
+	svc := NewWalletService(newStubRepo(), &stubSigner{},
+		WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}),
+		WithPermits(&stubPermitBuilder{}),
+		WithClock(func() time.Time { return now }),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	cases := []PermitRequest{
+		{Kind: "dai", Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1"},
+		{Token: "USDC", Spender: "nope", Value: "1"},
+		{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1", Deadline: now.Unix() - 1},
+		{Kind: PermitKindPermit2, Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "9999999999999999999999999999999999999999999"},
+	}
+	for _, req := range cases {
+		if _, err := svc.SignPermit(ctx, wallet.ID, req); !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error for %+v, got %v", req, err)
+		}
+	}
+}
+
+func TestSignPermitChecksPolicies(t *testing.T) {
+	builder := &stubPermitBuilder{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(newStubRepo(), &stubSigner{},
+		WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}),
+		WithPermits(builder),
+		WithPolicies(policies),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "limits",
+		Rules: PolicyRules{MaxValue: "1000", DeniedDestinations: []string{"0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	var violation *PolicyViolation
+	_, err = svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1"})
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleDeniedDestinations {
+		t.Fatalf("expected the token to be denied, as for ApproveToken, got %v", err)
+	}
+	if _, err := svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "USDC", Spender: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Value: "1"}); err != nil {
+		t.Fatalf("expected the spender to be a calldata argument rather than the destination, got %v", err)
+	}
+	_, err = svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "max"})
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected an unlimited allowance to exceed the cap, got %v", err)
+	}
+	if _, err := svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "5000"}); err != nil {
+		t.Fatalf("expected a bounded allowance to be signed, got %v", err)
+	}
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 1, Approvers: []string{"apikey:alice", "apikey:bob"}, TTL: Duration(time.Hour)}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	_, err = svc.SignPermit(ctx, wallet.ID, PermitRequest{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1"})
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleQuorum {
+		t.Fatalf("expected permits to be refused under a quorum, got %v", err)
+	}
+}
@@ -0,0 +1,576 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrPolicyViolation is returned when a signing policy rejects a transaction.
+// The wrapped message names the policy and the rule that failed.
+var ErrPolicyViolation = errors.New("policy violation")
+
+// Policy rule names reported in violations.
+const (
+	PolicyRuleMaxValue            = "max_value"
+	PolicyRuleWindowValue         = "window_value"
+	PolicyRuleAllowedDestinations = "allowed_destinations"
+	PolicyRuleDeniedDestinations  = "denied_destinations"
+	PolicyRuleAllowedMethods      = "allowed_methods"
+	PolicyRuleExpression          = "expression"
+	PolicyRuleQuorum              = "quorum"
+)
+
+// Policy constrains the transactions a wallet may sign. A policy with an
+// empty WalletID applies to every wallet in the tenant, and one with Networks
+// set only applies on those networks. Every applicable policy must pass.
+type Policy struct {
+	ID       string      `json:"id"`
+	TenantID string      `json:"tenantId"`
+	WalletID string      `json:"walletId,omitempty"`
+	Name     string      `json:"name"`
+	Networks []string    `json:"networks,omitempty"`
+	Rules    PolicyRules `json:"rules"`
+	// Expression is a CEL expression that must evaluate to true for the
+	// transaction to be signed. It is checked after Rules.
+	Expression string `json:"expression,omitempty"`
+	// Version starts at 1 and increases with every update.
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// PolicyRules holds the individual checks of a policy. Unset fields are not
+// enforced. Amounts are decimal strings or 0x-prefixed hex in the base unit
+// of one chain family: that of the wallet of a wallet policy, that of the
+// networks of a policy that names them, which must then share a family, and
+// otherwise wei. Value rules in wei refuse wallets of other families.
+type PolicyRules struct {
+	// MaxValue caps the value of a single transaction.
+	MaxValue string `json:"maxValue,omitempty"`
+	// WindowValue caps the total value signed within Window, counting the
+	// transaction being evaluated.
+	WindowValue string   `json:"windowValue,omitempty"`
+	Window      Duration `json:"window,omitempty"`
+	// AllowedDestinations, when set, is the only set of permitted recipients.
+	// Ethereum addresses are hex and Bitcoin addresses bech32 or base58.
+	AllowedDestinations []string `json:"allowedDestinations,omitempty"`
+	DeniedDestinations  []string `json:"deniedDestinations,omitempty"`
+	// AllowedMethods lists permitted 4-byte selectors for transactions that
+	// carry calldata. Plain transfers are unaffected.
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+	// Quorum queues matching transactions until enough approvers agree.
+	Quorum *QuorumRule `json:"quorum,omitempty"`
+	// AllowDelegateCall lets owners sign Safe transactions that delegatecall
+	// their target, which runs its code with the Safe's storage and funds.
+	AllowDelegateCall bool `json:"allowDelegateCall,omitempty"`
+}
+
+// Duration is a time.Duration that encodes as a Go duration string.
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// AppliesTo reports whether the policy governs the wallet.
+func (p *Policy) AppliesTo(wallet *WalletRecord) bool {
+	if p.TenantID != wallet.TenantID {
+		return false
+	}
+	if p.WalletID != "" && p.WalletID != wallet.ID {
+		return false
+	}
+	if len(p.Networks) == 0 {
+		return true
+	}
+	for _, network := range p.Networks {
+		if network == wallet.Network {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) violation(rule string, format string, args ...interface{}) error {
+	return &PolicyViolation{PolicyID: p.ID, Policy: p.Name, Rule: rule, Reason: fmt.Sprintf(format, args...)}
+}
+
+// enforcedRules lists the rules the policy sets, in the order they are
+// evaluated.
+func (p *Policy) enforcedRules() []string {
+	rules := p.Rules
+	var set []string
+	if rules.MaxValue != "" {
+		set = append(set, PolicyRuleMaxValue)
+	}
+	if rules.WindowValue != "" {
+		set = append(set, PolicyRuleWindowValue)
+	}
+	if len(rules.AllowedDestinations) > 0 {
+		set = append(set, PolicyRuleAllowedDestinations)
+	}
+	if len(rules.DeniedDestinations) > 0 {
+		set = append(set, PolicyRuleDeniedDestinations)
+	}
+	if len(rules.AllowedMethods) > 0 {
+		set = append(set, PolicyRuleAllowedMethods)
+	}
+	if p.Expression != "" {
+		set = append(set, PolicyRuleExpression)
+	}
+	if rules.Quorum != nil {
+		set = append(set, PolicyRuleQuorum)
+	}
+	return set
+}
+
+// valueRulesApply reports whether the amounts of the policy are in the
+// base unit of the wallet's family. Where they are not, value rules refuse
+// the wallet's transactions rather than skip them.
+func (p *Policy) valueRulesApply(wallet *WalletRecord) bool {
+	return p.WalletID != "" || len(p.Networks) > 0 || walletFamily(wallet) == ChainFamilyEthereum
+}
+
+// spendQuery selects the spend a window rule of the policy counts: the
+// wallet's own for wallet policies, and that of every wallet the policy
+// covers for tenant-wide ones.
+func (p *Policy) spendQuery(wallet *WalletRecord, since time.Time) SpendQuery {
+	if p.WalletID != "" {
+		return SpendQuery{TenantID: wallet.TenantID, WalletID: wallet.ID, Family: walletFamily(wallet), Since: since}
+	}
+	return SpendQuery{TenantID: wallet.TenantID, Networks: p.Networks, Family: walletFamily(wallet), Since: since}
+}
+
+type PolicyRepository interface {
+	Create(ctx context.Context, policy Policy) (*Policy, error)
+	GetByID(ctx context.Context, tenantID string, id string) (*Policy, error)
+	// List returns the tenant's policies, tenant-wide and wallet-level alike.
+	List(ctx context.Context, tenantID string) ([]Policy, error)
+	// Update stores a new version; earlier versions stay available through
+	// ListVersions.
+	Update(ctx context.Context, policy Policy) (*Policy, error)
+	// ListVersions returns every version of the policy, oldest first.
+	ListVersions(ctx context.Context, tenantID string, id string) ([]Policy, error)
+	Delete(ctx context.Context, tenantID string, id string) error
+}
+
+// MaxSpendWindow is the longest rolling window a policy may set. Ledgers
+// may forget entries older than that.
+const MaxSpendWindow = 31 * 24 * time.Hour
+
+// SpendEntry records value signed by a wallet, for rolling-window limits.
+// Amount is in the base unit of Family.
+type SpendEntry struct {
+	TenantID string
+	WalletID string
+	Network  string
+	Family   ChainFamily
+	Amount   *big.Int
+	At       time.Time
+}
+
+// SpendQuery selects the entries a rolling-window total sums. Only entries
+// of Family are summed, so amounts of different units never mix. An empty
+// WalletID covers every wallet in the tenant, and a non-empty Networks only
+// the wallets on those networks.
+type SpendQuery struct {
+	TenantID string
+	WalletID string
+	Networks []string
+	Family   ChainFamily
+	Since    time.Time
+}
+
+// Matches reports whether the query covers entry.
+func (q SpendQuery) Matches(entry SpendEntry) bool {
+	if entry.TenantID != q.TenantID || entry.Family != q.Family || entry.At.Before(q.Since) {
+		return false
+	}
+	if q.WalletID != "" && entry.WalletID != q.WalletID {
+		return false
+	}
+	return len(q.Networks) == 0 || slices.Contains(q.Networks, entry.Network)
+}
+
+type SpendLedger interface {
+	Record(ctx context.Context, entry SpendEntry) error
+	// Total sums the spend of the entries query matches.
+	Total(ctx context.Context, query SpendQuery) (*big.Int, error)
+}
+
+type PolicyService interface {
+	CreatePolicy(ctx context.Context, policy Policy) (*Policy, error)
+	GetPolicy(ctx context.Context, id string) (*Policy, error)
+	ListPolicies(ctx context.Context, walletID string) ([]Policy, error)
+	UpdatePolicy(ctx context.Context, policy Policy) (*Policy, error)
+	DeletePolicy(ctx context.Context, id string) error
+	ListPolicyVersions(ctx context.Context, id string) ([]Policy, error)
+	// DryRunPolicy evaluates a transaction against a policy without signing
+	// it or recording spend.
+	DryRunPolicy(ctx context.Context, req PolicyDryRunRequest) (*PolicyDryRunResult, error)
+}
+
+// PolicyDryRunRequest names the policy to evaluate, either a stored one by
+// PolicyID (at Version, or the latest when zero) or an unsaved candidate.
+type PolicyDryRunRequest struct {
+	PolicyID    string      `json:"policyId,omitempty"`
+	Version     int         `json:"version,omitempty"`
+	Policy      *Policy     `json:"policy,omitempty"`
+	WalletID    string      `json:"walletId"`
+	Transaction Transaction `json:"transaction"`
+}
+
+// PolicyDryRunResult reports whether the policy would allow the transaction.
+// Rule and Reason are set when it would not.
+type PolicyDryRunResult struct {
+	PolicyID         string `json:"policyId,omitempty"`
+	Version          int    `json:"version,omitempty"`
+	Allowed          bool   `json:"allowed"`
+	Rule             string `json:"rule,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	RequiresApproval bool   `json:"requiresApproval"`
+}
+
+type policyService struct {
+	engine   *PolicyEngine
+	repo     PolicyRepository
+	wallets  WalletRepository
+	registry NetworkRegistry
+	now      func() time.Time
+}
+
+// PolicyServiceOption customises a policy service at construction time.
+type PolicyServiceOption func(*policyService)
+
+// WithPolicyClock overrides the time source of policy timestamps and dry
+// runs, mainly for tests.
+func WithPolicyClock(now func() time.Time) PolicyServiceOption {
+	return func(s *policyService) {
+		s.now = now
+	}
+}
+
+// NewPolicyService uses registry to check that a policy with value rules
+// only names networks of one chain family.
+func NewPolicyService(engine *PolicyEngine, wallets WalletRepository, registry NetworkRegistry, opts ...PolicyServiceOption) PolicyService {
+	svc := &policyService{
+		engine:   engine,
+		repo:     engine.repo,
+		wallets:  wallets,
+		registry: registry,
+		now:      func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+func (s *policyService) CreatePolicy(ctx context.Context, policy Policy) (*Policy, error) {
+	policy.TenantID = TenantFromContext(ctx)
+	if err := s.validate(ctx, &policy); err != nil {
+		return nil, err
+	}
+
+	policy.ID = uuid.NewString()
+	policy.Version = 1
+	policy.CreatedAt = s.now()
+	policy.UpdatedAt = policy.CreatedAt
+	stored, err := s.repo.Create(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("store policy: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *policyService) GetPolicy(ctx context.Context, id string) (*Policy, error) {
+	policy, err := s.repo.GetByID(ctx, TenantFromContext(ctx), id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get policy: %w", err)
+	}
+	return policy, nil
+}
+
+// ListPolicies returns the caller's policies. A non-empty walletID limits the
+// result to policies that govern that wallet, including tenant-wide ones.
+func (s *policyService) ListPolicies(ctx context.Context, walletID string) ([]Policy, error) {
+	policies, err := s.repo.List(ctx, TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list policies: %w", err)
+	}
+	if walletID == "" {
+		return policies, nil
+	}
+
+	filtered := make([]Policy, 0, len(policies))
+	for _, policy := range policies {
+		if policy.WalletID == "" || policy.WalletID == walletID {
+			filtered = append(filtered, policy)
+		}
+	}
+	return filtered, nil
+}
+
+func (s *policyService) UpdatePolicy(ctx context.Context, policy Policy) (*Policy, error) {
+	existing, err := s.GetPolicy(ctx, policy.ID)
+	if err != nil {
+		return nil, err
+	}
+	policy.TenantID = existing.TenantID
+	if err := s.validate(ctx, &policy); err != nil {
+		return nil, err
+	}
+
+	policy.Version = existing.Version + 1
+	policy.CreatedAt = existing.CreatedAt
+	policy.UpdatedAt = s.now()
+	stored, err := s.repo.Update(ctx, policy)
+	if err != nil {
+		return nil, fmt.Errorf("update policy: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *policyService) DeletePolicy(ctx context.Context, id string) error {
+	if _, err := s.GetPolicy(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, TenantFromContext(ctx), id); err != nil {
+		return fmt.Errorf("delete policy: %w", err)
+	}
+	return nil
+}
+
+func (s *policyService) ListPolicyVersions(ctx context.Context, id string) ([]Policy, error) {
+	versions, err := s.repo.ListVersions(ctx, TenantFromContext(ctx), id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("list policy versions: %w", err)
+	}
+	return versions, nil
+}
+
+func (s *policyService) DryRunPolicy(ctx context.Context, req PolicyDryRunRequest) (*PolicyDryRunResult, error) {
+	tenantID := TenantFromContext(ctx)
+	policy, err := s.dryRunTarget(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.wallets.GetByID(ctx, tenantID, strings.TrimSpace(req.WalletID))
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get wallet: %w", err)
+	}
+	tx := req.Transaction
+	if err := ValidateTransaction(&tx); err != nil {
+		return nil, err
+	}
+	value, err := parseHexQuantity(tx.Value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid value", ErrValidation)
+	}
+
+	result := &PolicyDryRunResult{PolicyID: policy.ID, Version: policy.Version}
+	if !policy.AppliesTo(record) {
+		result.Allowed = true
+		result.Reason = "policy does not apply to this wallet"
+		return result, nil
+	}
+	err = s.engine.Evaluate(ctx, policy, record, &tx, value, s.now())
+	var violation *PolicyViolation
+	switch {
+	case err == nil:
+		result.Allowed = true
+		result.RequiresApproval = policy.Rules.Quorum != nil
+	case errors.As(err, &violation):
+		result.Rule = violation.Rule
+		result.Reason = violation.Reason
+	default:
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *policyService) dryRunTarget(ctx context.Context, req PolicyDryRunRequest) (*Policy, error) {
+	if req.Policy != nil {
+		candidate := *req.Policy
+		candidate.TenantID = TenantFromContext(ctx)
+		if err := s.validate(ctx, &candidate); err != nil {
+			return nil, err
+		}
+		return &candidate, nil
+	}
+	if req.Version == 0 {
+		return s.GetPolicy(ctx, req.PolicyID)
+	}
+
+	versions, err := s.ListPolicyVersions(ctx, req.PolicyID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range versions {
+		if versions[i].Version == req.Version {
+			return &versions[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *policyService) validate(ctx context.Context, policy *Policy) error {
+	policy.Name = strings.TrimSpace(policy.Name)
+	if policy.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrValidation)
+	}
+	policy.WalletID = strings.TrimSpace(policy.WalletID)
+	if policy.WalletID != "" {
+		if _, err := s.wallets.GetByID(ctx, policy.TenantID, policy.WalletID); err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return fmt.Errorf("%w: unknown wallet %s", ErrValidation, policy.WalletID)
+			}
+			return fmt.Errorf("get wallet: %w", err)
+		}
+	}
+	if err := validatePolicyRules(&policy.Rules); err != nil {
+		return err
+	}
+	if err := s.validateValueFamily(policy); err != nil {
+		return err
+	}
+	policy.Expression = strings.TrimSpace(policy.Expression)
+	return s.engine.compile(policy.Expression)
+}
+
+// validateValueFamily rejects value rules on a policy whose networks belong
+// to different chain families, as its amounts would be in several units.
+func (s *policyService) validateValueFamily(policy *Policy) error {
+	if policy.Rules.MaxValue == "" && policy.Rules.WindowValue == "" {
+		return nil
+	}
+	var family ChainFamily
+	for _, key := range policy.Networks {
+		network, err := s.registry.Lookup(key)
+		if err != nil {
+			return fmt.Errorf("%w: network %s is not registered", ErrValidation, key)
+		}
+		networkFamily := network.Family
+		if networkFamily == "" {
+			networkFamily = ChainFamilyEthereum
+		}
+		if family != "" && networkFamily != family {
+			return fmt.Errorf("%w: value rules need networks of one chain family, but %s is %s and not %s", ErrValidation, key, networkFamily, family)
+		}
+		family = networkFamily
+	}
+	return nil
+}
+
+func validatePolicyRules(rules *PolicyRules) error {
+	if rules.MaxValue != "" {
+		if _, err := parseAmount(rules.MaxValue); err != nil {
+			return fmt.Errorf("%w: maxValue: %v", ErrValidation, err)
+		}
+	}
+	if rules.WindowValue != "" || rules.Window != 0 {
+		if _, err := parseAmount(rules.WindowValue); err != nil {
+			return fmt.Errorf("%w: windowValue: %v", ErrValidation, err)
+		}
+		if rules.Window <= 0 {
+			return fmt.Errorf("%w: window must be positive", ErrValidation)
+		}
+		if time.Duration(rules.Window) > MaxSpendWindow {
+			return fmt.Errorf("%w: window must not exceed %s", ErrValidation, MaxSpendWindow)
+		}
+	}
+	for _, address := range append(append([]string{}, rules.AllowedDestinations...), rules.DeniedDestinations...) {
+		if !addressPattern.MatchString(strings.TrimSpace(address)) && !bitcoinAddressPattern.MatchString(strings.TrimSpace(address)) {
+			return fmt.Errorf("%w: invalid address %q", ErrValidation, address)
+		}
+	}
+	for i, selector := range rules.AllowedMethods {
+		normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(selector), "0x"))
+		if len(normalized) != 8 || strings.Trim(normalized, "0123456789abcdef") != "" {
+			return fmt.Errorf("%w: invalid method selector %q", ErrValidation, selector)
+		}
+		rules.AllowedMethods[i] = "0x" + normalized
+	}
+	if rules.Quorum != nil {
+		return validateQuorum(rules.Quorum)
+	}
+	return nil
+}
+
+func containsAddress(list []string, key string) bool {
+	for _, candidate := range list {
+		if addressKey(candidate) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// addressKey normalises an address for comparison. Hex and bech32 addresses
+// are case-insensitive and are lowercased, hex without its 0x prefix. Other
+// addresses, such as base58 ones, are case-sensitive and kept as they are.
+func addressKey(address string) string {
+	address = strings.TrimSpace(address)
+	switch {
+	case addressPattern.MatchString(address):
+		return strings.ToLower(strings.TrimPrefix(address, "0x"))
+	case bech32AddressPattern.MatchString(address):
+		return strings.ToLower(address)
+	default:
+		return address
+	}
+}
+
+// parseAmount accepts decimal or 0x-prefixed hex wei amounts.
+func parseAmount(input string) (*big.Int, error) {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(input, "0x") {
+		return parseHexQuantity(input)
+	}
+	amount, ok := new(big.Int).SetString(input, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %q", input)
+	}
+	return amount, nil
+}
+
+// parseHexQuantity parses transaction quantities, which are hex with an
+// optional 0x prefix.
+func parseHexQuantity(input string) (*big.Int, error) {
+	digits := strings.TrimPrefix(strings.TrimSpace(input), "0x")
+	if digits == "" {
+		return new(big.Int), nil
+	}
+	// big.Int accepts a sign, which a quantity must not have.
+	amount, ok := new(big.Int).SetString(digits, 16)
+	if !ok || strings.ContainsAny(digits, "+-") {
+		return nil, fmt.Errorf("invalid quantity %q", input)
+	}
+	return amount, nil
+}
@@ -0,0 +1,519 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ExpressionEvaluator compiles and runs policy expressions. An expression
+// allows a transaction by evaluating to true.
+type ExpressionEvaluator interface {
+	Compile(expression string) error
+	Evaluate(ctx context.Context, expression string, input *PolicyInput) (bool, error)
+}
+
+// PolicyInput is the context a policy expression is evaluated over.
+type PolicyInput struct {
+	Wallet      Wallet
+	Tenant      Tenant
+	Transaction Transaction
+	// Value is the parsed transaction value in wei.
+	Value    *big.Int
+	Calldata Calldata
+	Now      time.Time
+	Spend    SpendTotals
+}
+
+// Calldata is the transaction data split into its selector and 32-byte
+// argument words. When the calldata decoder knows the method, Method,
+// Signature and Params describe it. Params are keyed by argument name, or
+// argN for unnamed ones, and formatted as strings: integers in decimal,
+// addresses and bytes as lower-case hex, and arrays and tuples as JSON.
+type Calldata struct {
+	Selector  string
+	Args      []string
+	Method    string
+	Signature string
+	Params    map[string]string
+}
+
+// SpendTotals are the wallet's signed values over rolling windows, in the
+// base unit of its chain family, not counting the transaction being
+// evaluated.
+type SpendTotals struct {
+	LastHour *big.Int
+	LastDay  *big.Int
+	LastWeek *big.Int
+}
+
+// PolicyViolation describes which rule of which policy rejected a
+// transaction. It matches ErrPolicyViolation with errors.Is.
+type PolicyViolation struct {
+	PolicyID string
+	Policy   string
+	Rule     string
+	Reason   string
+}
+
+func (v *PolicyViolation) Error() string {
+	return fmt.Sprintf("%s: policy %q rule %s: %s", ErrPolicyViolation, v.Policy, v.Rule, v.Reason)
+}
+
+func (v *PolicyViolation) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+// PolicyEngine evaluates a tenant's policies against transactions. The wallet
+// service consults it before signing and the policy service uses it for dry
+// runs.
+type PolicyEngine struct {
+	repo      PolicyRepository
+	ledger    SpendLedger
+	tenants   TenantRepository
+	evaluator ExpressionEvaluator
+	decoder   TransactionDecoder
+}
+
+// PolicyEngineOption customises a PolicyEngine at construction time.
+type PolicyEngineOption func(*PolicyEngine)
+
+// WithExpressionEvaluator enables policies written as expressions. Without
+// one, policies that set Expression are rejected at creation.
+func WithExpressionEvaluator(evaluator ExpressionEvaluator) PolicyEngineOption {
+	return func(e *PolicyEngine) {
+		e.evaluator = evaluator
+	}
+}
+
+// WithPolicyTenants exposes tenant details to policy expressions.
+func WithPolicyTenants(tenants TenantRepository) PolicyEngineOption {
+	return func(e *PolicyEngine) {
+		e.tenants = tenants
+	}
+}
+
+// WithCalldataDecoder decodes the arguments of known methods for policy
+// expressions.
+func WithCalldataDecoder(decoder TransactionDecoder) PolicyEngineOption {
+	return func(e *PolicyEngine) {
+		e.decoder = decoder
+	}
+}
+
+// NewPolicyEngine uses ledger for rolling-window spend totals.
+func NewPolicyEngine(repo PolicyRepository, ledger SpendLedger, opts ...PolicyEngineOption) *PolicyEngine {
+	engine := &PolicyEngine{repo: repo, ledger: ledger}
+	for _, opt := range opts {
+		opt(engine)
+	}
+	return engine
+}
+
+// WithPolicies enforces the tenant's signing policies on SignTransaction.
+func WithPolicies(engine *PolicyEngine) WalletServiceOption {
+	return func(s *walletService) {
+		s.policies = engine
+	}
+}
+
+// Check evaluates every policy that governs the wallet against tx and
+// returns the transaction value for spend accounting. When any of them carries
+// a quorum rule, the one requiring the most approvals is returned as well.
+func (e *PolicyEngine) Check(ctx context.Context, record *WalletRecord, tx *Transaction, now time.Time) (*big.Int, *Policy, error) {
+	return e.CheckCalls(ctx, record, []Transaction{*tx}, now)
+}
+
+// CheckCalls is Check for a transaction that makes several calls, such as
+// a user operation batch or the outputs of a PSBT. Destination, method and
+// expression rules see each call with its own value, while value and window
+// limits apply once to the total, which is returned for spend accounting.
+func (e *PolicyEngine) CheckCalls(ctx context.Context, record *WalletRecord, calls []Transaction, now time.Time) (*big.Int, *Policy, error) {
+	return e.checkCalls(ctx, record, calls, nil, now)
+}
+
+// checkCalls is CheckCalls with a fee that the transaction pays as a whole.
+// The fee counts toward the total but belongs to no call.
+func (e *PolicyEngine) checkCalls(ctx context.Context, record *WalletRecord, calls []Transaction, fee *big.Int, now time.Time) (*big.Int, *Policy, error) {
+	total := new(big.Int)
+	if fee != nil {
+		total.Set(fee)
+	}
+	values := make([]*big.Int, len(calls))
+	for i := range calls {
+		value, err := parseHexQuantity(calls[i].Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid value", ErrValidation)
+		}
+		values[i] = value
+		total.Add(total, value)
+	}
+	if len(calls) == 0 && total.Sign() == 0 {
+		return total, nil, nil
+	}
+
+	policies, err := e.repo.List(ctx, record.TenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list policies: %w", err)
+	}
+	var quorum *Policy
+	for i := range policies {
+		policy := &policies[i]
+		if !policy.AppliesTo(record) {
+			continue
+		}
+		if err := e.evaluateLimits(ctx, policy, record, total, now); err != nil {
+			return nil, nil, err
+		}
+		for j := range calls {
+			if err := e.evaluateCall(ctx, policy, record, &calls[j], values[j], now); err != nil {
+				return nil, nil, err
+			}
+		}
+		if q := policy.Rules.Quorum; q != nil && (quorum == nil || q.Required > quorum.Rules.Quorum.Required) {
+			quorum = policy
+		}
+	}
+	return total, quorum, nil
+}
+
+// CheckOpaque rejects a call whose recipient and value cannot be read when
+// a policy governing the wallet limits either, as the call would otherwise
+// escape that policy. what names the call in the violation.
+func (e *PolicyEngine) CheckOpaque(ctx context.Context, record *WalletRecord, what string) error {
+	return e.checkUnreadable(ctx, record, what, func(rule string) bool {
+		return rule != PolicyRuleAllowedMethods && rule != PolicyRuleQuorum
+	})
+}
+
+// CheckUndecoded rejects a transaction the engine cannot decode at all when
+// any policy governs the wallet, so that policies on chain families without
+// a decoder fail closed. what names the transaction in the violation.
+func (e *PolicyEngine) CheckUndecoded(ctx context.Context, record *WalletRecord, what string) error {
+	return e.checkUnreadable(ctx, record, what, func(string) bool { return true })
+}
+
+// AllowsDelegateCall reports whether a policy governing the wallet lets it
+// sign Safe transactions that delegatecall their target.
+func (e *PolicyEngine) AllowsDelegateCall(ctx context.Context, record *WalletRecord) (bool, error) {
+	policies, err := e.repo.List(ctx, record.TenantID)
+	if err != nil {
+		return false, fmt.Errorf("list policies: %w", err)
+	}
+	for i := range policies {
+		if policies[i].AppliesTo(record) && policies[i].Rules.AllowDelegateCall {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkUnreadable returns a violation for the first rule of a policy
+// governing the wallet that matters according to matters.
+func (e *PolicyEngine) checkUnreadable(ctx context.Context, record *WalletRecord, what string, matters func(rule string) bool) error {
+	policies, err := e.repo.List(ctx, record.TenantID)
+	if err != nil {
+		return fmt.Errorf("list policies: %w", err)
+	}
+	for i := range policies {
+		policy := &policies[i]
+		if !policy.AppliesTo(record) {
+			continue
+		}
+		for _, rule := range policy.enforcedRules() {
+			if matters(rule) {
+				return policy.violation(rule, "%s cannot be checked against this rule", what)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluate checks a single policy. Quorum rules are not enforced here; the
+// caller decides whether to queue the transaction.
+func (e *PolicyEngine) Evaluate(ctx context.Context, policy *Policy, record *WalletRecord, tx *Transaction, value *big.Int, now time.Time) error {
+	if err := e.evaluateLimits(ctx, policy, record, value, now); err != nil {
+		return err
+	}
+	return e.evaluateCall(ctx, policy, record, tx, value, now)
+}
+
+// evaluateLimits checks the value and window rules of policy against value.
+func (e *PolicyEngine) evaluateLimits(ctx context.Context, policy *Policy, record *WalletRecord, value *big.Int, now time.Time) error {
+	rules := policy.Rules
+	if !policy.valueRulesApply(record) {
+		for _, rule := range policy.enforcedRules() {
+			if rule == PolicyRuleMaxValue || rule == PolicyRuleWindowValue {
+				return policy.violation(rule, "amounts of a tenant-wide policy without networks are in wei and cannot limit %s wallets", walletFamily(record))
+			}
+		}
+		return nil
+	}
+	if rules.MaxValue != "" {
+		limit, _ := parseAmount(rules.MaxValue)
+		if value.Cmp(limit) > 0 {
+			return policy.violation(PolicyRuleMaxValue, "value %s exceeds %s", value, limit)
+		}
+	}
+
+	if rules.WindowValue != "" && e.ledger != nil {
+		limit, _ := parseAmount(rules.WindowValue)
+		window := time.Duration(rules.Window)
+		spent, err := e.ledger.Total(ctx, policy.spendQuery(record, now.Add(-window)))
+		if err != nil {
+			return fmt.Errorf("load spend: %w", err)
+		}
+		total := new(big.Int).Add(spent, value)
+		if total.Cmp(limit) > 0 {
+			return policy.violation(PolicyRuleWindowValue, "spend of %s in %s would exceed %s", total, window, limit)
+		}
+	}
+	return nil
+}
+
+// evaluateCall checks the destination, method and expression rules of
+// policy against tx, which carries value.
+func (e *PolicyEngine) evaluateCall(ctx context.Context, policy *Policy, record *WalletRecord, tx *Transaction, value *big.Int, now time.Time) error {
+	rules := policy.Rules
+	to := addressKey(tx.To)
+	shown := to
+	if addressPattern.MatchString(to) {
+		shown = "0x" + to
+	}
+	if len(rules.AllowedDestinations) > 0 && !containsAddress(rules.AllowedDestinations, to) {
+		return policy.violation(PolicyRuleAllowedDestinations, "destination %s is not allowed", shown)
+	}
+	if containsAddress(rules.DeniedDestinations, to) {
+		return policy.violation(PolicyRuleDeniedDestinations, "destination %s is denied", shown)
+	}
+
+	if len(rules.AllowedMethods) > 0 {
+		data := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tx.Data), "0x"))
+		if data != "" {
+			if len(data) < 8 {
+				return policy.violation(PolicyRuleAllowedMethods, "calldata is shorter than a selector")
+			}
+			selector := "0x" + data[:8]
+			allowed := false
+			for _, method := range rules.AllowedMethods {
+				if strings.EqualFold(method, selector) {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return policy.violation(PolicyRuleAllowedMethods, "method %s is not allowed", selector)
+			}
+		}
+	}
+
+	if policy.Expression != "" {
+		if e.evaluator == nil {
+			return policy.violation(PolicyRuleExpression, "expression policies are not enabled")
+		}
+		input, err := e.input(ctx, record, tx, value, now)
+		if err != nil {
+			return err
+		}
+		allowed, err := e.evaluator.Evaluate(ctx, policy.Expression, input)
+		if err != nil {
+			return policy.violation(PolicyRuleExpression, "evaluation failed: %v", err)
+		}
+		if !allowed {
+			return policy.violation(PolicyRuleExpression, "expression evaluated to false")
+		}
+	}
+	return nil
+}
+
+// RecordSpend adds a signed transaction's value to the ledger.
+func (e *PolicyEngine) RecordSpend(ctx context.Context, record *WalletRecord, value *big.Int, at time.Time) error {
+	if e.ledger == nil || value.Sign() == 0 {
+		return nil
+	}
+	return e.ledger.Record(ctx, SpendEntry{
+		TenantID: record.TenantID,
+		WalletID: record.ID,
+		Network:  record.Network,
+		Family:   walletFamily(record),
+		Amount:   value,
+		At:       at,
+	})
+}
+
+func (e *PolicyEngine) compile(expression string) error {
+	if expression == "" {
+		return nil
+	}
+	if e.evaluator == nil {
+		return fmt.Errorf("%w: expression policies are not enabled", ErrValidation)
+	}
+	if err := e.evaluator.Compile(expression); err != nil {
+		return fmt.Errorf("%w: expression: %v", ErrValidation, err)
+	}
+	return nil
+}
+
+func (e *PolicyEngine) input(ctx context.Context, record *WalletRecord, tx *Transaction, value *big.Int, now time.Time) (*PolicyInput, error) {
+	input := &PolicyInput{
+		Wallet:      *toWallet(record),
+		Tenant:      Tenant{ID: record.TenantID},
+		Transaction: *tx,
+		Value:       value,
+		Calldata:    e.calldata(tx.Data),
+		Now:         now,
+		Spend:       SpendTotals{LastHour: new(big.Int), LastDay: new(big.Int), LastWeek: new(big.Int)},
+	}
+
+	if e.tenants != nil {
+		tenant, err := e.tenants.GetByID(ctx, record.TenantID)
+		if err != nil && !errors.Is(err, ErrNotFound) {
+			return nil, fmt.Errorf("get tenant: %w", err)
+		}
+		if tenant != nil {
+			input.Tenant = *tenant
+		}
+	}
+
+	if e.ledger != nil {
+		windows := []struct {
+			total  **big.Int
+			window time.Duration
+		}{
+			{&input.Spend.LastHour, time.Hour},
+			{&input.Spend.LastDay, 24 * time.Hour},
+			{&input.Spend.LastWeek, 7 * 24 * time.Hour},
+		}
+		for _, w := range windows {
+			total, err := e.ledger.Total(ctx, SpendQuery{TenantID: record.TenantID, WalletID: record.ID, Family: walletFamily(record), Since: now.Add(-w.window)})
+			if err != nil {
+				return nil, fmt.Errorf("load spend: %w", err)
+			}
+			*w.total = total
+		}
+	}
+	return input, nil
+}
+
+// calldata splits data and, when the decoder knows its method, adds the
+// decoded arguments. Calldata the decoder cannot parse keeps only the
+// split form, so expressions can still match on the selector.
+func (e *PolicyEngine) calldata(data string) Calldata {
+	calldata := splitCalldata(data)
+	if e.decoder == nil || calldata.Selector == "" {
+		return calldata
+	}
+	call, err := e.decoder.DecodeCalldata(strings.TrimSpace(data), "")
+	if err != nil || call.Method == "" {
+		return calldata
+	}
+
+	calldata.Method = call.Method
+	calldata.Signature = call.Signature
+	calldata.Params = make(map[string]string, len(call.Args))
+	for i, arg := range call.Args {
+		name := arg.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		calldata.Params[name] = formatParam(arg.Value)
+	}
+	return calldata
+}
+
+func formatParam(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		if strings.HasPrefix(v, "0x") {
+			return strings.ToLower(v)
+		}
+		return v
+	case []interface{}, map[string]interface{}:
+		encoded, _ := json.Marshal(v)
+		return strings.ToLower(string(encoded))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// splitCalldata separates the 4-byte selector from the 32-byte words that
+// follow it. Trailing bytes that do not fill a word are dropped.
+func splitCalldata(data string) Calldata {
+	hexData := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(data), "0x"))
+	if len(hexData) < 8 {
+		return Calldata{Args: []string{}}
+	}
+
+	calldata := Calldata{Selector: "0x" + hexData[:8], Args: []string{}}
+	for rest := hexData[8:]; len(rest) >= 64; rest = rest[64:] {
+		calldata.Args = append(calldata.Args, "0x"+rest[:64])
+	}
+	return calldata
+}
+
+func (s *walletService) checkPolicies(ctx context.Context, record *WalletRecord, tx *Transaction) (*big.Int, *Policy, error) {
+	if s.policies == nil {
+		value, err := parseHexQuantity(tx.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid value", ErrValidation)
+		}
+		return value, nil, nil
+	}
+	return s.policies.Check(ctx, record, tx, s.now())
+}
+
+// checkCallPolicies is checkPolicies for a transaction made of several
+// calls, plus a fee the transaction pays as a whole. fee may be nil.
+func (s *walletService) checkCallPolicies(ctx context.Context, record *WalletRecord, calls []Transaction, fee *big.Int) (*big.Int, *Policy, error) {
+	if s.policies == nil {
+		total := new(big.Int)
+		if fee != nil {
+			total.Set(fee)
+		}
+		for _, call := range calls {
+			value, err := parseHexQuantity(call.Value)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%w: invalid value", ErrValidation)
+			}
+			total.Add(total, value)
+		}
+		return total, nil, nil
+	}
+	return s.policies.checkCalls(ctx, record, calls, fee, s.now())
+}
+
+// checkUnqueuedPolicies is checkPolicies for signatures that cannot wait in
+// the approval queue, which a quorum policy rejects instead. what names the
+// kind of signature in the violation.
+func (s *walletService) checkUnqueuedPolicies(ctx context.Context, record *WalletRecord, tx *Transaction, what string) (*big.Int, error) {
+	return s.checkUnqueuedCallPolicies(ctx, record, []Transaction{*tx}, nil, what)
+}
+
+// checkUnqueuedCallPolicies is checkUnqueuedPolicies for a transaction made
+// of several calls, plus a fee the transaction pays as a whole.
+func (s *walletService) checkUnqueuedCallPolicies(ctx context.Context, record *WalletRecord, calls []Transaction, fee *big.Int, what string) (*big.Int, error) {
+	value, quorum, err := s.checkCallPolicies(ctx, record, calls, fee)
+	if err != nil {
+		return nil, err
+	}
+	if quorum != nil {
+		return nil, &PolicyViolation{
+			PolicyID: quorum.ID,
+			Policy:   quorum.Name,
+			Rule:     PolicyRuleQuorum,
+			Reason:   what + " cannot be queued for approval",
+		}
+	}
+	return value, nil
+}
+
+func (s *walletService) recordSpend(ctx context.Context, record *WalletRecord, value *big.Int) error {
+	if s.policies == nil {
+		return nil
+	}
+	return s.policies.RecordSpend(ctx, record, value, s.now())
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+// stubEvaluator treats "deny" as false, "broken" as a compile error and
+// anything else as an expression that caps the value at 100 wei.
+type stubEvaluator struct {
+	inputs []*PolicyInput
+}
+
+func (e *stubEvaluator) Compile(expression string) error {
+	if expression == "broken" {
+		return errors.New("syntax error")
+	}
+	return nil
+}
+
+func (e *stubEvaluator) Evaluate(_ context.Context, expression string, input *PolicyInput) (bool, error) {
+	e.inputs = append(e.inputs, input)
+	if expression == "deny" {
+		return false, nil
+	}
+	return input.Value.Cmp(big.NewInt(100)) <= 0, nil
+}
+
+func TestExpressionPolicyGatesSigning(t *testing.T) {
+	repo := newStubRepo()
+	evaluator := &stubEvaluator{}
+	ledger := &stubLedger{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger, WithExpressionEvaluator(evaluator))
+	svc := NewWalletService(repo, &stubSigner{}, WithPolicies(policies), WithClock(func() time.Time { return now }))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{Name: "cap", Expression: "tx.value <= 100"}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	to := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	if _, err := svc.SignTransaction(ctx, wallet.ID, policyTx(to, "0x64", "0xa9059cbb"+zeroWord)); err != nil {
+		t.Fatalf("expected transaction to sign, got %v", err)
+	}
+	_, err = svc.SignTransaction(ctx, wallet.ID, policyTx(to, "0x65", ""))
+	var violation *PolicyViolation
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleExpression {
+		t.Fatalf("expected expression violation, got %v", err)
+	}
+
+	input := evaluator.inputs[0]
+	if input.Wallet.ID != wallet.ID || input.Tenant.ID != DefaultTenantID || !input.Now.Equal(now) {
+		t.Fatalf("unexpected input %+v", input)
+	}
+	if input.Calldata.Selector != "0xa9059cbb" || len(input.Calldata.Args) != 1 {
+		t.Fatalf("unexpected calldata %+v", input.Calldata)
+	}
+	if got := evaluator.inputs[1].Spend.LastHour; got.Cmp(big.NewInt(100)) != 0 {
+		t.Fatalf("expected last hour spend of 100, got %s", got)
+	}
+}
+
+func TestExpressionPolicyValidation(t *testing.T) {
+	withEvaluator := NewPolicyService(NewPolicyEngine(&stubPolicyRepo{}, nil, WithExpressionEvaluator(&stubEvaluator{})), newStubRepo(), familyRegistry{})
+	if _, err := withEvaluator.CreatePolicy(context.Background(), Policy{Name: "bad", Expression: "broken"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation for a bad expression, got %v", err)
+	}
+
+	withoutEvaluator := NewPolicyService(NewPolicyEngine(&stubPolicyRepo{}, nil), newStubRepo(), familyRegistry{})
+	if _, err := withoutEvaluator.CreatePolicy(context.Background(), Policy{Name: "cap", Expression: "true"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected ErrValidation without an evaluator, got %v", err)
+	}
+}
+
+func TestPolicyVersionsAndDryRun(t *testing.T) {
+	repo := newStubRepo()
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger, WithExpressionEvaluator(&stubEvaluator{}))
+	signer := &stubSigner{}
+	wallets := NewWalletService(repo, signer, WithPolicies(policies))
+	svc := NewPolicyService(policies, repo, familyRegistry{})
+	ctx := context.Background()
+
+	wallet, err := wallets.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	created, err := svc.CreatePolicy(ctx, Policy{Name: "cap", Expression: "tx.value <= 100"})
+	if err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	updated, err := svc.UpdatePolicy(ctx, Policy{ID: created.ID, Name: "cap", Expression: "deny"})
+	if err != nil {
+		t.Fatalf("UpdatePolicy returned error: %v", err)
+	}
+	if created.Version != 1 || updated.Version != 2 {
+		t.Fatalf("unexpected versions %d and %d", created.Version, updated.Version)
+	}
+	versions, err := svc.ListPolicyVersions(ctx, created.ID)
+	if err != nil || len(versions) != 2 || versions[0].Expression != "tx.value <= 100" {
+		t.Fatalf("unexpected version history %+v (%v)", versions, err)
+	}
+
+	tx := *policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x64", "")
+	latest, err := svc.DryRunPolicy(ctx, PolicyDryRunRequest{PolicyID: created.ID, WalletID: wallet.ID, Transaction: tx})
+	if err != nil {
+		t.Fatalf("DryRunPolicy returned error: %v", err)
+	}
+	if latest.Allowed || latest.Version != 2 || latest.Rule != PolicyRuleExpression {
+		t.Fatalf("expected latest version to deny, got %+v", latest)
+	}
+
+	first, err := svc.DryRunPolicy(ctx, PolicyDryRunRequest{PolicyID: created.ID, Version: 1, WalletID: wallet.ID, Transaction: tx})
+	if err != nil || !first.Allowed || first.Version != 1 {
+		t.Fatalf("expected version 1 to allow, got %+v (%v)", first, err)
+	}
+
+	candidate, err := svc.DryRunPolicy(ctx, PolicyDryRunRequest{
+		Policy:      &Policy{Name: "candidate", Rules: PolicyRules{MaxValue: "10"}},
+		WalletID:    wallet.ID,
+		Transaction: tx,
+	})
+	if err != nil || candidate.Allowed || candidate.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected candidate to deny on max value, got %+v (%v)", candidate, err)
+	}
+
+	if signer.lastTx != nil || len(ledger.entries) != 0 {
+		t.Fatal("expected dry runs not to sign or record spend")
+	}
+	if _, err := svc.DryRunPolicy(ctx, PolicyDryRunRequest{PolicyID: created.ID, Version: 3, WalletID: wallet.ID, Transaction: tx}); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for an unknown version, got %v", err)
+	}
+}
+
+const zeroWord = "0000000000000000000000000000000000000000000000000000000000000000"
+
+type stubCalldataDecoder struct{}
+
+func (stubCalldataDecoder) DecodeTransaction(string, string) (*DecodedTransaction, error) {
+	return nil, ErrNotImplemented
+}
+
+func (stubCalldataDecoder) DecodeCalldata(data string, _ string) (*DecodedCall, error) {
+	return &DecodedCall{
+		Selector:  data[:10],
+		Signature: "transfer(address,uint256)",
+		Method:    "transfer",
+		Args: []ContractOutput{
+			{Name: "to", Type: "address", Value: "0xAAaaAAaaAAaaAAaaAAaaAAaaAAaaAAaaAAaaAAaa"},
+			{Type: "uint256", Value: "340282366920938463463374607431768211456"},
+		},
+	}, nil
+}
+
+func TestExpressionInputDecodesCalldata(t *testing.T) {
+	repo := newStubRepo()
+	evaluator := &stubEvaluator{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{},
+		WithExpressionEvaluator(evaluator),
+		WithCalldataDecoder(stubCalldataDecoder{}),
+	)
+	svc := NewPolicyService(policies, repo, familyRegistry{}, WithPolicyClock(func() time.Time { return now }))
+	ctx := context.Background()
+
+	wallet, err := NewWalletService(repo, &stubSigner{}).CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	result, err := svc.DryRunPolicy(ctx, PolicyDryRunRequest{
+		Policy:      &Policy{Name: "candidate", Expression: "tx.value <= 100"},
+		WalletID:    wallet.ID,
+		Transaction: *policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x0", "0xa9059cbb"+zeroWord+zeroWord),
+	})
+	if err != nil || !result.Allowed {
+		t.Fatalf("DryRunPolicy returned %+v, %v", result, err)
+	}
+
+	input := evaluator.inputs[0]
+	if !input.Now.Equal(now) {
+		t.Fatalf("expected the dry run to use the policy clock, got %s", input.Now)
+	}
+	calldata := input.Calldata
+	if calldata.Method != "transfer" || calldata.Params["to"] != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" {
+		t.Fatalf("unexpected calldata %+v", calldata)
+	}
+	if calldata.Params["arg1"] != "340282366920938463463374607431768211456" || len(calldata.Args) != 2 {
+		t.Fatalf("expected exact decoded amounts, got %+v", calldata)
+	}
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type stubPolicyRepo struct {
+	mu       sync.Mutex
+	policies []Policy
+	history  []Policy
+}
+
+func (r *stubPolicyRepo) Create(_ context.Context, policy Policy) (*Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies = append(r.policies, policy)
+	r.history = append(r.history, policy)
+	return &policy, nil
+}
+
+func (r *stubPolicyRepo) GetByID(_ context.Context, tenantID string, id string) (*Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, policy := range r.policies {
+		if policy.ID == id && policy.TenantID == tenantID {
+			return &policy, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *stubPolicyRepo) List(_ context.Context, tenantID string) ([]Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []Policy
+	for _, policy := range r.policies {
+		if policy.TenantID == tenantID {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubPolicyRepo) Update(ctx context.Context, policy Policy) (*Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.policies {
+		if r.policies[i].ID == policy.ID {
+			r.policies[i] = policy
+			r.history = append(r.history, policy)
+			return &policy, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *stubPolicyRepo) ListVersions(_ context.Context, tenantID string, id string) ([]Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []Policy
+	for _, policy := range r.history {
+		if policy.ID == id && policy.TenantID == tenantID {
+			result = append(result, policy)
+		}
+	}
+	if len(result) == 0 {
+		return nil, ErrNotFound
+	}
+	return result, nil
+}
+
+func (r *stubPolicyRepo) Delete(_ context.Context, tenantID string, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.policies {
+		if r.policies[i].ID == id && r.policies[i].TenantID == tenantID {
+			r.policies = append(r.policies[:i], r.policies[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+type stubLedger struct {
+	mu      sync.Mutex
+	entries []SpendEntry
+}
+
+func (l *stubLedger) Record(_ context.Context, entry SpendEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *stubLedger) Total(_ context.Context, query SpendQuery) (*big.Int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	total := new(big.Int)
+	for _, entry := range l.entries {
+		if query.Matches(entry) {
+			total.Add(total, entry.Amount)
+		}
+	}
+	return total, nil
+}
+
+func policyTx(to string, value string, data string) *Transaction {
+	return &Transaction{
+		ChainID:  11155111,
+		To:       to,
+		Value:    value,
+		Data:     data,
+		GasLimit: 21000,
+		GasPrice: "0x1",
+		Nonce:    1,
+	}
+}
+
+func TestPoliciesRejectViolatingTransactions(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewWalletService(repo, &stubSigner{},
+		WithPolicies(policies),
+		WithClock(func() time.Time { return now }),
+	)
+	policySvc := NewPolicyService(policies, repo, familyRegistry{})
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	allowed := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	if _, err := policySvc.CreatePolicy(ctx, Policy{
+		Name:     "treasury",
+		WalletID: wallet.ID,
+		Rules: PolicyRules{
+			MaxValue:            "100",
+			WindowValue:         "150",
+			Window:              Duration(time.Hour),
+			AllowedDestinations: []string{allowed},
+			AllowedMethods:      []string{"a9059cbb"},
+		},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	if _, err := policySvc.CreatePolicy(ctx, Policy{
+		Name:     "mainnet-only",
+		Networks: []string{"eth-mainnet"},
+		Rules:    PolicyRules{MaxValue: "0"},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	cases := []struct {
+		name string
+		tx   *Transaction
+		rule string
+	}{
+		{"max value", policyTx(allowed, "0x65", ""), PolicyRuleMaxValue},
+		{"destination", policyTx("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", "0x1", ""), PolicyRuleAllowedDestinations},
+		{"method", policyTx(allowed, "0x0", "0x095ea7b3"), PolicyRuleAllowedMethods},
+	}
+	for _, tc := range cases {
+		_, err := svc.SignTransaction(ctx, wallet.ID, tc.tx)
+		if !errors.Is(err, ErrPolicyViolation) {
+			t.Fatalf("%s: expected ErrPolicyViolation, got %v", tc.name, err)
+		}
+		if !strings.Contains(err.Error(), tc.rule) {
+			t.Fatalf("%s: expected rule %s in %q", tc.name, tc.rule, err)
+		}
+	}
+
+	if _, err := svc.SignTransaction(ctx, wallet.ID, policyTx(allowed, "0x-65", "")); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a negative value to be rejected, got %v", err)
+	}
+
+	if _, err := svc.SignTransaction(ctx, wallet.ID, policyTx(allowed, "0x64", "0xa9059cbb")); err != nil {
+		t.Fatalf("expected transaction within limits to sign, got %v", err)
+	}
+	_, err = svc.SignTransaction(ctx, wallet.ID, policyTx(allowed, "0x64", ""))
+	if !errors.Is(err, ErrPolicyViolation) || !strings.Contains(err.Error(), PolicyRuleWindowValue) {
+		t.Fatalf("expected window limit violation, got %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if _, err := svc.SignTransaction(ctx, wallet.ID, policyTx(allowed, "0x64", "")); err != nil {
+		t.Fatalf("expected spend to reset after the window, got %v", err)
+	}
+}
+
+func TestTenantPolicyAppliesToEveryWallet(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{}, WithPolicies(policies))
+	ctx := context.Background()
+
+	denied := "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "sanctions",
+		Rules: PolicyRules{DeniedDestinations: []string{denied}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	for _, network := range []string{"eth-sepolia", "base-sepolia"} {
+		wallet, err := svc.CreateWallet(ctx, network)
+		if err != nil {
+			t.Fatalf("CreateWallet returned error: %v", err)
+		}
+		_, err = svc.SignTransaction(ctx, wallet.ID, policyTx("0x"+strings.ToUpper(denied[2:]), "0x1", ""))
+		if !errors.Is(err, ErrPolicyViolation) {
+			t.Fatalf("expected denylist violation on %s, got %v", network, err)
+		}
+	}
+}
+
+func TestTenantWindowCoversEveryWallet(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{}, WithPolicies(policies))
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "daily",
+		Rules: PolicyRules{WindowValue: "150", Window: Duration(24 * time.Hour)},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	to := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	first, _ := svc.CreateWallet(ctx, "eth-sepolia")
+	second, _ := svc.CreateWallet(ctx, "eth-sepolia")
+	if _, err := svc.SignTransaction(ctx, first.ID, policyTx(to, "0x64", "")); err != nil {
+		t.Fatalf("expected first transaction to sign, got %v", err)
+	}
+	_, err := svc.SignTransaction(ctx, second.ID, policyTx(to, "0x64", ""))
+	if !errors.Is(err, ErrPolicyViolation) || !strings.Contains(err.Error(), PolicyRuleWindowValue) {
+		t.Fatalf("expected the tenant window to count the other wallet, got %v", err)
+	}
+}
+
+func TestDestinationsCompareBase58Exactly(t *testing.T) {
+	cases := []struct {
+		list []string
+		to   string
+		want bool
+	}{
+		{[]string{"0xAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA"}, "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", true},
+		{[]string{"TB1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KXPJZSX"}, "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", true},
+		{[]string{"mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"}, "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", true},
+		{[]string{"mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"}, "MIPCBBFG9GMICH81KJ8TQQDGOZUB1ZJRFN", false},
+		{[]string{"mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"}, "mipcbbfg9gmich81kj8tqqdgozub1zjrfn", false},
+	}
+	for _, tc := range cases {
+		if got := containsAddress(tc.list, addressKey(tc.to)); got != tc.want {
+			t.Fatalf("containsAddress(%v, %s) = %v, want %v", tc.list, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestCreatePolicyValidatesRules(t *testing.T) {
+	svc := NewPolicyService(NewPolicyEngine(&stubPolicyRepo{}, nil), newStubRepo(), familyRegistry{})
+
+	invalid := []Policy{
+		{Name: ""},
+		{Name: "bad-amount", Rules: PolicyRules{MaxValue: "ten"}},
+		{Name: "no-window", Rules: PolicyRules{WindowValue: "10"}},
+		{Name: "long-window", Rules: PolicyRules{WindowValue: "10", Window: Duration(MaxSpendWindow + time.Hour)}},
+		{Name: "bad-selector", Rules: PolicyRules{AllowedMethods: []string{"0x1234"}}},
+		{Name: "negative-hex", Rules: PolicyRules{MaxValue: "0x-1"}},
+		{Name: "unknown-wallet", WalletID: "missing"},
+	}
+	for _, policy := range invalid {
+		if _, err := svc.CreatePolicy(context.Background(), policy); !errors.Is(err, ErrValidation) {
+			t.Fatalf("%s: expected ErrValidation, got %v", policy.Name, err)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const AuditOperationSignPSBT = "sign_psbt"
+
+// PSBTSigner is implemented by family signers that sign BIP-174 partially
+// signed Bitcoin transactions. PSBTs are base64.
+type PSBTSigner interface {
+	DecodePSBT(network string, psbt string) (*DecodedPSBT, error)
+	// SignPSBT signs and finalizes every input spending the wallet's
+	// address and leaves the others untouched.
+	SignPSBT(record *WalletRecord, psbt string) (*PSBTResult, error)
+}
+
+// PSBTRequest carries a base64 PSBT. Inputs spent by the wallet need their
+// previous output, and taproot inputs need the previous outputs of every
+// input, as BIP-341 commits to all of them.
+type PSBTRequest struct {
+	PSBT string `json:"psbt"`
+}
+
+// DecodedPSBT lists what an unsigned transaction pays. Fee is what the
+// inputs leave to miners, in satoshis. It is nil when an input's previous
+// output is missing, as its value is then unknown.
+type DecodedPSBT struct {
+	TxID    string       `json:"txid"`
+	Outputs []PSBTOutput `json:"outputs"`
+	Fee     *int64       `json:"fee,omitempty"`
+}
+
+// PSBTOutput is an output of a PSBT. Address is empty for scripts that
+// have none, such as OP_RETURN data. Value is in satoshis.
+type PSBTOutput struct {
+	Address string `json:"address,omitempty"`
+	Script  string `json:"script"`
+	Value   int64  `json:"value"`
+}
+
+// PSBTResult is the PSBT with the wallet's inputs signed. When Complete is
+// set every input is finalized and Transaction holds the hex network
+// serialization, ready to broadcast.
+type PSBTResult struct {
+	PSBT         string `json:"psbt"`
+	TxID         string `json:"txid"`
+	SignedInputs []int  `json:"signedInputs"`
+	Complete     bool   `json:"complete"`
+	Transaction  string `json:"transaction,omitempty"`
+}
+
+func (s *walletService) SignPSBT(ctx context.Context, walletID string, req PSBTRequest) (*PSBTResult, error) {
+	result, err := s.signPSBT(ctx, walletID, req)
+
+	var txID string
+	if result != nil {
+		txID = result.TxID
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignPSBT, hashPayload([]byte(req.PSBT)), txID, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return result, err
+}
+
+func (s *walletService) signPSBT(ctx context.Context, walletID string, req PSBTRequest) (*PSBTResult, error) {
+	req.PSBT = strings.TrimSpace(req.PSBT)
+	if req.PSBT == "" {
+		return nil, fmt.Errorf("%w: psbt is required", ErrValidation)
+	}
+
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	if record.Family != ChainFamilyBitcoin {
+		return nil, fmt.Errorf("%w: only bitcoin wallets sign PSBTs", ErrValidation)
+	}
+	familySigner, err := s.familySigner(record.Family)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := familySigner.(PSBTSigner)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s signer does not support PSBTs", ErrNotImplemented, record.Family)
+	}
+
+	decoded, err := signer.DecodePSBT(record.Network, req.PSBT)
+	if err != nil {
+		return nil, fmt.Errorf("decode psbt: %w", err)
+	}
+
+	s.spendMu.Lock()
+	defer s.spendMu.Unlock()
+
+	value, err := s.checkOutputPolicies(ctx, record, decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := signer.SignPSBT(record, req.PSBT)
+	if err != nil {
+		return nil, fmt.Errorf("sign psbt: %w", err)
+	}
+	if len(result.SignedInputs) == 0 {
+		return nil, fmt.Errorf("%w: no input spends %s", ErrValidation, record.Address)
+	}
+	if err := s.recordSpend(ctx, record, value); err != nil {
+		return nil, fmt.Errorf("record spend: %w", err)
+	}
+	return result, nil
+}
+
+// checkOutputPolicies evaluates each output that pays someone other than
+// the wallet as a transaction of its value to its address. Limits apply to
+// the total leaving the wallet rather than to each output. The fee leaves the
+// wallet too, so it counts toward that total, which is returned as the spend;
+// a PSBT whose fee is unknown is refused by policies that limit value. PSBTs
+// cannot be queued for approval, so quorum policies reject them.
+func (s *walletService) checkOutputPolicies(ctx context.Context, record *WalletRecord, decoded *DecodedPSBT) (*big.Int, error) {
+	var outputs []Transaction
+	for _, output := range decoded.Outputs {
+		if output.Address == record.Address || (output.Address == "" && output.Value == 0) {
+			continue
+		}
+		outputs = append(outputs, Transaction{To: output.Address, Value: "0x" + big.NewInt(output.Value).Text(16)})
+	}
+	fee := new(big.Int)
+	if decoded.Fee != nil {
+		fee.SetInt64(*decoded.Fee)
+	} else if s.policies != nil {
+		if err := s.policies.CheckOpaque(ctx, record, "PSBTs without the previous output of every input"); err != nil {
+			return nil, err
+		}
+	}
+	return s.checkUnqueuedCallPolicies(ctx, record, outputs, fee, "PSBTs")
+}
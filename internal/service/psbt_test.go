@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+const testBitcoinAddress = "tb1q9vza2e8x573nczrlzms0wvx3gsqjx7vaxwd45v"
+
+type stubPSBTSigner struct {
+	stubFamilySigner
+	addressType AddressType
+	outputs     []PSBTOutput
+	fee         int64
+	unknownFee  bool
+	signed      bool
+}
+
+func (s *stubPSBTSigner) NewWallet(network string, addressType AddressType) (*WalletRecord, error) {
+	s.addressType = addressType
+	return &WalletRecord{Network: network, Address: testBitcoinAddress, PrivKey: "key", AddressType: AddressTypeP2WPKH}, nil
+}
+
+func (s *stubPSBTSigner) DecodePSBT(_ string, psbt string) (*DecodedPSBT, error) {
+	decoded := &DecodedPSBT{TxID: "txid-" + psbt, Outputs: s.outputs}
+	if !s.unknownFee {
+		fee := s.fee
+		decoded.Fee = &fee
+	}
+	return decoded, nil
+}
+
+func (s *stubPSBTSigner) SignPSBT(_ *WalletRecord, psbt string) (*PSBTResult, error) {
+	s.signed = true
+	return &PSBTResult{PSBT: psbt + "-signed", TxID: "txid-" + psbt, SignedInputs: []int{0}, Complete: true}, nil
+}
+
+func newBitcoinWallet(t *testing.T, signer *stubPSBTSigner, policies *PolicyEngine) (WalletService, *Wallet, *stubAuditLog) {
+	t.Helper()
+	repo := newStubRepo()
+	audit := &stubAuditLog{}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	svc := NewWalletService(repo, &stubSigner{},
+		WithChainFamily(ChainFamilyBitcoin, signer, familyRegistry{}),
+		WithPolicies(policies),
+		WithAuditLog(audit),
+		WithClock(func() time.Time { return now }),
+	)
+	wallet, err := svc.CreateWallet(context.Background(), "bitcoin-signet", WithAddressType(" P2TR "))
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if signer.addressType != AddressTypeP2TR || wallet.Family != ChainFamilyBitcoin {
+		t.Fatalf("unexpected wallet %+v for address type %q", wallet, signer.addressType)
+	}
+	return svc, wallet, audit
+}
+
+func TestSignPSBTChecksExternalOutputs(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{
+		{Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Value: 6000},
+		{Address: "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", Value: 4000},
+		{Address: testBitcoinAddress, Value: 50000},
+		{Script: "6a0568656c6c6f"},
+	}}
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger)
+	svc, wallet, audit := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:     "limits",
+		Networks: []string{"bitcoin-signet"},
+		Rules:    PolicyRules{MaxValue: "10000", DeniedDestinations: []string{"tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7"}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	_, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="})
+	var violation *PolicyViolation
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleDeniedDestinations {
+		t.Fatalf("expected denied destination violation, got %v", err)
+	}
+	if signer.signed {
+		t.Fatal("PSBT was signed despite the violation")
+	}
+	if last := audit.entries[len(audit.entries)-1]; last.Operation != AuditOperationSignPSBT || last.Error == "" {
+		t.Fatalf("unexpected audit entry: %+v", last)
+	}
+
+	signer.outputs = signer.outputs[:1]
+	signer.outputs = append(signer.outputs, PSBTOutput{Address: testBitcoinAddress, Value: 50000})
+	result, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="})
+	if err != nil {
+		t.Fatalf("SignPSBT returned error: %v", err)
+	}
+	if !result.Complete || result.PSBT != "cHNidP8=-signed" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(ledger.entries) != 1 || ledger.entries[0].Amount.Int64() != 6000 {
+		t.Fatalf("expected 6000 sats of spend, got %+v", ledger.entries)
+	}
+}
+
+func TestSignPSBTChecksTotalAgainstValueLimits(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{
+		{Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Value: 6000},
+		{Address: "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", Value: 6000},
+	}}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc, wallet, _ := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:     "limits",
+		Networks: []string{"bitcoin-signet"},
+		Rules:    PolicyRules{MaxValue: "10000"},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	_, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="})
+	var violation *PolicyViolation
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected max value violation, got %v", err)
+	}
+}
+
+func TestSignPSBTCountsTheFeeTowardValueLimits(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{{Address: testBitcoinAddress, Value: 500}}, fee: 20000}
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger)
+	svc, wallet, _ := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:     "limits",
+		Networks: []string{"bitcoin-signet"},
+		Rules:    PolicyRules{MaxValue: "10000"},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	var violation *PolicyViolation
+	if _, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="}); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue || signer.signed {
+		t.Fatalf("expected a fee above the cap to be refused, got %v", err)
+	}
+
+	signer.fee, signer.unknownFee = 0, true
+	if _, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="}); !errors.As(err, &violation) || signer.signed {
+		t.Fatalf("expected an unknown fee to be refused under a value cap, got %v", err)
+	}
+
+	signer.fee, signer.unknownFee = 300, false
+	if _, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="}); err != nil {
+		t.Fatalf("SignPSBT returned error: %v", err)
+	}
+	if len(ledger.entries) != 1 || ledger.entries[0].Amount.Int64() != 300 {
+		t.Fatalf("expected the fee to be recorded as spend, got %+v", ledger.entries)
+	}
+}
+
+func TestSignPSBTChecksEachOutputAtItsOwnValue(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{
+		{Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Value: 60},
+		{Address: "tb1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3q0sl5k7", Value: 70},
+	}}
+	evaluator := &stubEvaluator{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{}, WithExpressionEvaluator(evaluator))
+	svc, wallet, _ := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{Name: "per-output", Expression: "value <= 100"}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	if _, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="}); err != nil {
+		t.Fatalf("expected outputs of 60 and 70 to pass a per-output cap of 100, got %v", err)
+	}
+	if len(evaluator.inputs) != 2 || evaluator.inputs[0].Value.Int64() != 60 || evaluator.inputs[1].Value.Int64() != 70 {
+		t.Fatalf("expected each output to be evaluated at its own value, got %+v", evaluator.inputs)
+	}
+}
+
+func TestSignPSBTRejectsQuorumPolicies(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{{Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Value: 6000}}}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc, wallet, _ := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+
+	if _, err := NewPolicyService(policies, newStubRepo(), familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 1, Approvers: []string{"apikey:alice", "apikey:bob"}, TTL: Duration(time.Hour)}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	_, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="})
+	var violation *PolicyViolation
+	if !errors.As(err, &violation) || violation.Rule != PolicyRuleQuorum || signer.signed {
+		t.Fatalf("expected quorum violation, got %v", err)
+	}
+}
+
+func TestValueRulesKeepChainFamiliesApart(t *testing.T) {
+	signer := &stubPSBTSigner{outputs: []PSBTOutput{{Address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx", Value: 5000}}}
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger)
+	svc, bitcoin, _ := newBitcoinWallet(t, signer, policies)
+	ctx := context.Background()
+	ethereum, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	policyService := NewPolicyService(policies, newStubRepo(), familyRegistry{})
+	wei, err := policyService.CreatePolicy(ctx, Policy{
+		Name:  "wei",
+		Rules: PolicyRules{MaxValue: "1000", WindowValue: "1500", Window: Duration(time.Hour)},
+	})
+	if err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	var violation *PolicyViolation
+	if _, err := svc.SignPSBT(ctx, bitcoin.ID, PSBTRequest{PSBT: "cHNidP8="}); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue || signer.signed {
+		t.Fatalf("expected a tenant-wide wei cap to refuse bitcoin wallets, got %v", err)
+	}
+
+	wei.Networks = []string{"eth-sepolia"}
+	if _, err := policyService.UpdatePolicy(ctx, *wei); err != nil {
+		t.Fatalf("UpdatePolicy returned error: %v", err)
+	}
+	if _, err := svc.SignPSBT(ctx, bitcoin.ID, PSBTRequest{PSBT: "cHNidP8="}); err != nil {
+		t.Fatalf("expected a wei cap scoped to ethereum not to limit satoshis, got %v", err)
+	}
+	if _, err := svc.SignTransaction(ctx, ethereum.ID, policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x3e8", "")); err != nil {
+		t.Fatalf("expected the satoshis to stay out of the wei window, got %v", err)
+	}
+	if len(ledger.entries) != 2 || ledger.entries[0].Family != ChainFamilyBitcoin || ledger.entries[1].Family != ChainFamilyEthereum {
+		t.Fatalf("expected spend tagged with its family, got %+v", ledger.entries)
+	}
+
+	if _, err := policyService.CreatePolicy(ctx, Policy{
+		Name:     "sats",
+		Networks: []string{"bitcoin-signet"},
+		Rules:    PolicyRules{MaxValue: "4000"},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	if _, err := svc.SignPSBT(ctx, bitcoin.ID, PSBTRequest{PSBT: "cHNidP8="}); !errors.As(err, &violation) || violation.Policy != "sats" {
+		t.Fatalf("expected a bitcoin-scoped cap to apply in satoshis, got %v", err)
+	}
+
+	if _, err := policyService.CreatePolicy(ctx, Policy{
+		Name:     "mixed",
+		Networks: []string{"eth-sepolia", "bitcoin-signet"},
+		Rules:    PolicyRules{MaxValue: "1000"},
+	}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected value rules across families to be rejected, got %v", err)
+	}
+}
+
+func TestSignPSBTRejectsOtherFamilies(t *testing.T) {
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithChainFamily(ChainFamilyBitcoin, &stubPSBTSigner{}, familyRegistry{}))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := svc.SignPSBT(ctx, wallet.ID, PSBTRequest{PSBT: "cHNidP8="}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia", WithAddressType(AddressTypeP2TR)); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for an address type on ethereum, got %v", err)
+	}
+}
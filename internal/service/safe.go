@@ -0,0 +1,579 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SafeOperation uint8
+
+const (
+	SafeOperationCall         SafeOperation = 0
+	SafeOperationDelegateCall SafeOperation = 1
+
+	AuditOperationSignSafeTransaction = "sign_safe_transaction"
+
+	zeroAddress = "0x0000000000000000000000000000000000000000"
+)
+
+type SafeProposalStatus string
+
+const (
+	// SafeProposalStatusPending is waiting for owner signatures.
+	SafeProposalStatusPending SafeProposalStatus = "pending"
+	// SafeProposalStatusReady has reached the threshold and carries the
+	// execTransaction calldata.
+	SafeProposalStatusReady SafeProposalStatus = "ready"
+	// SafeProposalStatusExecuted has been submitted to the network.
+	SafeProposalStatusExecuted SafeProposalStatus = "executed"
+)
+
+// SafeTransaction is the SafeTx a Safe's owners sign. Value and the gas
+// fields are wei amounts, decimal or 0x hex; they are stored as decimals.
+// GasToken and RefundReceiver default to the zero address, and Nonce to the
+// Safe's current nonce.
+type SafeTransaction struct {
+	To             string        `json:"to"`
+	Value          string        `json:"value,omitempty"`
+	Data           string        `json:"data,omitempty"`
+	Operation      SafeOperation `json:"operation"`
+	SafeTxGas      string        `json:"safeTxGas,omitempty"`
+	BaseGas        string        `json:"baseGas,omitempty"`
+	GasPrice       string        `json:"gasPrice,omitempty"`
+	GasToken       string        `json:"gasToken,omitempty"`
+	RefundReceiver string        `json:"refundReceiver,omitempty"`
+	Nonce          string        `json:"nonce,omitempty"`
+}
+
+// SafeInfo is the on-chain state of a Safe.
+type SafeInfo struct {
+	Version   string   `json:"version"`
+	Threshold int      `json:"threshold"`
+	Owners    []string `json:"owners"`
+	Nonce     string   `json:"nonce"`
+}
+
+type SafeProposalRequest struct {
+	Network     string          `json:"network"`
+	Safe        string          `json:"safe"`
+	Transaction SafeTransaction `json:"transaction"`
+}
+
+// SafeSignature is one owner's EIP-712 signature of the SafeTxHash.
+type SafeSignature struct {
+	Owner     string    `json:"owner"`
+	WalletID  string    `json:"walletId"`
+	Signature string    `json:"signature"`
+	SignedAt  time.Time `json:"signedAt"`
+}
+
+// SafeProposal is a SafeTx collecting owner signatures. Threshold and Owners
+// are read from the Safe when it is proposed. ExecutionData is set once the
+// threshold is met.
+type SafeProposal struct {
+	ID                string             `json:"id"`
+	TenantID          string             `json:"tenantId"`
+	Network           string             `json:"network"`
+	ChainID           int64              `json:"chainId"`
+	Safe              string             `json:"safe"`
+	Version           string             `json:"version"`
+	Threshold         int                `json:"threshold"`
+	Owners            []string           `json:"owners"`
+	Transaction       SafeTransaction    `json:"transaction"`
+	SafeTxHash        string             `json:"safeTxHash"`
+	TypedData         *TypedData         `json:"typedData"`
+	Signatures        []SafeSignature    `json:"signatures"`
+	Status            SafeProposalStatus `json:"status"`
+	ExecutionData     string             `json:"executionData,omitempty"`
+	SignedTransaction string             `json:"signedTransaction,omitempty"`
+	TxHash            string             `json:"txHash,omitempty"`
+	BroadcastError    string             `json:"broadcastError,omitempty"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
+// SafeExecuteRequest submits a ready proposal from an owner wallet. The
+// transaction fields left empty are read from the network.
+type SafeExecuteRequest struct {
+	TokenTxOptions
+}
+
+type SafeProposalRepository interface {
+	Create(ctx context.Context, proposal SafeProposal) (*SafeProposal, error)
+	GetByID(ctx context.Context, tenantID string, id string) (*SafeProposal, error)
+	List(ctx context.Context, tenantID string) ([]SafeProposal, error)
+	Update(ctx context.Context, proposal SafeProposal) (*SafeProposal, error)
+}
+
+// SafeClient reads Safe state from the chain, hashes SafeTx typed data and
+// encodes execTransaction calls.
+type SafeClient interface {
+	ReadSafe(ctx context.Context, rpcURL string, safe string) (*SafeInfo, error)
+	HashTypedData(data *TypedData) (string, error)
+	EncodeExecTransaction(tx SafeTransaction, signatures string) (string, error)
+}
+
+// WithSafes enables Safe proposals. Executing a proposal also needs the chain
+// set by WithTokenChain to fill in the transaction.
+func WithSafes(client SafeClient, proposals SafeProposalRepository, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.safes = client
+		s.safeProposals = proposals
+		s.registry = registry
+	}
+}
+
+// ProposeSafeTransaction reads the Safe's version, owners, threshold and
+// nonce and records the SafeTx for owners to sign.
+func (s *walletService) ProposeSafeTransaction(ctx context.Context, req SafeProposalRequest) (*SafeProposal, error) {
+	if s.safes == nil || s.safeProposals == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: Safe transactions are not configured", ErrNotImplemented)
+	}
+	req.Safe = strings.TrimSpace(req.Safe)
+	if !addressPattern.MatchString(req.Safe) {
+		return nil, fmt.Errorf("%w: invalid Safe address", ErrValidation)
+	}
+	tx, err := normalizeSafeTransaction(req.Transaction)
+	if err != nil {
+		return nil, err
+	}
+	req.Network = strings.TrimSpace(req.Network)
+	network, err := s.registry.Lookup(req.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+
+	info, err := s.safes.ReadSafe(ctx, network.RPCURL, req.Safe)
+	if err != nil {
+		return nil, fmt.Errorf("read Safe: %w", err)
+	}
+	if info.Threshold < 1 || len(info.Owners) < info.Threshold {
+		return nil, fmt.Errorf("%w: %s is not a configured Safe", ErrValidation, req.Safe)
+	}
+	if tx.Nonce == "" {
+		tx.Nonce = info.Nonce
+	} else if current, err := parseAmount(info.Nonce); err == nil {
+		if nonce, _ := parseAmount(tx.Nonce); nonce.Cmp(current) < 0 {
+			return nil, fmt.Errorf("%w: nonce %s has already been used; the Safe is at %s", ErrValidation, tx.Nonce, info.Nonce)
+		}
+	}
+
+	typedData, err := SafeTypedData(info.Version, network.ChainID, req.Safe, tx)
+	if err != nil {
+		return nil, err
+	}
+	safeTxHash, err := s.safes.HashTypedData(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	proposal := SafeProposal{
+		ID:          uuid.NewString(),
+		TenantID:    TenantFromContext(ctx),
+		Network:     req.Network,
+		ChainID:     network.ChainID,
+		Safe:        req.Safe,
+		Version:     info.Version,
+		Threshold:   info.Threshold,
+		Owners:      info.Owners,
+		Transaction: tx,
+		SafeTxHash:  safeTxHash,
+		TypedData:   typedData,
+		Signatures:  []SafeSignature{},
+		Status:      SafeProposalStatusPending,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	created, err := s.safeProposals.Create(ctx, proposal)
+	if err != nil {
+		return nil, fmt.Errorf("store Safe proposal: %w", err)
+	}
+	return created, nil
+}
+
+func (s *walletService) GetSafeProposal(ctx context.Context, id string) (*SafeProposal, error) {
+	if s.safeProposals == nil {
+		return nil, ErrNotFound
+	}
+	proposal, err := s.safeProposals.GetByID(ctx, TenantFromContext(ctx), id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get Safe proposal: %w", err)
+	}
+	return proposal, nil
+}
+
+func (s *walletService) ListSafeProposals(ctx context.Context, status SafeProposalStatus) ([]SafeProposal, error) {
+	if s.safeProposals == nil {
+		return []SafeProposal{}, nil
+	}
+	proposals, err := s.safeProposals.List(ctx, TenantFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("list Safe proposals: %w", err)
+	}
+	result := make([]SafeProposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		if status == "" || proposal.Status == status {
+			result = append(result, proposal)
+		}
+	}
+	return result, nil
+}
+
+// SignSafeProposal adds the signature of walletID, which must be an owner of
+// the Safe. Once the threshold is met the signatures are packed into the
+// execTransaction calldata.
+func (s *walletService) SignSafeProposal(ctx context.Context, proposalID string, walletID string) (*SafeProposal, error) {
+	proposal, signature, err := s.signSafeProposal(ctx, proposalID, walletID)
+
+	var payloadHash, result string
+	if proposal != nil {
+		payloadHash = proposal.SafeTxHash
+	}
+	if signature != nil {
+		result = signature.Signature
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignSafeTransaction, payloadHash, result, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return proposal, err
+}
+
+func (s *walletService) signSafeProposal(ctx context.Context, proposalID string, walletID string) (*SafeProposal, *SafeSignature, error) {
+	if s.safes == nil || s.safeProposals == nil {
+		return nil, nil, fmt.Errorf("%w: Safe transactions are not configured", ErrNotImplemented)
+	}
+
+	s.safeMu.Lock()
+	defer s.safeMu.Unlock()
+
+	proposal, err := s.GetSafeProposal(ctx, proposalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	record, err := s.loadSafeOwner(ctx, proposal, walletID)
+	if err != nil {
+		return proposal, nil, err
+	}
+	if proposal.Status != SafeProposalStatusPending {
+		return proposal, nil, fmt.Errorf("%w: proposal is %s", ErrConflict, proposal.Status)
+	}
+	for _, existing := range proposal.Signatures {
+		if strings.EqualFold(existing.Owner, record.Address) {
+			return proposal, nil, fmt.Errorf("%w: %s has already signed", ErrConflict, record.Address)
+		}
+	}
+	if err := s.checkSafeTransactionPolicies(ctx, record, proposal); err != nil {
+		return proposal, nil, err
+	}
+
+	output, err := s.signer.SignTypedData(record.Network, record.PrivKey, proposal.TypedData)
+	if err != nil {
+		return proposal, nil, fmt.Errorf("sign Safe transaction: %w", err)
+	}
+	signature := SafeSignature{
+		Owner:     record.Address,
+		WalletID:  record.ID,
+		Signature: output.Signature,
+		SignedAt:  s.now(),
+	}
+	proposal.Signatures = append(proposal.Signatures, signature)
+	proposal.UpdatedAt = signature.SignedAt
+
+	if len(proposal.Signatures) >= proposal.Threshold {
+		packed, err := packSafeSignatures(proposal.Signatures)
+		if err != nil {
+			return proposal, &signature, err
+		}
+		data, err := s.safes.EncodeExecTransaction(proposal.Transaction, packed)
+		if err != nil {
+			return proposal, &signature, fmt.Errorf("encode execTransaction: %w", err)
+		}
+		proposal.ExecutionData = data
+		proposal.Status = SafeProposalStatusReady
+	}
+
+	updated, err := s.safeProposals.Update(ctx, *proposal)
+	if err != nil {
+		return proposal, &signature, fmt.Errorf("store Safe proposal: %w", err)
+	}
+	return updated, &signature, nil
+}
+
+// ExecuteSafeProposal signs an execTransaction call to the Safe from
+// walletID, which must be one of its owners. The transaction goes through the
+// wallet's policies like any other.
+func (s *walletService) ExecuteSafeProposal(ctx context.Context, proposalID string, walletID string, req SafeExecuteRequest) (*SafeProposal, error) {
+	if s.safes == nil || s.safeProposals == nil || s.chain == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: Safe execution is not configured", ErrNotImplemented)
+	}
+	proposal, err := s.GetSafeProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	record, err := s.loadSafeOwner(ctx, proposal, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Status != SafeProposalStatusReady {
+		return nil, fmt.Errorf("%w: proposal is %s", ErrConflict, proposal.Status)
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+
+	tx := &Transaction{
+		ChainID:  network.ChainID,
+		From:     record.Address,
+		To:       proposal.Safe,
+		Value:    "0x0",
+		Data:     proposal.ExecutionData,
+		GasLimit: req.GasLimit,
+		GasPrice: req.GasPrice,
+		Nonce:    req.Nonce,
+	}
+	if err := s.fillTransaction(ctx, network, record.Address, tx); err != nil {
+		return nil, err
+	}
+	var signOpts []SignOption
+	if req.Force {
+		signOpts = append(signOpts, ForceSign())
+	}
+	signed, err := s.SignTransaction(ctx, walletID, tx, signOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	proposal.SignedTransaction = signed
+	proposal.BroadcastError = ""
+	if req.Broadcast {
+		if s.broadcaster == nil {
+			proposal.BroadcastError = "broadcasting is not configured"
+		} else if hash, err := s.broadcaster.Broadcast(ctx, network.RPCURL, signed); err != nil {
+			proposal.BroadcastError = err.Error()
+		} else {
+			proposal.TxHash = hash
+			proposal.Status = SafeProposalStatusExecuted
+		}
+	}
+	proposal.UpdatedAt = s.now()
+
+	updated, err := s.safeProposals.Update(ctx, *proposal)
+	if err != nil {
+		return nil, fmt.Errorf("store Safe proposal: %w", err)
+	}
+	return updated, nil
+}
+
+// loadSafeOwner loads walletID and checks that it is an active EOA owner of
+// the proposal's Safe on the same network.
+// checkSafeTransactionPolicies evaluates the SafeTx an owner is about to sign
+// as a transaction from the Safe, since the owner's signature is what lets
+// the Safe send it. Delegatecalls also need a policy that allows them.
+func (s *walletService) checkSafeTransactionPolicies(ctx context.Context, record *WalletRecord, proposal *SafeProposal) error {
+	tx := proposal.Transaction
+	if tx.Operation == SafeOperationDelegateCall {
+		allowed := false
+		if s.policies != nil {
+			var err error
+			if allowed, err = s.policies.AllowsDelegateCall(ctx, record); err != nil {
+				return err
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%w: delegatecall Safe transactions need a policy that sets allowDelegateCall", ErrPolicyViolation)
+		}
+	}
+
+	value, err := parseAmount(tx.Value)
+	if err != nil {
+		return fmt.Errorf("%w: value: %v", ErrValidation, err)
+	}
+	_, err = s.checkUnqueuedPolicies(ctx, record, &Transaction{
+		ChainID: proposal.ChainID,
+		From:    proposal.Safe,
+		To:      tx.To,
+		Value:   "0x" + value.Text(16),
+		Data:    tx.Data,
+	}, "Safe signatures")
+	return err
+}
+
+func (s *walletService) loadSafeOwner(ctx context.Context, proposal *SafeProposal, walletID string) (*WalletRecord, error) {
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	if record.Network != proposal.Network {
+		return nil, fmt.Errorf("%w: wallet is on %s, the Safe is on %s", ErrValidation, record.Network, proposal.Network)
+	}
+	if record.SmartAccount != nil {
+		return nil, errSmartAccountTransaction
+	}
+	for _, owner := range proposal.Owners {
+		if strings.EqualFold(owner, record.Address) {
+			return record, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s is not an owner of %s", ErrValidation, record.Address, proposal.Safe)
+}
+
+func normalizeSafeTransaction(tx SafeTransaction) (SafeTransaction, error) {
+	tx.To = strings.TrimSpace(tx.To)
+	if !addressPattern.MatchString(tx.To) {
+		return tx, fmt.Errorf("%w: invalid to address", ErrValidation)
+	}
+	if tx.Operation != SafeOperationCall && tx.Operation != SafeOperationDelegateCall {
+		return tx, fmt.Errorf("%w: operation must be 0 (call) or 1 (delegatecall)", ErrValidation)
+	}
+	tx.Data = strings.TrimSpace(tx.Data)
+	if tx.Data == "" {
+		tx.Data = "0x"
+	}
+	if !hexDataPattern.MatchString(tx.Data) {
+		return tx, fmt.Errorf("%w: data must be 0x-prefixed hex", ErrValidation)
+	}
+
+	amounts := []struct {
+		name  string
+		value *string
+	}{
+		{"value", &tx.Value},
+		{"safeTxGas", &tx.SafeTxGas},
+		{"baseGas", &tx.BaseGas},
+		{"gasPrice", &tx.GasPrice},
+	}
+	for _, field := range amounts {
+		if strings.TrimSpace(*field.value) == "" {
+			*field.value = "0"
+			continue
+		}
+		amount, err := parseAmount(*field.value)
+		if err != nil {
+			return tx, fmt.Errorf("%w: %s: %v", ErrValidation, field.name, err)
+		}
+		*field.value = amount.String()
+	}
+	if tx.Nonce = strings.TrimSpace(tx.Nonce); tx.Nonce != "" {
+		nonce, err := parseAmount(tx.Nonce)
+		if err != nil {
+			return tx, fmt.Errorf("%w: nonce: %v", ErrValidation, err)
+		}
+		tx.Nonce = nonce.String()
+	}
+
+	for _, field := range []*string{&tx.GasToken, &tx.RefundReceiver} {
+		*field = strings.TrimSpace(*field)
+		if *field == "" {
+			*field = zeroAddress
+		}
+		if !addressPattern.MatchString(*field) {
+			return tx, fmt.Errorf("%w: invalid gas token or refund receiver address", ErrValidation)
+		}
+	}
+	return tx, nil
+}
+
+// SafeTypedData builds the SafeTx typed data. Safes from 1.3.0 include the
+// chain ID in their domain; earlier 1.x versions only the Safe address.
+func SafeTypedData(version string, chainID int64, safe string, tx SafeTransaction) (*TypedData, error) {
+	major, minor, err := parseSafeVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if major != 1 {
+		return nil, fmt.Errorf("%w: unsupported Safe version %q", ErrValidation, version)
+	}
+
+	domainFields := []TypedDataField{{Name: "verifyingContract", Type: "address"}}
+	domain := TypedDataDomain{VerifyingContract: safe}
+	if minor >= 3 {
+		domainFields = []TypedDataField{{Name: "chainId", Type: "uint256"}, {Name: "verifyingContract", Type: "address"}}
+		domain.ChainID = chainID
+	}
+
+	return &TypedData{
+		Types: map[string][]TypedDataField{
+			"EIP712Domain": domainFields,
+			"SafeTx": {
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+				{Name: "operation", Type: "uint8"},
+				{Name: "safeTxGas", Type: "uint256"},
+				{Name: "baseGas", Type: "uint256"},
+				{Name: "gasPrice", Type: "uint256"},
+				{Name: "gasToken", Type: "address"},
+				{Name: "refundReceiver", Type: "address"},
+				{Name: "nonce", Type: "uint256"},
+			},
+		},
+		PrimaryType: "SafeTx",
+		Domain:      domain,
+		Message: map[string]interface{}{
+			"to":             tx.To,
+			"value":          tx.Value,
+			"data":           tx.Data,
+			"operation":      strconv.Itoa(int(tx.Operation)),
+			"safeTxGas":      tx.SafeTxGas,
+			"baseGas":        tx.BaseGas,
+			"gasPrice":       tx.GasPrice,
+			"gasToken":       tx.GasToken,
+			"refundReceiver": tx.RefundReceiver,
+			"nonce":          tx.Nonce,
+		},
+	}, nil
+}
+
+// parseSafeVersion reads the major and minor version from strings such as
+// "1.3.0" and "1.3.0+L2".
+func parseSafeVersion(version string) (int, int, error) {
+	core, _, _ := strings.Cut(strings.TrimSpace(version), "+")
+	parts := strings.Split(core, ".")
+	if len(parts) < 2 {
+		return 0, 0, fmt.Errorf("%w: unsupported Safe version %q", ErrValidation, version)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unsupported Safe version %q", ErrValidation, version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: unsupported Safe version %q", ErrValidation, version)
+	}
+	return major, minor, nil
+}
+
+// packSafeSignatures concatenates 65-byte r||s||v signatures in ascending
+// owner order, as checkSignatures requires.
+func packSafeSignatures(signatures []SafeSignature) (string, error) {
+	sorted := append([]SafeSignature(nil), signatures...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return strings.ToLower(sorted[i].Owner) < strings.ToLower(sorted[j].Owner)
+	})
+
+	var b strings.Builder
+	b.WriteString("0x")
+	for _, signature := range sorted {
+		digits := strings.TrimPrefix(signature.Signature, "0x")
+		if len(digits) != 130 {
+			return "", fmt.Errorf("unexpected signature length %d from %s", len(digits)/2, signature.Owner)
+		}
+		b.WriteString(strings.ToLower(digits))
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// safeOwnerSigner hands out a distinct address and signature per wallet, in
+// descending address order.
+type safeOwnerSigner struct {
+	stubSigner
+	created int
+}
+
+func (s *safeOwnerSigner) NewWallet(network string) (*WalletRecord, error) {
+	s.created++
+	digit := strconv.Itoa(9 - s.created)
+	return &WalletRecord{
+		Network:   network,
+		Address:   "0x" + strings.Repeat(digit, 40),
+		PublicKey: "pub-key",
+		PrivKey:   digit,
+	}, nil
+}
+
+func (s *safeOwnerSigner) SignTypedData(_ string, privKeyHex string, data *TypedData) (*SignatureOutput, error) {
+	s.lastTypedData = data
+	return &SignatureOutput{Signature: "0x" + strings.Repeat(privKeyHex, 128) + "1b"}, nil
+}
+
+// stubSafeRegistry gives networks a display name that differs from their
+// key, so proposals that store the name instead of the key are caught.
+type stubSafeRegistry struct{}
+
+func (stubSafeRegistry) Lookup(network string) (*Network, error) {
+	return &Network{
+		Name:    "Test " + network,
+		ChainID: 11155111,
+		RPCURL:  "http://" + network,
+	}, nil
+}
+
+type stubSafeClient struct {
+	info       SafeInfo
+	signatures string
+}
+
+func (c *stubSafeClient) ReadSafe(context.Context, string, string) (*SafeInfo, error) {
+	info := c.info
+	return &info, nil
+}
+
+func (c *stubSafeClient) HashTypedData(*TypedData) (string, error) {
+	return "0x" + strings.Repeat("ab", 32), nil
+}
+
+func (c *stubSafeClient) EncodeExecTransaction(_ SafeTransaction, signatures string) (string, error) {
+	c.signatures = signatures
+	return "0x6a761202", nil
+}
+
+type stubSafeProposalRepo struct {
+	proposals map[string]SafeProposal
+}
+
+func (r *stubSafeProposalRepo) Create(_ context.Context, proposal SafeProposal) (*SafeProposal, error) {
+	r.proposals[proposal.ID] = proposal
+	return &proposal, nil
+}
+
+func (r *stubSafeProposalRepo) GetByID(_ context.Context, tenantID string, id string) (*SafeProposal, error) {
+	proposal, ok := r.proposals[id]
+	if !ok || proposal.TenantID != tenantID {
+		return nil, ErrNotFound
+	}
+	proposal.Signatures = append([]SafeSignature(nil), proposal.Signatures...)
+	return &proposal, nil
+}
+
+func (r *stubSafeProposalRepo) List(_ context.Context, tenantID string) ([]SafeProposal, error) {
+	var result []SafeProposal
+	for _, proposal := range r.proposals {
+		if proposal.TenantID == tenantID {
+			result = append(result, proposal)
+		}
+	}
+	return result, nil
+}
+
+func (r *stubSafeProposalRepo) Update(_ context.Context, proposal SafeProposal) (*SafeProposal, error) {
+	r.proposals[proposal.ID] = proposal
+	return &proposal, nil
+}
+
+func TestSafeProposalCollectsSignaturesAndExecutes(t *testing.T) {
+	signer := &safeOwnerSigner{}
+	client := &stubSafeClient{}
+	broadcaster := &stubBroadcaster{}
+	audit := &stubAuditLog{}
+	svc := NewWalletService(newStubRepo(), signer,
+		WithSafes(client, &stubSafeProposalRepo{proposals: map[string]SafeProposal{}}, stubSafeRegistry{}),
+		WithTokenChain(&stubTokenChain{}, stubSafeRegistry{}),
+		WithBroadcaster(broadcaster, stubSafeRegistry{}),
+		WithAuditLog(audit),
+	)
+	ctx := context.Background()
+
+	var wallets []*Wallet
+	for i := 0; i < 3; i++ {
+		wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+		if err != nil {
+			t.Fatalf("CreateWallet returned error: %v", err)
+		}
+		wallets = append(wallets, wallet)
+	}
+	client.info = SafeInfo{Version: "1.3.0", Threshold: 2, Nonce: "5", Owners: []string{wallets[0].Address, wallets[1].Address}}
+
+	proposal, err := svc.ProposeSafeTransaction(ctx, SafeProposalRequest{
+		Network:     "eth-sepolia",
+		Safe:        "0x9999999999999999999999999999999999999999",
+		Transaction: SafeTransaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "0x3e8"},
+	})
+	if err != nil {
+		t.Fatalf("ProposeSafeTransaction returned error: %v", err)
+	}
+	if proposal.Network != "eth-sepolia" || proposal.Transaction.Nonce != "5" || proposal.Transaction.Value != "1000" || proposal.Transaction.Data != "0x" || proposal.TypedData.Domain.ChainID != 11155111 {
+		t.Fatalf("unexpected proposal %+v", proposal)
+	}
+
+	if _, err := svc.SignSafeProposal(ctx, proposal.ID, wallets[2].ID); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a non-owner to be rejected, got %v", err)
+	}
+	if _, err := svc.ExecuteSafeProposal(ctx, proposal.ID, wallets[0].ID, SafeExecuteRequest{}); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected execution below the threshold to conflict, got %v", err)
+	}
+
+	// The first owner has the higher address, so the packed signatures must
+	// be reordered.
+	proposal, err = svc.SignSafeProposal(ctx, proposal.ID, wallets[0].ID)
+	if err != nil || proposal.Status != SafeProposalStatusPending {
+		t.Fatalf("expected the proposal to stay pending, got %+v (%v)", proposal, err)
+	}
+	if _, err := svc.SignSafeProposal(ctx, proposal.ID, wallets[0].ID); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected a second signature from the same owner to conflict, got %v", err)
+	}
+	proposal, err = svc.SignSafeProposal(ctx, proposal.ID, wallets[1].ID)
+	if err != nil || proposal.Status != SafeProposalStatusReady || proposal.ExecutionData != "0x6a761202" {
+		t.Fatalf("expected the proposal to be ready, got %+v (%v)", proposal, err)
+	}
+	want := "0x" + strings.Repeat("7", 128) + "1b" + strings.Repeat("8", 128) + "1b"
+	if client.signatures != want {
+		t.Fatalf("expected signatures sorted by owner, got %s", client.signatures)
+	}
+
+	proposal, err = svc.ExecuteSafeProposal(ctx, proposal.ID, wallets[1].ID, SafeExecuteRequest{TokenTxOptions{Broadcast: true}})
+	if err != nil {
+		t.Fatalf("ExecuteSafeProposal returned error: %v", err)
+	}
+	if proposal.Status != SafeProposalStatusExecuted || proposal.TxHash != "0xhash" || broadcaster.signed != "signed-tx" {
+		t.Fatalf("unexpected executed proposal %+v", proposal)
+	}
+	if signer.lastTx.To != "0x9999999999999999999999999999999999999999" || signer.lastTx.Data != "0x6a761202" || signer.lastTx.From != wallets[1].Address {
+		t.Fatalf("unexpected execution transaction %+v", signer.lastTx)
+	}
+
+	entries, err := audit.Entries(ctx)
+	if err != nil {
+		t.Fatalf("Entries returned error: %v", err)
+	}
+	var signed int
+	for _, entry := range entries {
+		if entry.Operation == AuditOperationSignSafeTransaction && entry.Error == "" {
+			signed++
+		}
+	}
+	if signed != 2 {
+		t.Fatalf("expected two audited Safe signatures, got %+v", entries)
+	}
+}
+
+func TestSignSafeProposalChecksPolicies(t *testing.T) {
+	repo := newStubRepo()
+	client := &stubSafeClient{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &safeOwnerSigner{},
+		WithSafes(client, &stubSafeProposalRepo{proposals: map[string]SafeProposal{}}, stubSafeRegistry{}),
+		WithPolicies(policies),
+	)
+	ctx := context.Background()
+
+	owner, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	client.info = SafeInfo{Version: "1.3.0", Threshold: 1, Nonce: "0", Owners: []string{owner.Address}}
+	policyService := NewPolicyService(policies, repo, familyRegistry{})
+	if _, err := policyService.CreatePolicy(ctx, Policy{Name: "cap", Rules: PolicyRules{MaxValue: "500"}}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	propose := func(tx SafeTransaction) *SafeProposal {
+		t.Helper()
+		proposal, err := svc.ProposeSafeTransaction(ctx, SafeProposalRequest{
+			Network:     "eth-sepolia",
+			Safe:        "0x9999999999999999999999999999999999999999",
+			Transaction: tx,
+		})
+		if err != nil {
+			t.Fatalf("ProposeSafeTransaction returned error: %v", err)
+		}
+		return proposal
+	}
+
+	// The Safe's funds are what the owner's signature releases, so the SafeTx
+	// value counts against the owner's limits.
+	var violation *PolicyViolation
+	large := propose(SafeTransaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "1000"})
+	if _, err := svc.SignSafeProposal(ctx, large.ID, owner.ID); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected the SafeTx value to be capped, got %v", err)
+	}
+
+	delegate := propose(SafeTransaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Operation: SafeOperationDelegateCall})
+	if _, err := svc.SignSafeProposal(ctx, delegate.ID, owner.ID); !errors.Is(err, ErrPolicyViolation) {
+		t.Fatalf("expected a delegatecall without an allowing policy to be refused, got %v", err)
+	}
+	if _, err := policyService.CreatePolicy(ctx, Policy{Name: "modules", Rules: PolicyRules{AllowDelegateCall: true}}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	proposal, err := svc.SignSafeProposal(ctx, delegate.ID, owner.ID)
+	if err != nil || proposal.Status != SafeProposalStatusReady {
+		t.Fatalf("expected an allowed delegatecall to be signed, got %+v (%v)", proposal, err)
+	}
+}
+
+func TestSafeTypedDataDomainDependsOnVersion(t *testing.T) {
+	tx, err := normalizeSafeTransaction(SafeTransaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "1"})
+	if err != nil {
+		t.Fatalf("normalizeSafeTransaction returned error: %v", err)
+	}
+	legacy, err := SafeTypedData("1.1.1", 1, "0x9999999999999999999999999999999999999999", tx)
+	if err != nil || legacy.Domain.ChainID != 0 || len(legacy.Types["EIP712Domain"]) != 1 {
+		t.Fatalf("expected a domain without chain ID before 1.3.0, got %+v (%v)", legacy, err)
+	}
+	if _, err := SafeTypedData("0.1.0", 1, "0x9999999999999999999999999999999999999999", tx); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected pre-1.0 Safes to be rejected, got %v", err)
+	}
+	if _, err := normalizeSafeTransaction(SafeTransaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Operation: 2}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected an unknown operation to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// SignatureMethod names the check that accepted a signature.
+type SignatureMethod string
+
+const (
+	// SignatureMethodECRecover recovered the signer of an EOA signature.
+	SignatureMethodECRecover SignatureMethod = "ecrecover"
+	// SignatureMethodERC1271 asked a deployed contract through
+	// isValidSignature(bytes32,bytes).
+	SignatureMethodERC1271 SignatureMethod = "erc1271"
+	// SignatureMethodERC6492 deployed a counterfactual account in a
+	// simulation and then asked it through isValidSignature.
+	SignatureMethodERC6492 SignatureMethod = "erc6492"
+)
+
+// SignatureVerifyRequest checks that Address signed exactly one of Message
+// (an EIP-191 personal_sign message), Hash (a raw 32-byte digest) or
+// TypedData (an EIP-712 document) on Network.
+type SignatureVerifyRequest struct {
+	Network   string     `json:"network"`
+	Address   string     `json:"address"`
+	Message   string     `json:"message,omitempty"`
+	Hash      string     `json:"hash,omitempty"`
+	TypedData *TypedData `json:"typedData,omitempty"`
+	Signature string     `json:"signature"`
+}
+
+// SignatureVerification reports whether a signature is valid. Method is set
+// when it is; Reason explains why it is not.
+type SignatureVerification struct {
+	Valid   bool            `json:"valid"`
+	Method  SignatureMethod `json:"method,omitempty"`
+	Address string          `json:"address"`
+	Reason  string          `json:"reason,omitempty"`
+}
+
+// SignatureVerifier checks a signature against the chain at rpcURL. A
+// signature that does not verify is a result, not an error; errors are
+// reserved for malformed input and RPC failures.
+type SignatureVerifier interface {
+	VerifySignature(ctx context.Context, rpcURL string, req SignatureVerifyRequest) (*SignatureVerification, error)
+}
+
+type SignatureService interface {
+	VerifySignature(ctx context.Context, req SignatureVerifyRequest) (*SignatureVerification, error)
+}
+
+type signatureService struct {
+	verifier SignatureVerifier
+	registry NetworkRegistry
+}
+
+func NewSignatureService(verifier SignatureVerifier, registry NetworkRegistry) SignatureService {
+	return &signatureService{verifier: verifier, registry: registry}
+}
+
+func (s *signatureService) VerifySignature(ctx context.Context, req SignatureVerifyRequest) (*SignatureVerification, error) {
+	req.Network = strings.TrimSpace(req.Network)
+	req.Address = strings.TrimSpace(req.Address)
+	if req.Network == "" {
+		return nil, fmt.Errorf("%w: network is required", ErrValidation)
+	}
+	if !addressPattern.MatchString(req.Address) {
+		return nil, fmt.Errorf("%w: invalid address", ErrValidation)
+	}
+	if strings.TrimSpace(req.Signature) == "" {
+		return nil, fmt.Errorf("%w: signature is required", ErrValidation)
+	}
+	payloads := 0
+	for _, set := range []bool{req.Message != "", req.Hash != "", req.TypedData != nil} {
+		if set {
+			payloads++
+		}
+	}
+	if payloads != 1 {
+		return nil, fmt.Errorf("%w: exactly one of message, hash or typedData is required", ErrValidation)
+	}
+
+	network, err := s.registry.Lookup(req.Network)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	return s.verifier.VerifySignature(ctx, network.RPCURL, req)
+}
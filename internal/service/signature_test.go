@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubSignatureVerifier struct {
+	rpcURL string
+}
+
+func (v *stubSignatureVerifier) VerifySignature(_ context.Context, rpcURL string, req SignatureVerifyRequest) (*SignatureVerification, error) {
+	v.rpcURL = rpcURL
+	return &SignatureVerification{Valid: true, Method: SignatureMethodERC1271, Address: req.Address}, nil
+}
+
+func TestVerifySignatureRequiresOnePayload(t *testing.T) {
+	verifier := &stubSignatureVerifier{}
+	svc := NewSignatureService(verifier, stubRegistry{})
+	ctx := context.Background()
+	base := SignatureVerifyRequest{Network: "eth-sepolia", Address: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Signature: "0x01"}
+
+	for _, req := range []SignatureVerifyRequest{
+		base,
+		{Network: base.Network, Address: base.Address, Signature: base.Signature, Message: "hi", Hash: "0x01"},
+		{Network: base.Network, Address: "0x1234", Signature: base.Signature, Message: "hi"},
+	} {
+		if _, err := svc.VerifySignature(ctx, req); !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected a validation error for %+v, got %v", req, err)
+		}
+	}
+
+	base.Message = "hi"
+	result, err := svc.VerifySignature(ctx, base)
+	if err != nil || !result.Valid || verifier.rpcURL != "http://eth-sepolia" {
+		t.Fatalf("unexpected result %+v (%v), rpc %q", result, err, verifier.rpcURL)
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrSimulationReverted is returned when a transaction reverts in simulation
+// and the caller did not force signing. It is always wrapped in a
+// *SimulationRevertedError.
+var ErrSimulationReverted = errors.New("simulation reverted")
+
+// SimulationResult is the outcome of executing a transaction against the
+// latest block without submitting it.
+type SimulationResult struct {
+	Success bool `json:"success"`
+	// RevertReason is decoded from Error(string) or Panic(uint256) revert
+	// data, and holds the node's message when the data is not standard.
+	RevertReason string `json:"revertReason,omitempty"`
+	RevertData   string `json:"revertData,omitempty"`
+	ReturnData   string `json:"returnData,omitempty"`
+	GasEstimate  uint64 `json:"gasEstimate,omitempty"`
+	// Trace is the callTracer output of debug_traceCall, when the node
+	// supports it.
+	Trace json.RawMessage `json:"trace,omitempty"`
+}
+
+// SimulationRevertedError carries the simulation that refused a signature.
+type SimulationRevertedError struct {
+	Result *SimulationResult
+}
+
+func (e *SimulationRevertedError) Error() string {
+	if e.Result.RevertReason == "" {
+		return ErrSimulationReverted.Error()
+	}
+	return fmt.Sprintf("%s: %s", ErrSimulationReverted, e.Result.RevertReason)
+}
+
+func (e *SimulationRevertedError) Unwrap() error {
+	return ErrSimulationReverted
+}
+
+// Simulator executes a transaction from the given address on a network's RPC
+// endpoint. A revert is reported in the result, not as an error.
+type Simulator interface {
+	Simulate(ctx context.Context, rpcURL string, from string, tx *Transaction) (*SimulationResult, error)
+}
+
+// WithSimulator enables SimulateTransaction against the network's RPC
+// endpoint.
+func WithSimulator(simulator Simulator, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.simulator = simulator
+		s.registry = registry
+	}
+}
+
+// WithSimulateBeforeSigning simulates every transaction before it is signed
+// and refuses those that revert, unless signing is forced. It needs
+// WithSimulator.
+func WithSimulateBeforeSigning(enabled bool) WalletServiceOption {
+	return func(s *walletService) {
+		s.simulateBeforeSigning = enabled
+	}
+}
+
+// SignOption adjusts a single SignTransaction call.
+type SignOption func(*signOptions)
+
+type signOptions struct {
+	force bool
+}
+
+// ForceSign signs the transaction even when simulation shows it reverting.
+func ForceSign() SignOption {
+	return func(o *signOptions) {
+		o.force = true
+	}
+}
+
+func (s *walletService) SimulateTransaction(ctx context.Context, walletID string, tx *Transaction) (*SimulationResult, error) {
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEthereum(record); err != nil {
+		return nil, err
+	}
+	if err := ValidateTransaction(tx); err != nil {
+		return nil, err
+	}
+	return s.simulate(ctx, record, tx)
+}
+
+func (s *walletService) simulate(ctx context.Context, record *WalletRecord, tx *Transaction) (*SimulationResult, error) {
+	if s.simulator == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: simulation is not configured", ErrNotImplemented)
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	result, err := s.simulator.Simulate(ctx, network.RPCURL, record.Address, tx)
+	if err != nil {
+		return nil, fmt.Errorf("simulate transaction: %w", err)
+	}
+	return result, nil
+}
+
+// checkSimulation runs the pre-signing simulation when one is configured.
+func (s *walletService) checkSimulation(ctx context.Context, record *WalletRecord, tx *Transaction, opts signOptions) error {
+	if !s.simulateBeforeSigning || opts.force {
+		return nil
+	}
+	result, err := s.simulate(ctx, record, tx)
+	if err != nil {
+		return err
+	}
+	if !result.Success {
+		return &SimulationRevertedError{Result: result}
+	}
+	return nil
+}
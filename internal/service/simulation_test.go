@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type stubRegistry struct{}
+
+func (stubRegistry) Lookup(network string) (*Network, error) {
+	return &Network{Name: network, RPCURL: "http://" + network}, nil
+}
+
+type stubSimulator struct {
+	result *SimulationResult
+	calls  int
+	rpcURL string
+}
+
+func (s *stubSimulator) Simulate(_ context.Context, rpcURL string, _ string, _ *Transaction) (*SimulationResult, error) {
+	s.calls++
+	s.rpcURL = rpcURL
+	return s.result, nil
+}
+
+func TestSimulationRefusesRevertingTransactions(t *testing.T) {
+	simulator := &stubSimulator{result: &SimulationResult{RevertReason: "insufficient balance"}}
+	signer := &stubSigner{}
+	svc := NewWalletService(newStubRepo(), signer,
+		WithSimulator(simulator, stubRegistry{}),
+		WithSimulateBeforeSigning(true),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	tx := policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x1", "")
+
+	_, err = svc.SignTransaction(ctx, wallet.ID, tx)
+	var reverted *SimulationRevertedError
+	if !errors.As(err, &reverted) || !errors.Is(err, ErrSimulationReverted) {
+		t.Fatalf("expected a simulation revert, got %v", err)
+	}
+	if reverted.Result.RevertReason != "insufficient balance" || signer.lastTx != nil {
+		t.Fatalf("expected the transaction to stay unsigned, got %+v", reverted.Result)
+	}
+	if simulator.rpcURL != "http://eth-sepolia" {
+		t.Fatalf("expected the wallet's network RPC, got %q", simulator.rpcURL)
+	}
+
+	if _, err := svc.SignTransaction(ctx, wallet.ID, tx, ForceSign()); err != nil {
+		t.Fatalf("expected forced signing to succeed, got %v", err)
+	}
+	if simulator.calls != 1 {
+		t.Fatalf("expected forced signing to skip simulation, got %d calls", simulator.calls)
+	}
+
+	simulator.result = &SimulationResult{Success: true, GasEstimate: 21000}
+	if _, err := svc.SignTransaction(ctx, wallet.ID, tx); err != nil {
+		t.Fatalf("expected successful simulation to sign, got %v", err)
+	}
+	result, err := svc.SimulateTransaction(ctx, wallet.ID, tx)
+	if err != nil || result.GasEstimate != 21000 {
+		t.Fatalf("unexpected simulation %+v (%v)", result, err)
+	}
+}
+
+func TestSimulationIsOptional(t *testing.T) {
+	simulator := &stubSimulator{result: &SimulationResult{}}
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithSimulator(simulator, stubRegistry{}))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	tx := policyTx("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", "0x1", "")
+	if _, err := svc.SignTransaction(ctx, wallet.ID, tx); err != nil || simulator.calls != 0 {
+		t.Fatalf("expected signing without simulation, got %v after %d calls", err, simulator.calls)
+	}
+
+	unconfigured := NewWalletService(newStubRepo(), &stubSigner{})
+	other, err := unconfigured.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := unconfigured.SimulateTransaction(ctx, other.ID, tx); !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("expected ErrNotImplemented without a simulator, got %v", err)
+	}
+}
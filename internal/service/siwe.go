@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	// DefaultSIWENonceTTL is how long an issued nonce can be used to sign in.
+	DefaultSIWENonceTTL = 10 * time.Minute
+
+	siweVersion   = "1"
+	siwePreamble  = " wants you to sign in with your Ethereum account:"
+	siweURIPrefix = "URI: "
+)
+
+var siweNoncePattern = regexp.MustCompile(`^[A-Za-z0-9]{8,}$`)
+
+// SIWEMessage is an EIP-4361 Sign-In With Ethereum message.
+type SIWEMessage struct {
+	Domain         string     `json:"domain"`
+	Address        string     `json:"address"`
+	Statement      string     `json:"statement,omitempty"`
+	URI            string     `json:"uri"`
+	Version        string     `json:"version"`
+	ChainID        int64      `json:"chainId"`
+	Nonce          string     `json:"nonce"`
+	IssuedAt       time.Time  `json:"issuedAt"`
+	ExpirationTime *time.Time `json:"expirationTime,omitempty"`
+	NotBefore      *time.Time `json:"notBefore,omitempty"`
+	RequestID      string     `json:"requestId,omitempty"`
+	Resources      []string   `json:"resources,omitempty"`
+}
+
+// String renders the message in the EIP-4361 text format that is signed.
+func (m *SIWEMessage) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + siwePreamble + "\n")
+	b.WriteString(m.Address + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(siweURIPrefix + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatInt(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + m.IssuedAt.UTC().Format(time.RFC3339))
+	if m.ExpirationTime != nil {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if m.NotBefore != nil {
+		b.WriteString("\nNot Before: " + m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+	return b.String()
+}
+
+func (m *SIWEMessage) validate() error {
+	switch {
+	case m.Domain == "" || strings.ContainsAny(m.Domain, " \n/"):
+		return fmt.Errorf("%w: invalid domain", ErrValidation)
+	case !addressPattern.MatchString(m.Address) || checksumAddress(m.Address) != m.Address:
+		return fmt.Errorf("%w: address must be an EIP-55 checksummed address", ErrValidation)
+	case strings.Contains(m.Statement, "\n"):
+		return fmt.Errorf("%w: statement must be a single line", ErrValidation)
+	case m.Version != siweVersion:
+		return fmt.Errorf("%w: unsupported version %q", ErrValidation, m.Version)
+	case m.ChainID <= 0:
+		return fmt.Errorf("%w: chain ID is required", ErrValidation)
+	case !siweNoncePattern.MatchString(m.Nonce):
+		return fmt.Errorf("%w: nonce must be at least 8 alphanumeric characters", ErrValidation)
+	case m.IssuedAt.IsZero():
+		return fmt.Errorf("%w: issued at is required", ErrValidation)
+	}
+	if !validURI(m.URI) {
+		return fmt.Errorf("%w: invalid URI", ErrValidation)
+	}
+	for _, resource := range m.Resources {
+		if !validURI(resource) {
+			return fmt.Errorf("%w: invalid resource %q", ErrValidation, resource)
+		}
+	}
+	return nil
+}
+
+// ParseSIWEMessage parses and validates an EIP-4361 message.
+func ParseSIWEMessage(text string) (*SIWEMessage, error) {
+	lines := strings.Split(text, "\n")
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: malformed SIWE message: %s", ErrValidation, reason)
+	}
+	if len(lines) < 8 || !strings.HasSuffix(lines[0], siwePreamble) {
+		return nil, invalid("missing preamble")
+	}
+	msg := &SIWEMessage{
+		Domain:  strings.TrimSuffix(lines[0], siwePreamble),
+		Address: lines[1],
+	}
+	if lines[2] != "" {
+		return nil, invalid("expected a blank line after the address")
+	}
+
+	// The statement is optional, but the blank line that follows it is not.
+	i := 3
+	if lines[i] != "" && !strings.HasPrefix(lines[i], siweURIPrefix) {
+		msg.Statement = lines[i]
+		i++
+	}
+	if i >= len(lines) || lines[i] != "" {
+		return nil, invalid("expected a blank line before the URI")
+	}
+	i++
+
+	field := func(name string, required bool) (string, error) {
+		prefix := name + ": "
+		if i < len(lines) && strings.HasPrefix(lines[i], prefix) {
+			value := strings.TrimPrefix(lines[i], prefix)
+			i++
+			return value, nil
+		}
+		if required {
+			return "", invalid("missing " + name)
+		}
+		return "", nil
+	}
+	timestamp := func(name string, value string) (*time.Time, error) {
+		if value == "" {
+			return nil, nil
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, invalid("invalid " + name)
+		}
+		return &parsed, nil
+	}
+
+	var err error
+	if msg.URI, err = field("URI", true); err != nil {
+		return nil, err
+	}
+	if msg.Version, err = field("Version", true); err != nil {
+		return nil, err
+	}
+	chainID, err := field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if msg.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil {
+		return nil, invalid("invalid Chain ID")
+	}
+	if msg.Nonce, err = field("Nonce", true); err != nil {
+		return nil, err
+	}
+	issuedAt, err := field("Issued At", true)
+	if err != nil {
+		return nil, err
+	}
+	issued, err := timestamp("Issued At", issuedAt)
+	if err != nil {
+		return nil, err
+	}
+	msg.IssuedAt = *issued
+	for _, optional := range []struct {
+		name string
+		dest **time.Time
+	}{{"Expiration Time", &msg.ExpirationTime}, {"Not Before", &msg.NotBefore}} {
+		value, _ := field(optional.name, false)
+		if *optional.dest, err = timestamp(optional.name, value); err != nil {
+			return nil, err
+		}
+	}
+	msg.RequestID, _ = field("Request ID", false)
+	if i < len(lines) && lines[i] == "Resources:" {
+		for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+			msg.Resources = append(msg.Resources, strings.TrimPrefix(lines[i], "- "))
+		}
+	}
+	if i != len(lines) {
+		return nil, invalid(fmt.Sprintf("unexpected line %q", lines[i]))
+	}
+
+	if err := msg.validate(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// SIWENonce is a server-issued sign-in nonce. Each can be consumed once.
+type SIWENonce struct {
+	Nonce     string    `json:"nonce"`
+	TenantID  string    `json:"-"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// NonceStore keeps issued SIWE nonces. Consume removes the nonce and returns
+// ErrNotFound when it was never issued to the tenant, was already used, or
+// has expired.
+type NonceStore interface {
+	Create(ctx context.Context, nonce SIWENonce) error
+	Consume(ctx context.Context, tenantID string, nonce string, now time.Time) error
+}
+
+// SignatureRecoverer returns the address that produced a personal_sign
+// signature over message.
+type SignatureRecoverer interface {
+	RecoverSigner(message []byte, signature string) (string, error)
+}
+
+// SIWESignRequest describes the message to build. Nonce is usually issued by
+// the relying party the wallet signs in to, so it is not checked against the
+// NonceStore; only Verify consumes nonces. A fresh one is issued when it is
+// empty. ChainID defaults to the wallet's network and ExpiresIn to no expiry.
+type SIWESignRequest struct {
+	Domain    string     `json:"domain"`
+	URI       string     `json:"uri"`
+	Statement string     `json:"statement,omitempty"`
+	ChainID   int64      `json:"chainId,omitempty"`
+	Nonce     string     `json:"nonce,omitempty"`
+	ExpiresIn Duration   `json:"expiresIn,omitempty"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	RequestID string     `json:"requestId,omitempty"`
+	Resources []string   `json:"resources,omitempty"`
+}
+
+type SIWESignature struct {
+	Message   string       `json:"message"`
+	Signature string       `json:"signature"`
+	Fields    *SIWEMessage `json:"fields"`
+}
+
+// SIWEVerifyRequest checks Signature over Message. Domain, when set, must
+// match the message's domain, as a relying party would require.
+type SIWEVerifyRequest struct {
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	Domain    string `json:"domain,omitempty"`
+}
+
+// SIWEVerification reports whether a sign-in is valid. A valid verification
+// consumes the nonce, so the same message cannot be replayed.
+type SIWEVerification struct {
+	Valid   bool         `json:"valid"`
+	Address string       `json:"address,omitempty"`
+	Reason  string       `json:"reason,omitempty"`
+	Fields  *SIWEMessage `json:"fields,omitempty"`
+}
+
+type SIWEService interface {
+	IssueNonce(ctx context.Context) (*SIWENonce, error)
+	SignIn(ctx context.Context, walletID string, req SIWESignRequest) (*SIWESignature, error)
+	Verify(ctx context.Context, req SIWEVerifyRequest) (*SIWEVerification, error)
+}
+
+type siweService struct {
+	wallets   WalletService
+	nonces    NonceStore
+	recoverer SignatureRecoverer
+	registry  NetworkRegistry
+	nonceTTL  time.Duration
+	now       func() time.Time
+}
+
+// NewSIWEService signs through wallets, so sign-ins are audited like any
+// other message signature.
+func NewSIWEService(wallets WalletService, nonces NonceStore, recoverer SignatureRecoverer, registry NetworkRegistry) SIWEService {
+	return &siweService{
+		wallets:   wallets,
+		nonces:    nonces,
+		recoverer: recoverer,
+		registry:  registry,
+		nonceTTL:  DefaultSIWENonceTTL,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+func (s *siweService) IssueNonce(ctx context.Context) (*SIWENonce, error) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	nonce := SIWENonce{
+		Nonce:     hex.EncodeToString(raw),
+		TenantID:  TenantFromContext(ctx),
+		ExpiresAt: s.now().Add(s.nonceTTL),
+	}
+	if err := s.nonces.Create(ctx, nonce); err != nil {
+		return nil, fmt.Errorf("store nonce: %w", err)
+	}
+	return &nonce, nil
+}
+
+func (s *siweService) SignIn(ctx context.Context, walletID string, req SIWESignRequest) (*SIWESignature, error) {
+	wallet, err := s.wallets.GetWallet(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if wallet.Family != "" && wallet.Family != ChainFamilyEthereum {
+		return nil, fmt.Errorf("%w: Sign-In With Ethereum needs an ethereum wallet", ErrValidation)
+	}
+	if req.ChainID == 0 {
+		network, err := s.registry.Lookup(wallet.Network)
+		if err != nil {
+			return nil, fmt.Errorf("lookup network: %w", err)
+		}
+		req.ChainID = network.ChainID
+	}
+	if req.Nonce == "" {
+		issued, err := s.IssueNonce(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Nonce = issued.Nonce
+	}
+
+	now := s.now().Truncate(time.Second)
+	msg := &SIWEMessage{
+		Domain:    strings.TrimSpace(req.Domain),
+		Address:   checksumAddress(wallet.Address),
+		Statement: req.Statement,
+		URI:       strings.TrimSpace(req.URI),
+		Version:   siweVersion,
+		ChainID:   req.ChainID,
+		Nonce:     req.Nonce,
+		IssuedAt:  now,
+		NotBefore: req.NotBefore,
+		RequestID: req.RequestID,
+		Resources: req.Resources,
+	}
+	if req.ExpiresIn < 0 {
+		return nil, fmt.Errorf("%w: expiresIn must not be negative", ErrValidation)
+	}
+	if req.ExpiresIn > 0 {
+		expires := now.Add(time.Duration(req.ExpiresIn))
+		msg.ExpirationTime = &expires
+	}
+	if err := msg.validate(); err != nil {
+		return nil, err
+	}
+
+	text := msg.String()
+	signature, err := s.wallets.SignMessage(ctx, walletID, []byte(text))
+	if err != nil {
+		return nil, err
+	}
+	return &SIWESignature{Message: text, Signature: signature.Signature, Fields: msg}, nil
+}
+
+func (s *siweService) Verify(ctx context.Context, req SIWEVerifyRequest) (*SIWEVerification, error) {
+	msg, err := ParseSIWEMessage(req.Message)
+	if err != nil {
+		return nil, err
+	}
+	result := &SIWEVerification{Address: msg.Address, Fields: msg}
+	invalid := func(reason string) (*SIWEVerification, error) {
+		result.Reason = reason
+		return result, nil
+	}
+
+	now := s.now()
+	if req.Domain != "" && req.Domain != msg.Domain {
+		return invalid(fmt.Sprintf("domain %q does not match %q", msg.Domain, req.Domain))
+	}
+	if msg.ExpirationTime != nil && !now.Before(*msg.ExpirationTime) {
+		return invalid("message has expired")
+	}
+	if msg.NotBefore != nil && now.Before(*msg.NotBefore) {
+		return invalid("message is not valid yet")
+	}
+
+	signer, err := s.recoverer.RecoverSigner([]byte(req.Message), req.Signature)
+	if err != nil {
+		return invalid("invalid signature: " + err.Error())
+	}
+	if !strings.EqualFold(signer, msg.Address) {
+		return invalid("signature was not made by " + msg.Address)
+	}
+
+	if err := s.nonces.Consume(ctx, TenantFromContext(ctx), msg.Nonce, now); err != nil {
+		return invalid("nonce was not issued, has expired or was already used")
+	}
+	result.Valid = true
+	return result, nil
+}
+
+func validURI(raw string) bool {
+	parsed, err := url.Parse(raw)
+	return err == nil && parsed.Scheme != ""
+}
+
+// checksumAddress returns the EIP-55 mixed-case form of a hex address.
+func checksumAddress(address string) string {
+	lower := strings.ToLower(strings.TrimPrefix(address, "0x"))
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	out := []byte(lower)
+	for i, c := range out {
+		if c >= 'a' && c <= 'f' && digest[i] >= '8' {
+			out[i] = c - 32
+		}
+	}
+	return "0x" + string(out)
+}
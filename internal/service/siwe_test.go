@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type stubNonceStore struct {
+	nonces map[string]SIWENonce
+}
+
+func (s *stubNonceStore) Create(_ context.Context, nonce SIWENonce) error {
+	if s.nonces == nil {
+		s.nonces = make(map[string]SIWENonce)
+	}
+	s.nonces[nonce.Nonce] = nonce
+	return nil
+}
+
+func (s *stubNonceStore) Consume(_ context.Context, tenantID string, nonce string, now time.Time) error {
+	stored, ok := s.nonces[nonce]
+	if !ok || stored.TenantID != tenantID || !now.Before(stored.ExpiresAt) {
+		return ErrNotFound
+	}
+	delete(s.nonces, nonce)
+	return nil
+}
+
+type stubRecoverer struct {
+	address string
+}
+
+func (r stubRecoverer) RecoverSigner(_ []byte, signature string) (string, error) {
+	if signature != "signature" {
+		return "", errors.New("bad signature")
+	}
+	return r.address, nil
+}
+
+func TestSIWEMessageRoundTrip(t *testing.T) {
+	expires := time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)
+	msg := &SIWEMessage{
+		Domain:         "example.com",
+		Address:        "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		Statement:      "Sign in to Example.",
+		URI:            "https://example.com/login",
+		Version:        "1",
+		ChainID:        1,
+		Nonce:          "32891756",
+		IssuedAt:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpirationTime: &expires,
+		Resources:      []string{"ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/"},
+	}
+	want := "example.com wants you to sign in with your Ethereum account:\n" +
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed\n\n" +
+		"Sign in to Example.\n\n" +
+		"URI: https://example.com/login\n" +
+		"Version: 1\n" +
+		"Chain ID: 1\n" +
+		"Nonce: 32891756\n" +
+		"Issued At: 2024-01-01T00:00:00Z\n" +
+		"Expiration Time: 2024-01-01T01:00:00Z\n" +
+		"Resources:\n" +
+		"- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/"
+	if got := msg.String(); got != want {
+		t.Fatalf("unexpected message:\n%s", got)
+	}
+
+	parsed, err := ParseSIWEMessage(want)
+	if err != nil {
+		t.Fatalf("ParseSIWEMessage returned error: %v", err)
+	}
+	if parsed.String() != want || !parsed.ExpirationTime.Equal(expires) || len(parsed.Resources) != 1 {
+		t.Fatalf("unexpected parsed message %+v", parsed)
+	}
+
+	msg.Statement = ""
+	if _, err := ParseSIWEMessage(msg.String()); err != nil {
+		t.Fatalf("message without a statement should parse: %v", err)
+	}
+}
+
+func TestParseSIWEMessageRejectsUnchecksummedAddress(t *testing.T) {
+	msg := &SIWEMessage{
+		Domain:   "example.com",
+		Address:  "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed",
+		URI:      "https://example.com",
+		Version:  "1",
+		ChainID:  1,
+		Nonce:    "32891756",
+		IssuedAt: time.Now(),
+	}
+	if _, err := ParseSIWEMessage(msg.String()); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+func TestSIWESignInAndVerifyRejectsReplay(t *testing.T) {
+	ctx := context.Background()
+	wallets := NewWalletService(newStubRepo(), &stubSigner{})
+	wallet, err := wallets.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	svc := NewSIWEService(wallets, &stubNonceStore{}, stubRecoverer{address: wallet.Address}, stubTokenRegistry{})
+
+	signed, err := svc.SignIn(ctx, wallet.ID, SIWESignRequest{Domain: "example.com", URI: "https://example.com", ExpiresIn: Duration(time.Minute)})
+	if err != nil {
+		t.Fatalf("SignIn returned error: %v", err)
+	}
+	if signed.Fields.ChainID != 11155111 || signed.Fields.Nonce == "" || signed.Fields.ExpirationTime == nil {
+		t.Fatalf("unexpected message fields %+v", signed.Fields)
+	}
+
+	mismatch, err := svc.Verify(ctx, SIWEVerifyRequest{Message: signed.Message, Signature: signed.Signature, Domain: "evil.com"})
+	if err != nil || mismatch.Valid {
+		t.Fatalf("expected a domain mismatch, got %+v (%v)", mismatch, err)
+	}
+
+	verified, err := svc.Verify(ctx, SIWEVerifyRequest{Message: signed.Message, Signature: signed.Signature, Domain: "example.com"})
+	if err != nil || !verified.Valid || verified.Address != wallet.Address {
+		t.Fatalf("expected a valid sign-in, got %+v (%v)", verified, err)
+	}
+
+	replayed, err := svc.Verify(ctx, SIWEVerifyRequest{Message: signed.Message, Signature: signed.Signature})
+	if err != nil || replayed.Valid {
+		t.Fatalf("expected the replay to be rejected, got %+v (%v)", replayed, err)
+	}
+}
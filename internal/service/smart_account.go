@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+)
+
+// AccountType distinguishes wallets that sign as an EOA from smart account
+// wallets whose key owns an ERC-4337 account.
+type AccountType string
+
+const (
+	AccountTypeEOA   AccountType = "eoa"
+	AccountTypeSmart AccountType = "smart"
+)
+
+// AccountFactory is a CREATE2 smart account factory. Accounts are deployed
+// with createAccount(address owner, uint256 salt), using
+// keccak256(abi.encode(owner, salt)) as the CREATE2 salt and the same proxy
+// creation code, whose hash is InitCodeHash, for every owner.
+type AccountFactory struct {
+	Name         string
+	Address      string
+	InitCodeHash string
+	EntryPoint   EntryPointVersion
+}
+
+// SmartAccount describes the counterfactual account behind a smart account
+// wallet. The address can receive funds before Deployed is set; the account
+// is deployed by the first user operation.
+type SmartAccount struct {
+	Owner      string            `json:"owner"`
+	Factory    string            `json:"factory"`
+	Salt       string            `json:"salt"`
+	EntryPoint EntryPointVersion `json:"entryPoint"`
+	Deployed   bool              `json:"deployed"`
+}
+
+// SmartAccountBuilder derives counterfactual addresses and deployment
+// calldata for an AccountFactory, and checks whether an account has code.
+// FactoryAddress asks the deployed factory for the address it would create,
+// so a misconfigured init code hash is caught before funds are sent.
+type SmartAccountBuilder interface {
+	AccountAddress(factory AccountFactory, owner string, salt *big.Int) (string, error)
+	FactoryAddress(ctx context.Context, rpcURL string, factory AccountFactory, owner string, salt *big.Int) (string, error)
+	FactoryData(factory AccountFactory, owner string, salt *big.Int) (string, error)
+	AccountDeployed(ctx context.Context, rpcURL string, address string) (bool, error)
+}
+
+// WithSmartAccounts enables smart account wallets. Factories are read from
+// the wallet's network in registry.
+func WithSmartAccounts(builder SmartAccountBuilder, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.accounts = builder
+		s.registry = registry
+	}
+}
+
+// errSmartAccountTransaction rejects signing paths that would produce a
+// signature from the owner key rather than the account.
+var errSmartAccountTransaction = fmt.Errorf("%w: smart account wallets transact through user operations", ErrValidation)
+
+type CreateWalletOption func(*createWalletOptions)
+
+type createWalletOptions struct {
+	accountType AccountType
+	factory     string
+	salt        string
+	addressType AddressType
+}
+
+// WithAccountType selects an EOA (the default) or a smart account wallet.
+func WithAccountType(accountType AccountType) CreateWalletOption {
+	return func(o *createWalletOptions) {
+		o.accountType = accountType
+	}
+}
+
+// WithAccountFactory names the network factory a smart account wallet is
+// derived from. It defaults to the network's only factory.
+func WithAccountFactory(name string) CreateWalletOption {
+	return func(o *createWalletOptions) {
+		o.factory = strings.TrimSpace(name)
+	}
+}
+
+// WithAccountSalt sets the CREATE2 salt of a smart account wallet, as a
+// decimal or 0x-prefixed hex uint256. It defaults to zero.
+func WithAccountSalt(salt string) CreateWalletOption {
+	return func(o *createWalletOptions) {
+		o.salt = strings.TrimSpace(salt)
+	}
+}
+
+// deriveSmartAccount turns a freshly generated EOA record into a smart
+// account wallet owned by that EOA. The derived address must match the one
+// the factory reports, otherwise funds sent to it could never be recovered.
+func (s *walletService) deriveSmartAccount(ctx context.Context, record *WalletRecord, options createWalletOptions) error {
+	if s.accounts == nil || s.registry == nil {
+		return fmt.Errorf("%w: smart accounts are not configured", ErrNotImplemented)
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return fmt.Errorf("lookup network: %w", err)
+	}
+	factory, err := selectFactory(network, options.factory)
+	if err != nil {
+		return err
+	}
+	salt := new(big.Int)
+	if options.salt != "" {
+		parsed, err := parseAmount(options.salt)
+		if err != nil || parsed.BitLen() > 256 {
+			return fmt.Errorf("%w: salt must be a uint256", ErrValidation)
+		}
+		salt = parsed
+	}
+
+	address, err := s.accounts.AccountAddress(factory, record.Address, salt)
+	if err != nil {
+		return fmt.Errorf("derive account address: %w", err)
+	}
+	reported, err := s.accounts.FactoryAddress(ctx, network.RPCURL, factory, record.Address, salt)
+	if err != nil {
+		return fmt.Errorf("query account factory: %w", err)
+	}
+	if !strings.EqualFold(reported, address) {
+		return fmt.Errorf("%w: factory %q creates %s, not the derived %s; check its init code hash", ErrValidation, factory.Name, reported, address)
+	}
+	record.AccountType = AccountTypeSmart
+	record.SmartAccount = &SmartAccount{
+		Owner:      record.Address,
+		Factory:    factory.Address,
+		Salt:       "0x" + salt.Text(16),
+		EntryPoint: factory.EntryPoint,
+	}
+	record.Address = address
+	return nil
+}
+
+func selectFactory(network *Network, name string) (AccountFactory, error) {
+	if name == "" {
+		if len(network.AccountFactories) != 1 {
+			return AccountFactory{}, fmt.Errorf("%w: %s has %d account factories; name one", ErrValidation, network.Name, len(network.AccountFactories))
+		}
+		for _, factory := range network.AccountFactories {
+			return factory, nil
+		}
+	}
+	factory, ok := network.AccountFactories[name]
+	if !ok {
+		return AccountFactory{}, fmt.Errorf("%w: unknown account factory %q", ErrValidation, name)
+	}
+	return factory, nil
+}
+
+// prepareSmartAccountOperation points op at the wallet's account and, while
+// the account has no code, adds the factory call that deploys it. The
+// deployment state is refreshed from the chain first, so an account deployed
+// by an earlier operation is not deployed twice. If the chain cannot be
+// read, the factory call is added anyway: a bundler rejects it for a
+// deployed account, which is safer than sending an undeployed one none.
+func (s *walletService) prepareSmartAccountOperation(ctx context.Context, record *WalletRecord, network *Network, req *UserOperationRequest) error {
+	account := record.SmartAccount
+	op := &req.UserOperation
+	if op.Sender == "" {
+		op.Sender = record.Address
+	}
+	if !strings.EqualFold(strings.TrimSpace(op.Sender), record.Address) {
+		return fmt.Errorf("%w: sender must be the wallet's smart account %s", ErrValidation, record.Address)
+	}
+	if req.Version == "" {
+		req.Version = account.EntryPoint
+	}
+	if account.Deployed || op.InitCode != "" || op.Factory != "" {
+		return nil
+	}
+	if s.accounts == nil {
+		return fmt.Errorf("%w: smart accounts are not configured", ErrNotImplemented)
+	}
+
+	deployed, err := s.refreshDeployment(ctx, record, network)
+	if err != nil {
+		log.Printf("wallet %s: %v", record.ID, err)
+	}
+	if deployed {
+		return nil
+	}
+
+	salt, err := parseHexQuantity(account.Salt)
+	if err != nil {
+		return fmt.Errorf("stored salt: %w", err)
+	}
+	factoryData, err := s.accounts.FactoryData(AccountFactory{Address: account.Factory, EntryPoint: account.EntryPoint}, account.Owner, salt)
+	if err != nil {
+		return fmt.Errorf("build factory data: %w", err)
+	}
+	if req.Version == EntryPointV06 {
+		op.InitCode = account.Factory + strings.TrimPrefix(factoryData, "0x")
+	} else {
+		op.Factory = account.Factory
+		op.FactoryData = factoryData
+	}
+	return nil
+}
+
+// refreshDeployment checks whether the wallet's account has been deployed
+// since it was last seen and, if so, stores that on the wallet.
+func (s *walletService) refreshDeployment(ctx context.Context, record *WalletRecord, network *Network) (bool, error) {
+	deployed, err := s.accounts.AccountDeployed(ctx, network.RPCURL, record.Address)
+	if err != nil {
+		return false, fmt.Errorf("check account deployment: %w", err)
+	}
+	if !deployed {
+		return false, nil
+	}
+
+	// Reload under the lifecycle lock so a concurrent status change is not
+	// overwritten by this copy of the record.
+	s.lifecycleMu.Lock()
+	defer s.lifecycleMu.Unlock()
+	current, err := s.repo.GetByID(ctx, record.TenantID, record.ID)
+	if err != nil {
+		return false, fmt.Errorf("get wallet: %w", err)
+	}
+	updated := *current.SmartAccount
+	updated.Deployed = true
+	current.SmartAccount = &updated
+	stored, err := s.repo.Update(ctx, *current)
+	if err != nil {
+		return false, fmt.Errorf("store wallet: %w", err)
+	}
+	*record = *stored
+	return true, nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+type stubAccountBuilder struct {
+	deployed bool
+	checks   int
+	// deployedErr fails deployment checks, as an RPC outage would.
+	deployedErr error
+	// factoryAddress overrides the address the factory reports.
+	factoryAddress string
+}
+
+func (b *stubAccountBuilder) AccountAddress(AccountFactory, string, *big.Int) (string, error) {
+	return "0x2222222222222222222222222222222222222222", nil
+}
+
+func (b *stubAccountBuilder) FactoryAddress(context.Context, string, AccountFactory, string, *big.Int) (string, error) {
+	if b.factoryAddress != "" {
+		return b.factoryAddress, nil
+	}
+	return "0x2222222222222222222222222222222222222222", nil
+}
+
+func (b *stubAccountBuilder) FactoryData(_ AccountFactory, _ string, _ *big.Int) (string, error) {
+	return "0x5fbfb9cf", nil
+}
+
+func (b *stubAccountBuilder) AccountDeployed(context.Context, string, string) (bool, error) {
+	b.checks++
+	return b.deployed, b.deployedErr
+}
+
+type stubFactoryRegistry struct{}
+
+func (stubFactoryRegistry) Lookup(network string) (*Network, error) {
+	return &Network{
+		Name:       network,
+		ChainID:    11155111,
+		BundlerURL: "http://bundler",
+		AccountFactories: map[string]AccountFactory{
+			"default": {Name: "default", Address: "0x9406Cc6185a346906296840746125a0E44976454", EntryPoint: EntryPointV06},
+		},
+	}, nil
+}
+
+func newSmartAccountService(builder *stubAccountBuilder, bundler *stubBundler) (WalletService, *stubRepo) {
+	repo := newStubRepo()
+	return NewWalletService(repo, &stubSigner{},
+		WithBundler(bundler, stubFactoryRegistry{}),
+		WithSmartAccounts(builder, stubFactoryRegistry{}),
+	), repo
+}
+
+func TestCreateWalletDerivesSmartAccount(t *testing.T) {
+	svc, _ := newSmartAccountService(&stubAccountBuilder{}, &stubBundler{})
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart), WithAccountSalt("7"))
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if wallet.AccountType != AccountTypeSmart || wallet.SmartAccount == nil {
+		t.Fatalf("expected a smart account wallet, got %+v", wallet)
+	}
+	account := wallet.SmartAccount
+	if account.Owner != "0x1111111111111111111111111111111111111111" || account.Salt != "0x7" || account.EntryPoint != EntryPointV06 || account.Deployed {
+		t.Fatalf("unexpected smart account %+v", account)
+	}
+	if wallet.Address != "0x2222222222222222222222222222222222222222" {
+		t.Fatalf("expected the wallet address to be the account, got %s", wallet.Address)
+	}
+
+	eoa, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil || eoa.AccountType != AccountTypeEOA || eoa.SmartAccount != nil {
+		t.Fatalf("expected an EOA by default, got %+v (%v)", eoa, err)
+	}
+
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart), WithAccountFactory("kernel")); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for an unknown factory, got %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType("multisig")); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for an unknown account type, got %v", err)
+	}
+
+	mismatched, _ := newSmartAccountService(&stubAccountBuilder{factoryAddress: "0x3333333333333333333333333333333333333333"}, &stubBundler{})
+	if _, err := mismatched.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart)); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error when the factory reports another address, got %v", err)
+	}
+}
+
+func TestDeploymentChecksStayOffTheReadPath(t *testing.T) {
+	builder := &stubAccountBuilder{deployedErr: errors.New("rpc unavailable")}
+	svc, _ := newSmartAccountService(builder, &stubBundler{})
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart))
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	stored, err := svc.GetWallet(ctx, wallet.ID)
+	if err != nil || stored.SmartAccount.Deployed || builder.checks != 0 {
+		t.Fatalf("expected GetWallet to read storage only, got %+v after %d checks (%v)", stored, builder.checks, err)
+	}
+
+	// An outage does not block signing; the operation deploys the account
+	// in case it has no code yet.
+	req := UserOperationRequest{UserOperation: UserOperation{Nonce: "0x0", CallData: "0x"}}
+	result, err := svc.SignUserOperation(ctx, wallet.ID, req)
+	if err != nil || result.UserOperation.InitCode == "" {
+		t.Fatalf("expected initCode when deployment cannot be checked, got %+v (%v)", result, err)
+	}
+}
+
+func TestSmartAccountDeploysWithFirstUserOperation(t *testing.T) {
+	builder := &stubAccountBuilder{}
+	bundler := &stubBundler{}
+	svc, _ := newSmartAccountService(builder, bundler)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart))
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	req := UserOperationRequest{UserOperation: UserOperation{Nonce: "0x0", CallData: "0x"}}
+	result, err := svc.SignUserOperation(ctx, wallet.ID, req)
+	if err != nil {
+		t.Fatalf("SignUserOperation returned error: %v", err)
+	}
+	op := result.UserOperation
+	if op.Sender != wallet.Address || op.InitCode != wallet.SmartAccount.Factory+"5fbfb9cf" || result.EntryPoint.Version != EntryPointV06 {
+		t.Fatalf("expected initCode for the undeployed account, got %+v", result)
+	}
+
+	builder.deployed = true
+	result, err = svc.SignUserOperation(ctx, wallet.ID, req)
+	if err != nil || result.UserOperation.InitCode != "" {
+		t.Fatalf("expected no initCode once deployed, got %+v (%v)", result, err)
+	}
+	stored, err := svc.GetWallet(ctx, wallet.ID)
+	if err != nil || !stored.SmartAccount.Deployed {
+		t.Fatalf("expected the wallet to be marked deployed, got %+v (%v)", stored, err)
+	}
+
+	checks := builder.checks
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, req); err != nil || builder.checks != checks {
+		t.Fatalf("expected no deployment check after deployment, got %d checks (%v)", builder.checks-checks, err)
+	}
+
+	other := req
+	other.UserOperation.Sender = "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, other); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected validation error for a foreign sender, got %v", err)
+	}
+	if _, err := svc.SignTransaction(ctx, wallet.ID, &Transaction{To: wallet.Address, Value: "0x0"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected smart accounts to reject plain transactions, got %v", err)
+	}
+}
+
+func TestSmartAccountWalletsAreBoundByPolicies(t *testing.T) {
+	repo := newStubRepo()
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger)
+	svc := NewWalletService(repo, &stubSigner{},
+		WithBundler(&stubBundler{}, stubFactoryRegistry{}),
+		WithSmartAccounts(&stubAccountBuilder{deployed: true}, stubFactoryRegistry{}),
+		WithPolicies(policies),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia", WithAccountType(AccountTypeSmart))
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{
+		Name:     "payees",
+		WalletID: wallet.ID,
+		Rules:    PolicyRules{AllowedDestinations: []string{"0xcccccccccccccccccccccccccccccccccccccccc"}, MaxValue: "100"},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	execute := func(to string, value int64) UserOperationRequest {
+		return UserOperationRequest{UserOperation: UserOperation{
+			Nonce:    "0x0",
+			CallData: executeBatchCalldata([]string{to}, []int64{value}, []string{"0x"}),
+		}}
+	}
+	var violation *PolicyViolation
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, execute("0xdddddddddddddddddddddddddddddddddddddddd", 1)); !errors.As(err, &violation) || violation.Rule != PolicyRuleAllowedDestinations {
+		t.Fatalf("expected the account's call to be checked, got %v", err)
+	}
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, execute("0xcccccccccccccccccccccccccccccccccccccccc", 101)); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected the account's value to be capped, got %v", err)
+	}
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, execute("0xcccccccccccccccccccccccccccccccccccccccc", 40)); err != nil {
+		t.Fatalf("SignUserOperation returned error: %v", err)
+	}
+	if len(ledger.entries) != 1 || ledger.entries[0].WalletID != wallet.ID || ledger.entries[0].Amount.Int64() != 40 {
+		t.Fatalf("expected the account's spend to be recorded, got %+v", ledger.entries)
+	}
+
+	if _, err := svc.SignTransaction(ctx, wallet.ID, policyTx("0xcccccccccccccccccccccccccccccccccccccccc", "0x1", "")); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected smart account wallets to keep transacting through user operations, got %v", err)
+	}
+
+	// A user operation hash signed as a message would authorise the
+	// operation without any of the checks above.
+	userOpHash := make([]byte, 32)
+	userOpHash[31] = 1
+	if _, err := svc.SignMessage(ctx, wallet.ID, userOpHash); !errors.As(err, &violation) {
+		t.Fatalf("expected a governed smart account to refuse message signing, got %v", err)
+	}
+}
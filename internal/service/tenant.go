@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ErrQuotaExceeded is returned when a tenant has used up a quota.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// DefaultTenantID owns wallets created without an authenticated principal and
+// the bootstrap admin key.
+const DefaultTenantID = "default"
+
+var tenantIDPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// Tenant isolates a group of wallets and API keys. An empty AllowedNetworks
+// permits every configured network and a zero MaxWallets means unlimited.
+type Tenant struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	AllowedNetworks []string  `json:"allowedNetworks,omitempty"`
+	MaxWallets      int       `json:"maxWallets"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// AllowsNetwork reports whether the tenant may create wallets on network.
+func (t *Tenant) AllowsNetwork(network string) bool {
+	if len(t.AllowedNetworks) == 0 {
+		return true
+	}
+	for _, allowed := range t.AllowedNetworks {
+		if allowed == network {
+			return true
+		}
+	}
+	return false
+}
+
+type TenantRepository interface {
+	Create(ctx context.Context, tenant Tenant) (*Tenant, error)
+	GetByID(ctx context.Context, id string) (*Tenant, error)
+	List(ctx context.Context) ([]Tenant, error)
+	Update(ctx context.Context, tenant Tenant) (*Tenant, error)
+}
+
+// TenantFromContext returns the tenant of the authenticated principal, or the
+// default tenant for unauthenticated internal calls.
+func TenantFromContext(ctx context.Context) string {
+	if p, ok := PrincipalFromContext(ctx); ok && p.TenantID != "" {
+		return p.TenantID
+	}
+	return DefaultTenantID
+}
+
+type TenantService interface {
+	CreateTenant(ctx context.Context, tenant Tenant) (*Tenant, error)
+	GetTenant(ctx context.Context, id string) (*Tenant, error)
+	ListTenants(ctx context.Context) ([]Tenant, error)
+	UpdateTenant(ctx context.Context, tenant Tenant) (*Tenant, error)
+}
+
+type tenantService struct {
+	repo TenantRepository
+}
+
+func NewTenantService(repo TenantRepository) TenantService {
+	return &tenantService{repo: repo}
+}
+
+func (s *tenantService) CreateTenant(ctx context.Context, tenant Tenant) (*Tenant, error) {
+	if err := validateTenant(&tenant); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetByID(ctx, tenant.ID); err == nil {
+		return nil, fmt.Errorf("%w: tenant %s already exists", ErrConflict, tenant.ID)
+	}
+
+	tenant.CreatedAt = time.Now().UTC()
+	tenant.UpdatedAt = tenant.CreatedAt
+	stored, err := s.repo.Create(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("store tenant: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *tenantService) GetTenant(ctx context.Context, id string) (*Tenant, error) {
+	tenant, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("get tenant: %w", err)
+	}
+	return tenant, nil
+}
+
+func (s *tenantService) ListTenants(ctx context.Context) ([]Tenant, error) {
+	tenants, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tenants: %w", err)
+	}
+	return tenants, nil
+}
+
+// UpdateTenant replaces the name, network allowlist and quota of an existing
+// tenant.
+func (s *tenantService) UpdateTenant(ctx context.Context, tenant Tenant) (*Tenant, error) {
+	existing, err := s.GetTenant(ctx, tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTenant(&tenant); err != nil {
+		return nil, err
+	}
+
+	tenant.CreatedAt = existing.CreatedAt
+	tenant.UpdatedAt = time.Now().UTC()
+	stored, err := s.repo.Update(ctx, tenant)
+	if err != nil {
+		return nil, fmt.Errorf("update tenant: %w", err)
+	}
+	return stored, nil
+}
+
+func validateTenant(tenant *Tenant) error {
+	tenant.ID = strings.TrimSpace(tenant.ID)
+	if !tenantIDPattern.MatchString(tenant.ID) {
+		return fmt.Errorf("%w: tenant id must be lowercase alphanumeric or dashes", ErrValidation)
+	}
+	if strings.TrimSpace(tenant.Name) == "" {
+		tenant.Name = tenant.ID
+	}
+	if tenant.MaxWallets < 0 {
+		return fmt.Errorf("%w: maxWallets must not be negative", ErrValidation)
+	}
+	for i, network := range tenant.AllowedNetworks {
+		tenant.AllowedNetworks[i] = strings.TrimSpace(network)
+	}
+	return nil
+}
+
+// checkTenantLimits enforces the tenant's network allowlist and wallet quota
+// before a new wallet is created.
+func (s *walletService) checkTenantLimits(ctx context.Context, tenantID string, network string) error {
+	if s.tenants == nil {
+		return nil
+	}
+
+	tenant, err := s.tenants.GetByID(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("%w: unknown tenant %s", ErrForbidden, tenantID)
+		}
+		return fmt.Errorf("get tenant: %w", err)
+	}
+	if !tenant.AllowsNetwork(network) {
+		return fmt.Errorf("%w: network %s is not allowed for tenant %s", ErrForbidden, network, tenantID)
+	}
+	if tenant.MaxWallets == 0 {
+		return nil
+	}
+
+	records, err := s.repo.ListByNetwork(ctx, tenantID, "")
+	if err != nil {
+		return fmt.Errorf("list wallets: %w", err)
+	}
+	live := 0
+	for _, record := range records {
+		if record.Status != WalletStatusDeleted {
+			live++
+		}
+	}
+	if live >= tenant.MaxWallets {
+		return fmt.Errorf("%w: tenant %s is limited to %d wallets", ErrQuotaExceeded, tenantID, tenant.MaxWallets)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+type stubTenantRepo struct {
+	mu      sync.RWMutex
+	tenants map[string]Tenant
+}
+
+func newStubTenantRepo(tenants ...Tenant) *stubTenantRepo {
+	repo := &stubTenantRepo{tenants: make(map[string]Tenant)}
+	for _, tenant := range tenants {
+		repo.tenants[tenant.ID] = tenant
+	}
+	return repo
+}
+
+func (r *stubTenantRepo) Create(_ context.Context, tenant Tenant) (*Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tenants[tenant.ID] = tenant
+	return &tenant, nil
+}
+
+func (r *stubTenantRepo) GetByID(_ context.Context, id string) (*Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenant, ok := r.tenants[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &tenant, nil
+}
+
+func (r *stubTenantRepo) List(_ context.Context) ([]Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]Tenant, 0, len(r.tenants))
+	for _, tenant := range r.tenants {
+		items = append(items, tenant)
+	}
+	return items, nil
+}
+
+func (r *stubTenantRepo) Update(ctx context.Context, tenant Tenant) (*Tenant, error) {
+	return r.Create(ctx, tenant)
+}
+
+func tenantContext(tenantID string) context.Context {
+	return ContextWithPrincipal(context.Background(), &Principal{ID: tenantID + "-key", TenantID: tenantID, Scopes: []Scope{ScopeAdmin}})
+}
+
+func TestWalletsAreIsolatedByTenant(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	tenants := newStubTenantRepo(Tenant{ID: "team-a"}, Tenant{ID: "team-b"})
+	svc := NewWalletService(repo, signer, WithTenants(tenants))
+
+	ctxA := tenantContext("team-a")
+	ctxB := tenantContext("team-b")
+
+	wallet, err := svc.CreateWallet(ctxA, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if wallet.TenantID != "team-a" {
+		t.Fatalf("expected tenant team-a, got %s", wallet.TenantID)
+	}
+
+	if _, err := svc.GetWallet(ctxB, wallet.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound across tenants, got %v", err)
+	}
+	if _, err := svc.SignMessage(ctxB, wallet.ID, []byte("gm")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound signing across tenants, got %v", err)
+	}
+	if _, err := svc.DisableWallet(ctxB, wallet.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound disabling across tenants, got %v", err)
+	}
+
+	listed, err := svc.ListWallets(ctxB, "")
+	if err != nil {
+		t.Fatalf("ListWallets returned error: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected no wallets for team-b, got %d", len(listed))
+	}
+
+	if _, err := svc.GetWallet(ctxA, wallet.ID); err != nil {
+		t.Fatalf("expected owner tenant to read wallet, got %v", err)
+	}
+}
+
+func TestTenantNetworkAllowlistAndQuota(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	tenants := newStubTenantRepo(Tenant{ID: "team-a", AllowedNetworks: []string{"base-sepolia"}, MaxWallets: 1})
+	svc := NewWalletService(repo, signer, WithTenants(tenants))
+
+	ctx := tenantContext("team-a")
+
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for disallowed network, got %v", err)
+	}
+	wallet, err := svc.CreateWallet(ctx, "base-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "base-sepolia"); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+	}
+
+	if _, err := svc.DeleteWallet(ctx, wallet.ID); err != nil {
+		t.Fatalf("DeleteWallet returned error: %v", err)
+	}
+	if _, err := svc.CreateWallet(ctx, "base-sepolia"); err != nil {
+		t.Fatalf("expected deleted wallet to free quota, got %v", err)
+	}
+}
+
+func TestRestrictedPrincipalOnlySeesAssignedWallets(t *testing.T) {
+	repo := newStubRepo()
+	svc := NewWalletService(repo, &stubSigner{})
+
+	owned, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	other, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	ctx := ContextWithPrincipal(context.Background(), &Principal{
+		ID:              "user-1",
+		Type:            PrincipalUser,
+		TenantID:        DefaultTenantID,
+		Scopes:          []Scope{ScopeWalletsRead, ScopeSign},
+		WalletIDs:       []string{owned.ID},
+		RestrictWallets: true,
+	})
+	if ActorFromContext(ctx) != "user:user-1" {
+		t.Fatalf("unexpected actor %s", ActorFromContext(ctx))
+	}
+
+	if _, err := svc.SignMessage(ctx, owned.ID, []byte("gm")); err != nil {
+		t.Fatalf("expected assigned wallet to sign, got %v", err)
+	}
+	if _, err := svc.SignMessage(ctx, other.ID, []byte("gm")); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("expected ErrNotFound for unassigned wallet, got %v", err)
+	}
+	listed, err := svc.ListWallets(ctx, "")
+	if err != nil {
+		t.Fatalf("ListWallets returned error: %v", err)
+	}
+	if len(listed) != 1 || listed[0].ID != owned.ID {
+		t.Fatalf("expected only the assigned wallet, got %+v", listed)
+	}
+	if _, err := svc.CreateWallet(ctx, "eth-sepolia"); !errors.Is(err, ErrForbidden) {
+		t.Fatalf("expected ErrForbidden creating wallet, got %v", err)
+	}
+}
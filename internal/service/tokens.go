@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	erc20TransferSelector = "0xa9059cbb"
+	erc20ApproveSelector  = "0x095ea7b3"
+	erc20DecimalsSelector = "0x313ce567"
+
+	// TokenAmountMax approves the largest uint256 allowance.
+	TokenAmountMax = "max"
+
+	// gasEstimateBuffer is the percentage added on top of eth_estimateGas so
+	// small state changes between estimation and inclusion do not run the
+	// transaction out of gas.
+	gasEstimateBuffer = 20
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Token is an ERC-20 contract known on a network.
+type Token struct {
+	Symbol   string `json:"symbol,omitempty"`
+	Address  string `json:"address"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// TokenTxOptions fills in the transaction around a token operation. Fields
+// left empty are read from the network.
+type TokenTxOptions struct {
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+	GasPrice string `json:"gasPrice,omitempty"`
+	Nonce    uint64 `json:"nonce,omitempty"`
+	// Broadcast submits the signed transaction to the wallet's network.
+	Broadcast bool `json:"broadcast,omitempty"`
+	// Force signs even when simulation shows the call reverting.
+	Force bool `json:"force,omitempty"`
+}
+
+// TokenTransferRequest sends Amount of Token to To. Token is a symbol from
+// the network's registry or a contract address, and Amount is in whole
+// tokens, such as "12.5".
+type TokenTransferRequest struct {
+	Token  string `json:"token"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	TokenTxOptions
+}
+
+// TokenApprovalRequest lets Spender move up to Amount of Token. Amount may be
+// TokenAmountMax for an unlimited allowance, or "0" to revoke one.
+type TokenApprovalRequest struct {
+	Token   string `json:"token"`
+	Spender string `json:"spender"`
+	Amount  string `json:"amount"`
+	TokenTxOptions
+}
+
+type TokenTxResult struct {
+	Token Token `json:"token"`
+	// Amount is in the token's base units.
+	Amount            string       `json:"amount"`
+	Transaction       *Transaction `json:"transaction"`
+	SignedTransaction string       `json:"signedTransaction"`
+	TxHash            string       `json:"txHash,omitempty"`
+	BroadcastError    string       `json:"broadcastError,omitempty"`
+}
+
+// TokenChain reads the on-chain state token operations need: decimals of
+// unregistered tokens, the wallet's nonce, the gas price and a gas estimate.
+type TokenChain interface {
+	ContractCaller
+	PendingNonce(ctx context.Context, rpcURL string, address string) (uint64, error)
+	GasPrice(ctx context.Context, rpcURL string) (string, error)
+	EstimateGas(ctx context.Context, rpcURL string, from string, tx *Transaction) (uint64, error)
+}
+
+// WithTokenChain enables TransferToken and ApproveToken.
+func WithTokenChain(chain TokenChain, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.chain = chain
+		s.registry = registry
+	}
+}
+
+func (s *walletService) TransferToken(ctx context.Context, walletID string, req TokenTransferRequest) (*TokenTxResult, error) {
+	return s.tokenOperation(ctx, walletID, erc20TransferSelector, req.Token, req.To, req.Amount, req.TokenTxOptions)
+}
+
+func (s *walletService) ApproveToken(ctx context.Context, walletID string, req TokenApprovalRequest) (*TokenTxResult, error) {
+	return s.tokenOperation(ctx, walletID, erc20ApproveSelector, req.Token, req.Spender, req.Amount, req.TokenTxOptions)
+}
+
+func (s *walletService) tokenOperation(ctx context.Context, walletID string, selector string, tokenRef string, counterparty string, amount string, opts TokenTxOptions) (*TokenTxResult, error) {
+	if s.chain == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: token operations are not configured", ErrNotImplemented)
+	}
+	counterparty = strings.TrimSpace(counterparty)
+	if !addressPattern.MatchString(counterparty) {
+		return nil, fmt.Errorf("%w: invalid recipient address", ErrValidation)
+	}
+
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	token, err := s.resolveToken(ctx, network, record.Address, tokenRef)
+	if err != nil {
+		return nil, err
+	}
+	units, err := parseTokenAmount(amount, token.Decimals, selector == erc20ApproveSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &Transaction{
+		ChainID:  network.ChainID,
+		From:     record.Address,
+		To:       token.Address,
+		Value:    "0x0",
+		Data:     selector + padWord(strings.TrimPrefix(strings.ToLower(counterparty), "0x")) + padWord(units.Text(16)),
+		GasLimit: opts.GasLimit,
+		GasPrice: opts.GasPrice,
+		Nonce:    opts.Nonce,
+	}
+	if err := s.fillTransaction(ctx, network, record.Address, tx); err != nil {
+		return nil, err
+	}
+
+	var signOpts []SignOption
+	if opts.Force {
+		signOpts = append(signOpts, ForceSign())
+	}
+	signed, err := s.SignTransaction(ctx, walletID, tx, signOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TokenTxResult{Token: *token, Amount: units.String(), Transaction: tx, SignedTransaction: signed}
+	if opts.Broadcast {
+		if s.broadcaster == nil {
+			result.BroadcastError = "broadcasting is not configured"
+		} else if hash, err := s.broadcaster.Broadcast(ctx, network.RPCURL, signed); err != nil {
+			result.BroadcastError = err.Error()
+		} else {
+			result.TxHash = hash
+		}
+	}
+	return result, nil
+}
+
+// resolveToken looks ref up as a symbol, or as an address whose decimals are
+// taken from the registry or read from the contract.
+func (s *walletService) resolveToken(ctx context.Context, network *Network, from string, ref string) (*Token, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, fmt.Errorf("%w: token is required", ErrValidation)
+	}
+	if !addressPattern.MatchString(ref) {
+		token, ok := network.Tokens[strings.ToUpper(ref)]
+		if !ok {
+			return nil, fmt.Errorf("%w: token %q is not registered on %s", ErrValidation, ref, network.Name)
+		}
+		return &token, nil
+	}
+
+	for _, token := range network.Tokens {
+		if strings.EqualFold(token.Address, ref) {
+			return &token, nil
+		}
+	}
+	returned, err := s.chain.Call(ctx, network.RPCURL, from, &Transaction{To: ref, Value: "0x0", Data: erc20DecimalsSelector})
+	if err != nil {
+		return nil, fmt.Errorf("read token decimals: %w", err)
+	}
+	decimals, ok := new(big.Int).SetString(strings.TrimPrefix(returned, "0x"), 16)
+	if !ok || decimals.Cmp(big.NewInt(255)) > 0 {
+		return nil, fmt.Errorf("%w: token %s returned invalid decimals", ErrValidation, ref)
+	}
+	return &Token{Address: ref, Decimals: uint8(decimals.Uint64())}, nil
+}
+
+// fillTransaction reads the nonce, gas price and gas limit the caller left
+// empty.
+func (s *walletService) fillTransaction(ctx context.Context, network *Network, from string, tx *Transaction) error {
+	if tx.Nonce == 0 {
+		nonce, err := s.chain.PendingNonce(ctx, network.RPCURL, from)
+		if err != nil {
+			return fmt.Errorf("read nonce: %w", err)
+		}
+		tx.Nonce, tx.nonceResolved = nonce, true
+	}
+	if tx.GasPrice == "" {
+		price, err := s.chain.GasPrice(ctx, network.RPCURL)
+		if err != nil {
+			return fmt.Errorf("read gas price: %w", err)
+		}
+		tx.GasPrice = price
+	}
+	if tx.GasLimit == 0 {
+		gas, err := s.chain.EstimateGas(ctx, network.RPCURL, from, tx)
+		if err != nil {
+			return fmt.Errorf("estimate gas: %w", err)
+		}
+		tx.GasLimit = gas + gas*gasEstimateBuffer/100
+	}
+	return nil
+}
+
+// parseTokenAmount converts a decimal amount in whole tokens to base units.
+func parseTokenAmount(amount string, decimals uint8, approval bool) (*big.Int, error) {
+	amount = strings.TrimSpace(amount)
+	if approval && strings.EqualFold(amount, TokenAmountMax) {
+		return new(big.Int).Set(maxUint256), nil
+	}
+	whole, fraction, _ := strings.Cut(amount, ".")
+	if whole == "" && fraction == "" {
+		return nil, fmt.Errorf("%w: amount is required", ErrValidation)
+	}
+	if len(fraction) > int(decimals) {
+		return nil, fmt.Errorf("%w: amount has more than %d decimal places", ErrValidation, decimals)
+	}
+	digits := whole + fraction + strings.Repeat("0", int(decimals)-len(fraction))
+	if strings.Trim(digits, "0123456789") != "" {
+		return nil, fmt.Errorf("%w: invalid amount %q", ErrValidation, amount)
+	}
+	units, ok := new(big.Int).SetString(digits, 10)
+	if !ok || units.Cmp(maxUint256) > 0 {
+		return nil, fmt.Errorf("%w: invalid amount %q", ErrValidation, amount)
+	}
+	if !approval && units.Sign() == 0 {
+		return nil, fmt.Errorf("%w: amount must be positive", ErrValidation)
+	}
+	return units, nil
+}
+
+func padWord(hexDigits string) string {
+	return strings.Repeat("0", 64-len(hexDigits)) + hexDigits
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+type stubTokenRegistry struct{}
+
+func (stubTokenRegistry) Lookup(network string) (*Network, error) {
+	return &Network{
+		Name:    network,
+		ChainID: 11155111,
+		RPCURL:  "http://" + network,
+		Tokens: map[string]Token{
+			"USDC": {Symbol: "USDC", Address: "0xcccccccccccccccccccccccccccccccccccccccc", Decimals: 6},
+		},
+	}, nil
+}
+
+type stubTokenChain struct {
+	decimalsCalls int
+	estimated     *Transaction
+	// fresh reports a wallet that has never sent a transaction.
+	fresh bool
+}
+
+func (c *stubTokenChain) Call(_ context.Context, _ string, _ string, tx *Transaction) (string, error) {
+	c.decimalsCalls++
+	return "0x" + padWord("12"), nil
+}
+
+func (c *stubTokenChain) PendingNonce(context.Context, string, string) (uint64, error) {
+	if c.fresh {
+		return 0, nil
+	}
+	return 7, nil
+}
+
+func (c *stubTokenChain) GasPrice(context.Context, string) (string, error) {
+	return "0x3b9aca00", nil
+}
+
+func (c *stubTokenChain) EstimateGas(_ context.Context, _ string, _ string, tx *Transaction) (uint64, error) {
+	c.estimated = tx
+	return 50000, nil
+}
+
+type stubBroadcaster struct {
+	signed string
+}
+
+func (b *stubBroadcaster) Broadcast(_ context.Context, _ string, signedTx string) (string, error) {
+	b.signed = signedTx
+	return "0xhash", nil
+}
+
+func TestTransferTokenBuildsAndSignsTransfer(t *testing.T) {
+	chain := &stubTokenChain{}
+	signer := &stubSigner{}
+	broadcaster := &stubBroadcaster{}
+	svc := NewWalletService(newStubRepo(), signer,
+		WithTokenChain(chain, stubTokenRegistry{}),
+		WithBroadcaster(broadcaster, stubTokenRegistry{}),
+	)
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	result, err := svc.TransferToken(ctx, wallet.ID, TokenTransferRequest{
+		Token:          "usdc",
+		To:             "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Amount:         "12.5",
+		TokenTxOptions: TokenTxOptions{Broadcast: true},
+	})
+	if err != nil {
+		t.Fatalf("TransferToken returned error: %v", err)
+	}
+	wantData := erc20TransferSelector + padWord(strings.Repeat("a", 40)) + padWord("bebc20")
+	if result.Amount != "12500000" || signer.lastTx == nil || signer.lastTx.Data != wantData {
+		t.Fatalf("unexpected transfer %+v", signer.lastTx)
+	}
+	if tx := signer.lastTx; tx.To != "0xcccccccccccccccccccccccccccccccccccccccc" || tx.Nonce != 7 || tx.GasPrice != "0x3b9aca00" || tx.GasLimit != 60000 || tx.ChainID != 11155111 {
+		t.Fatalf("expected chain defaults to be filled in, got %+v", tx)
+	}
+	if chain.decimalsCalls != 0 {
+		t.Fatalf("expected registered decimals to be used")
+	}
+	if result.TxHash != "0xhash" || broadcaster.signed != "signed-tx" {
+		t.Fatalf("expected the transfer to be broadcast, got %+v", result)
+	}
+}
+
+func TestTransferTokenFromAFreshWallet(t *testing.T) {
+	signer := &stubSigner{}
+	svc := NewWalletService(newStubRepo(), signer, WithTokenChain(&stubTokenChain{fresh: true}, stubTokenRegistry{}))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := svc.TransferToken(ctx, wallet.ID, TokenTransferRequest{Token: "USDC", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "1"}); err != nil {
+		t.Fatalf("expected a first transfer to use nonce 0, got %v", err)
+	}
+	if signer.lastTx == nil || signer.lastTx.Nonce != 0 {
+		t.Fatalf("unexpected transaction %+v", signer.lastTx)
+	}
+
+	if _, err := svc.SignTransaction(ctx, wallet.ID, &Transaction{To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Value: "0x0", GasLimit: 21000, GasPrice: "0x1"}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a caller-supplied transaction to still need a nonce, got %v", err)
+	}
+}
+
+func TestApproveTokenReadsDecimalsForUnknownAddress(t *testing.T) {
+	chain := &stubTokenChain{}
+	signer := &stubSigner{}
+	svc := NewWalletService(newStubRepo(), signer, WithTokenChain(chain, stubTokenRegistry{}))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	result, err := svc.ApproveToken(ctx, wallet.ID, TokenApprovalRequest{
+		Token:          "0xdddddddddddddddddddddddddddddddddddddddd",
+		Spender:        "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Amount:         "1",
+		TokenTxOptions: TokenTxOptions{Nonce: 3, GasLimit: 70000},
+	})
+	if err != nil {
+		t.Fatalf("ApproveToken returned error: %v", err)
+	}
+	if chain.decimalsCalls != 1 || result.Token.Decimals != 18 || result.Amount != "1000000000000000000" {
+		t.Fatalf("expected decimals read from the contract, got %+v", result)
+	}
+	if signer.lastTx.Nonce != 3 || signer.lastTx.GasLimit != 70000 || chain.estimated != nil {
+		t.Fatalf("expected caller values to be kept, got %+v", signer.lastTx)
+	}
+	if !strings.HasPrefix(signer.lastTx.Data, erc20ApproveSelector) {
+		t.Fatalf("expected approve calldata, got %s", signer.lastTx.Data)
+	}
+
+	result, err = svc.ApproveToken(ctx, wallet.ID, TokenApprovalRequest{Token: "USDC", Spender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "max"})
+	if err != nil || result.Amount != maxUint256.String() {
+		t.Fatalf("expected an unlimited approval, got %+v (%v)", result, err)
+	}
+}
+
+func TestTokenOperationsValidateInput(t *testing.T) {
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithTokenChain(&stubTokenChain{}, stubTokenRegistry{}))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	cases := []TokenTransferRequest{
+		{Token: "DAI", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "1"},
+		{Token: "USDC", To: "not-an-address", Amount: "1"},
+		{Token: "USDC", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "1.0000001"},
+		{Token: "USDC", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "-1"},
+		{Token: "USDC", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "0"},
+		{Token: "USDC", To: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Amount: "max"},
+	}
+	for _, req := range cases {
+		if _, err := svc.TransferToken(ctx, wallet.ID, req); !errors.Is(err, ErrValidation) {
+			t.Fatalf("expected validation error for %+v, got %v", req, err)
+		}
+	}
+
+	unconfigured := NewWalletService(newStubRepo(), &stubSigner{})
+	if _, err := unconfigured.TransferToken(ctx, wallet.ID, cases[0]); !errors.Is(err, ErrNotImplemented) {
+		t.Fatalf("expected not implemented without a token chain, got %v", err)
+	}
+}
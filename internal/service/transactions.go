@@ -1,13 +1,26 @@
 package service
 
-// Transaction represents a simplified transaction request.
+// Transaction represents a simplified transaction request. A transaction
+// with an AuthorizationList is signed as an EIP-7702 set-code transaction,
+// and one with Blobs as an EIP-4844 blob transaction. For both, GasPrice is
+// the max fee per gas and MaxPriorityFeePerGas the tip, which defaults to
+// GasPrice. Blobs are 0x hex of at most BlobSize bytes; the signed form of
+// a blob transaction is the network encoding with the blob sidecar.
 type Transaction struct {
-	ChainID  int64  `json:"chainId"`
-	From     string `json:"from,omitempty"`
-	To       string `json:"to"`
-	Value    string `json:"value"`
-	Data     string `json:"data,omitempty"`
-	GasLimit uint64 `json:"gasLimit"`
-	GasPrice string `json:"gasPrice"`
-	Nonce    uint64 `json:"nonce"`
+	ChainID              int64           `json:"chainId"`
+	From                 string          `json:"from,omitempty"`
+	To                   string          `json:"to"`
+	Value                string          `json:"value"`
+	Data                 string          `json:"data,omitempty"`
+	GasLimit             uint64          `json:"gasLimit"`
+	GasPrice             string          `json:"gasPrice"`
+	MaxPriorityFeePerGas string          `json:"maxPriorityFeePerGas,omitempty"`
+	Nonce                uint64          `json:"nonce"`
+	AuthorizationList    []Authorization `json:"authorizationList,omitempty"`
+	MaxFeePerBlobGas     string          `json:"maxFeePerBlobGas,omitempty"`
+	Blobs                []string        `json:"blobs,omitempty"`
+
+	// nonceResolved marks a Nonce read from the network, so a fresh
+	// wallet's nonce of 0 passes validation.
+	nonceResolved bool
 }
@@ -0,0 +1,24 @@
+package service
+
+// TypedData is an EIP-712 document in the shape eth_signTypedData_v4 takes.
+// Integers in Message are decimal strings.
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      TypedDataDomain             `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedDataDomain holds the EIP712Domain fields. Empty fields are left out of
+// the domain, so Types["EIP712Domain"] must list exactly the ones set.
+type TypedDataDomain struct {
+	Name              string `json:"name,omitempty"`
+	Version           string `json:"version,omitempty"`
+	ChainID           int64  `json:"chainId,omitempty"`
+	VerifyingContract string `json:"verifyingContract,omitempty"`
+}
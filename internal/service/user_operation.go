@@ -0,0 +1,506 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type EntryPointVersion string
+
+const (
+	EntryPointV06 EntryPointVersion = "v0.6"
+	EntryPointV07 EntryPointVersion = "v0.7"
+
+	// EntryPointV06Address and EntryPointV07Address are the canonical
+	// EntryPoint deployments, the same on every chain.
+	EntryPointV06Address = "0x5FF137D4b0FDCD49DcA30c7CF57E578a026d2789"
+	EntryPointV07Address = "0x0000000071727De22E5E9d8BAf0edAc6f37da032"
+
+	AuditOperationSignUserOperation = "sign_user_operation"
+
+	// Calls a smart account makes on behalf of a user operation: execute and
+	// the v0.6 and v0.7 executeBatch of SimpleAccount and compatible
+	// accounts.
+	accountExecuteSelector           = "0xb61d27f6"
+	accountExecuteBatchSelector      = "0x18dfb3c7"
+	accountExecuteBatchValueSelector = "0x47e1da2a"
+)
+
+// UserOperation is an ERC-4337 user operation. Quantities are 0x hex and
+// byte fields are 0x hex. InitCode and PaymasterAndData are the v0.6 fields;
+// v0.7 splits them into Factory/FactoryData and the Paymaster fields.
+type UserOperation struct {
+	Sender                        string `json:"sender"`
+	Nonce                         string `json:"nonce"`
+	InitCode                      string `json:"initCode,omitempty"`
+	Factory                       string `json:"factory,omitempty"`
+	FactoryData                   string `json:"factoryData,omitempty"`
+	CallData                      string `json:"callData"`
+	CallGasLimit                  string `json:"callGasLimit"`
+	VerificationGasLimit          string `json:"verificationGasLimit"`
+	PreVerificationGas            string `json:"preVerificationGas"`
+	MaxFeePerGas                  string `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          string `json:"maxPriorityFeePerGas"`
+	PaymasterAndData              string `json:"paymasterAndData,omitempty"`
+	Paymaster                     string `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit string `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       string `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 string `json:"paymasterData,omitempty"`
+	Signature                     string `json:"signature,omitempty"`
+}
+
+// EntryPoint identifies the contract a user operation is bound to.
+type EntryPoint struct {
+	Version EntryPointVersion `json:"version"`
+	Address string            `json:"address"`
+}
+
+// UserOperationRequest asks the wallet, as the owner of a smart account, to
+// sign a user operation. Version defaults to v0.7 and EntryPoint to the
+// canonical deployment of that version. Submit sends the signed operation to
+// the network's bundler.
+type UserOperationRequest struct {
+	Version       EntryPointVersion `json:"version,omitempty"`
+	EntryPoint    string            `json:"entryPoint,omitempty"`
+	UserOperation UserOperation     `json:"userOperation"`
+	Submit        bool              `json:"submit,omitempty"`
+}
+
+// UserOperationResult holds the signed operation. Submitted is set once the
+// bundler accepted it; SubmitError is set when it refused, since the
+// operation was still signed.
+type UserOperationResult struct {
+	EntryPoint    EntryPoint     `json:"entryPoint"`
+	ChainID       int64          `json:"chainId"`
+	UserOpHash    string         `json:"userOpHash"`
+	UserOperation *UserOperation `json:"userOperation"`
+	Submitted     bool           `json:"submitted"`
+	SubmitError   string         `json:"submitError,omitempty"`
+}
+
+// Bundler hashes user operations the way a given EntryPoint does and
+// submits them with eth_sendUserOperation.
+type Bundler interface {
+	HashUserOperation(op *UserOperation, entryPoint EntryPoint, chainID int64) (string, error)
+	SendUserOperation(ctx context.Context, bundlerURL string, op *UserOperation, entryPoint EntryPoint) (string, error)
+}
+
+// WithBundler enables SignUserOperation. Networks are looked up in registry
+// for their chain ID and bundler URL.
+func WithBundler(bundler Bundler, registry NetworkRegistry) WalletServiceOption {
+	return func(s *walletService) {
+		s.bundler = bundler
+		s.registry = registry
+	}
+}
+
+func (s *walletService) SignUserOperation(ctx context.Context, walletID string, req UserOperationRequest) (*UserOperationResult, error) {
+	result, err := s.signUserOperation(ctx, walletID, req)
+
+	encoded, _ := json.Marshal(req.UserOperation)
+	var userOpHash string
+	if result != nil {
+		userOpHash = result.UserOpHash
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignUserOperation, hashPayload(encoded), userOpHash, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return result, err
+}
+
+func (s *walletService) signUserOperation(ctx context.Context, walletID string, req UserOperationRequest) (*UserOperationResult, error) {
+	if s.bundler == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: user operations are not configured", ErrNotImplemented)
+	}
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	if err := requireEthereum(record); err != nil {
+		return nil, err
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	if record.SmartAccount != nil {
+		if err := s.prepareSmartAccountOperation(ctx, record, network, &req); err != nil {
+			return nil, err
+		}
+	}
+
+	entryPoint, err := resolveEntryPoint(req.Version, req.EntryPoint)
+	if err != nil {
+		return nil, err
+	}
+	op := req.UserOperation
+	if err := validateUserOperation(&op, entryPoint.Version); err != nil {
+		return nil, err
+	}
+	if req.Submit && network.BundlerURL == "" {
+		return nil, fmt.Errorf("%w: no bundler is configured for %s", ErrValidation, record.Network)
+	}
+	calls, decoded, err := accountCalls(network.ChainID, &op)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.checkAndSignOperation(ctx, record, network, entryPoint, &op, calls, decoded, req.Submit)
+	if err != nil {
+		return nil, err
+	}
+	if req.Submit {
+		s.submitUserOperation(ctx, network, result)
+	}
+	return result, nil
+}
+
+// checkAndSignOperation evaluates the calls of op against the wallet's
+// policies and signs it, or queues it when a quorum policy applies.
+func (s *walletService) checkAndSignOperation(ctx context.Context, record *WalletRecord, network *Network, entryPoint EntryPoint, op *UserOperation, calls []Transaction, decoded bool, submit bool) (*UserOperationResult, error) {
+	s.spendMu.Lock()
+	defer s.spendMu.Unlock()
+
+	value, quorum, err := s.checkAccountCalls(ctx, record, calls, decoded)
+	if err != nil {
+		return nil, err
+	}
+	if quorum != nil {
+		req := UserOperationRequest{Version: entryPoint.Version, EntryPoint: entryPoint.Address, UserOperation: *op, Submit: submit}
+		return nil, s.queueUserOperation(ctx, record, network, req, value, quorum)
+	}
+	return s.signOperationAndRecordSpend(ctx, record, network, entryPoint, op, value)
+}
+
+func (s *walletService) signOperationAndRecordSpend(ctx context.Context, record *WalletRecord, network *Network, entryPoint EntryPoint, op *UserOperation, value *big.Int) (*UserOperationResult, error) {
+	userOpHash, err := s.bundler.HashUserOperation(op, entryPoint, network.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := hex.DecodeString(strings.TrimPrefix(userOpHash, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("decode user operation hash: %w", err)
+	}
+
+	// Owner-validated accounts such as SimpleAccount and Kernel's ECDSA
+	// validator expect an EIP-191 signature over the hash.
+	signature, err := s.signer.SignMessage(record.Network, record.PrivKey, digest)
+	if err != nil {
+		return nil, fmt.Errorf("sign user operation: %w", err)
+	}
+	op.Signature = signature.Signature
+
+	if err := s.recordSpend(ctx, record, value); err != nil {
+		return nil, fmt.Errorf("record spend: %w", err)
+	}
+	return &UserOperationResult{
+		EntryPoint:    entryPoint,
+		ChainID:       network.ChainID,
+		UserOpHash:    userOpHash,
+		UserOperation: op,
+	}, nil
+}
+
+// submitUserOperation sends a signed operation to the network's bundler and
+// notes the outcome in result.
+func (s *walletService) submitUserOperation(ctx context.Context, network *Network, result *UserOperationResult) {
+	if _, err := s.bundler.SendUserOperation(ctx, network.BundlerURL, result.UserOperation, result.EntryPoint); err != nil {
+		result.SubmitError = err.Error()
+	} else {
+		result.Submitted = true
+	}
+}
+
+// checkAccountCalls evaluates the calls of a user operation as transactions
+// from the account. Each call is checked with its own value, and limits
+// apply to the total of the operation, which is returned for spend
+// accounting. When the calls could not be decoded, policies that limit
+// value or destinations reject the operation. When quorum policies apply,
+// the one requiring the most approvals is returned.
+func (s *walletService) checkAccountCalls(ctx context.Context, record *WalletRecord, calls []Transaction, decoded bool) (*big.Int, *Policy, error) {
+	if !decoded && s.policies != nil {
+		if err := s.policies.CheckOpaque(ctx, record, "user operations with undecodable callData"); err != nil {
+			return nil, nil, err
+		}
+	}
+	return s.checkCallPolicies(ctx, record, calls, nil)
+}
+
+func resolveEntryPoint(version EntryPointVersion, address string) (EntryPoint, error) {
+	address = strings.TrimSpace(address)
+	switch version {
+	case "":
+		version = EntryPointV07
+	case EntryPointV06, EntryPointV07:
+	default:
+		return EntryPoint{}, fmt.Errorf("%w: unsupported EntryPoint version %q", ErrValidation, version)
+	}
+	if address == "" {
+		address = EntryPointV07Address
+		if version == EntryPointV06 {
+			address = EntryPointV06Address
+		}
+	}
+	if !addressPattern.MatchString(address) {
+		return EntryPoint{}, fmt.Errorf("%w: invalid EntryPoint address", ErrValidation)
+	}
+	return EntryPoint{Version: version, Address: address}, nil
+}
+
+func validateUserOperation(op *UserOperation, version EntryPointVersion) error {
+	op.Sender = strings.TrimSpace(op.Sender)
+	if !addressPattern.MatchString(op.Sender) {
+		return fmt.Errorf("%w: invalid sender address", ErrValidation)
+	}
+	if op.Signature != "" {
+		return fmt.Errorf("%w: user operation is already signed", ErrValidation)
+	}
+
+	v06 := op.InitCode != "" || op.PaymasterAndData != ""
+	v07 := op.Factory != "" || op.FactoryData != "" || op.Paymaster != "" ||
+		op.PaymasterVerificationGasLimit != "" || op.PaymasterPostOpGasLimit != "" || op.PaymasterData != ""
+	switch {
+	case version == EntryPointV06 && v07:
+		return fmt.Errorf("%w: v0.6 user operations use initCode and paymasterAndData", ErrValidation)
+	case version == EntryPointV07 && v06:
+		return fmt.Errorf("%w: v0.7 user operations use factory and paymaster fields", ErrValidation)
+	case op.Factory != "" && !addressPattern.MatchString(op.Factory):
+		return fmt.Errorf("%w: invalid factory address", ErrValidation)
+	case op.Factory == "" && op.FactoryData != "":
+		return fmt.Errorf("%w: factoryData needs a factory", ErrValidation)
+	case op.Paymaster != "" && !addressPattern.MatchString(op.Paymaster):
+		return fmt.Errorf("%w: invalid paymaster address", ErrValidation)
+	case op.Paymaster == "" && (op.PaymasterData != "" || op.PaymasterVerificationGasLimit != "" || op.PaymasterPostOpGasLimit != ""):
+		return fmt.Errorf("%w: paymaster fields need a paymaster", ErrValidation)
+	}
+	return nil
+}
+
+// accountCalls decodes the calls op.CallData makes the account perform and
+// reports whether it could. Calldata other than execute or executeBatch,
+// including none, is returned as the single call the EntryPoint makes, from
+// the account to itself, so method and quorum rules still see it. Its value
+// and recipients are unknown.
+func accountCalls(chainID int64, op *UserOperation) ([]Transaction, bool, error) {
+	callData := strings.TrimSpace(op.CallData)
+	if !hexDataPattern.MatchString(callData) {
+		return nil, false, fmt.Errorf("%w: invalid callData", ErrValidation)
+	}
+	data, _ := hex.DecodeString(strings.TrimPrefix(callData, "0x"))
+	call := func(to string, value *big.Int, data []byte) Transaction {
+		return Transaction{ChainID: chainID, From: op.Sender, To: to, Value: "0x" + value.Text(16), Data: "0x" + hex.EncodeToString(data)}
+	}
+	if len(data) < 4 {
+		return []Transaction{call(op.Sender, new(big.Int), data)}, false, nil
+	}
+
+	selector, args := "0x"+hex.EncodeToString(data[:4]), data[4:]
+	switch selector {
+	case accountExecuteSelector:
+		to, err := abiAddress(args, 0)
+		if err != nil {
+			return nil, false, err
+		}
+		value, err := abiWord(args, 32)
+		if err != nil {
+			return nil, false, err
+		}
+		inner, err := abiBytesArg(args, 64)
+		if err != nil {
+			return nil, false, err
+		}
+		return []Transaction{call(to, value, inner)}, true, nil
+
+	case accountExecuteBatchSelector, accountExecuteBatchValueSelector:
+		dests, destCount, err := abiArrayArg(args, 0)
+		if err != nil {
+			return nil, false, err
+		}
+		var values []byte
+		valueCount := 0
+		funcHead := 32
+		if selector == accountExecuteBatchValueSelector {
+			if values, valueCount, err = abiArrayArg(args, 32); err != nil {
+				return nil, false, err
+			}
+			funcHead = 64
+		}
+		funcs, funcCount, err := abiArrayArg(args, funcHead)
+		if err != nil {
+			return nil, false, err
+		}
+		if funcCount != destCount || (valueCount != 0 && valueCount != destCount) {
+			return nil, false, fmt.Errorf("%w: executeBatch arrays differ in length", ErrValidation)
+		}
+
+		calls := make([]Transaction, 0, destCount)
+		for i := 0; i < destCount; i++ {
+			to, err := abiAddress(dests, i*32)
+			if err != nil {
+				return nil, false, err
+			}
+			value := new(big.Int)
+			if valueCount > 0 {
+				if value, err = abiWord(values, i*32); err != nil {
+					return nil, false, err
+				}
+			}
+			inner, err := abiBytesArg(funcs, i*32)
+			if err != nil {
+				return nil, false, err
+			}
+			calls = append(calls, call(to, value, inner))
+		}
+		return calls, true, nil
+	}
+	return []Transaction{call(op.Sender, new(big.Int), data)}, false, nil
+}
+
+var errMalformedCallData = fmt.Errorf("%w: malformed account callData", ErrValidation)
+
+// abiWord reads the 32-byte word at offset of ABI-encoded data.
+func abiWord(data []byte, offset int) (*big.Int, error) {
+	if offset < 0 || offset+32 > len(data) {
+		return nil, errMalformedCallData
+	}
+	return new(big.Int).SetBytes(data[offset : offset+32]), nil
+}
+
+func abiAddress(data []byte, offset int) (string, error) {
+	word, err := abiWord(data, offset)
+	if err != nil || word.BitLen() > 160 {
+		return "", errMalformedCallData
+	}
+	return "0x" + hex.EncodeToString(data[offset+12:offset+32]), nil
+}
+
+// abiOffset reads a word that points into, or counts items of, data.
+func abiOffset(data []byte, offset int) (int, error) {
+	word, err := abiWord(data, offset)
+	if err != nil || !word.IsInt64() || word.Int64() > int64(len(data)) {
+		return 0, errMalformedCallData
+	}
+	return int(word.Int64()), nil
+}
+
+// abiBytesArg reads the dynamic bytes value whose offset is at head.
+func abiBytesArg(data []byte, head int) ([]byte, error) {
+	at, err := abiOffset(data, head)
+	if err != nil {
+		return nil, err
+	}
+	length, err := abiOffset(data, at)
+	if err != nil || at+32+length > len(data) {
+		return nil, errMalformedCallData
+	}
+	return data[at+32 : at+32+length], nil
+}
+
+// abiArrayArg reads the dynamic array whose offset is at head and returns
+// the data its elements are encoded in, with their count.
+func abiArrayArg(data []byte, head int) ([]byte, int, error) {
+	at, err := abiOffset(data, head)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := abiOffset(data, at)
+	if err != nil || count > (len(data)-at-32)/32 {
+		return nil, 0, errMalformedCallData
+	}
+	return data[at+32:], count, nil
+}
+
+// queueUserOperation stores a pending request for a user operation. A
+// request to submit the operation carries over to the approved one.
+func (s *walletService) queueUserOperation(ctx context.Context, record *WalletRecord, network *Network, req UserOperationRequest, value *big.Int, policy *Policy) error {
+	op := req.UserOperation
+	return s.queue(ctx, record, policy, ApprovalRequest{
+		Transaction: Transaction{
+			ChainID: network.ChainID,
+			From:    req.EntryPoint,
+			To:      op.Sender,
+			Value:   "0x" + value.Text(16),
+			Data:    op.CallData,
+		},
+		UserOperation: &req,
+		Broadcast:     req.Submit,
+	})
+}
+
+// executeUserOperationApproval signs a queued user operation once its quorum
+// is reached. The other policies are evaluated again, as for transactions.
+func (s *walletService) executeUserOperationApproval(ctx context.Context, request *ApprovalRequest) error {
+	req := *request.UserOperation
+	result, err := s.signApprovedUserOperation(ctx, request.WalletID, req)
+
+	encoded, _ := json.Marshal(req.UserOperation)
+	var userOpHash string
+	if result != nil {
+		userOpHash = result.UserOpHash
+	}
+	if auditErr := s.record(ctx, request.WalletID, AuditOperationSignUserOperation, hashPayload(encoded), userOpHash, err); auditErr != nil {
+		return auditErr
+	}
+	if err != nil {
+		return err
+	}
+
+	request.Status = ApprovalStatusApproved
+	request.UserOperationResult = result
+	return nil
+}
+
+func (s *walletService) signApprovedUserOperation(ctx context.Context, walletID string, req UserOperationRequest) (*UserOperationResult, error) {
+	if s.bundler == nil || s.registry == nil {
+		return nil, fmt.Errorf("%w: user operations are not configured", ErrNotImplemented)
+	}
+	record, err := s.load(ctx, walletID)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		return nil, fmt.Errorf("lookup network: %w", err)
+	}
+	op := req.UserOperation
+	calls, decoded, err := accountCalls(network.ChainID, &op)
+	if err != nil {
+		return nil, err
+	}
+
+	s.spendMu.Lock()
+	defer s.spendMu.Unlock()
+
+	value, _, err := s.checkAccountCalls(ctx, record, calls, decoded)
+	if err != nil {
+		return nil, err
+	}
+	return s.signOperationAndRecordSpend(ctx, record, network, EntryPoint{Version: req.Version, Address: req.EntryPoint}, &op, value)
+}
+
+// submitApprovedUserOperation submits the operation signed for request,
+// reporting a refusal in BroadcastError as well.
+func (s *walletService) submitApprovedUserOperation(ctx context.Context, request *ApprovalRequest) {
+	record, err := s.load(ctx, request.WalletID)
+	if err != nil {
+		request.BroadcastError = err.Error()
+		return
+	}
+	network, err := s.registry.Lookup(record.Network)
+	if err != nil {
+		request.BroadcastError = err.Error()
+		return
+	}
+	s.submitUserOperation(ctx, network, request.UserOperationResult)
+	request.BroadcastError = request.UserOperationResult.SubmitError
+}
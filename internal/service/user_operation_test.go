@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type stubBundler struct {
+	entryPoint EntryPoint
+	sent       *UserOperation
+	sendErr    error
+}
+
+func (b *stubBundler) HashUserOperation(_ *UserOperation, entryPoint EntryPoint, _ int64) (string, error) {
+	b.entryPoint = entryPoint
+	return "0x" + strings.Repeat("ab", 32), nil
+}
+
+func (b *stubBundler) SendUserOperation(_ context.Context, _ string, op *UserOperation, _ EntryPoint) (string, error) {
+	b.sent = op
+	return "0x" + strings.Repeat("ab", 32), b.sendErr
+}
+
+type stubBundlerRegistry struct{}
+
+func (stubBundlerRegistry) Lookup(network string) (*Network, error) {
+	return &Network{Name: network, ChainID: 11155111, BundlerURL: "http://bundler"}, nil
+}
+
+func TestSignUserOperationSignsHashAndSubmits(t *testing.T) {
+	bundler := &stubBundler{}
+	signer := &stubSigner{}
+	audit := &stubAuditLog{}
+	svc := NewWalletService(newStubRepo(), signer, WithBundler(bundler, stubBundlerRegistry{}), WithAuditLog(audit))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	op := UserOperation{Sender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0", CallData: "0x"}
+	result, err := svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{UserOperation: op, Submit: true})
+	if err != nil {
+		t.Fatalf("SignUserOperation returned error: %v", err)
+	}
+	if bundler.entryPoint != (EntryPoint{Version: EntryPointV07, Address: EntryPointV07Address}) {
+		t.Fatalf("expected the default v0.7 EntryPoint, got %+v", bundler.entryPoint)
+	}
+	if len(signer.lastPayload) != 32 || signer.lastPayload[0] != 0xab {
+		t.Fatalf("expected the raw user operation hash to be signed, got %x", signer.lastPayload)
+	}
+	if !result.Submitted || bundler.sent == nil || bundler.sent.Signature != "signature" || result.ChainID != 11155111 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	entries, err := audit.Entries(ctx)
+	if err != nil || len(entries) != 1 || entries[0].Operation != AuditOperationSignUserOperation || entries[0].Result != result.UserOpHash {
+		t.Fatalf("expected one user operation audit entry, got %+v (%v)", entries, err)
+	}
+
+	bundler.sendErr = errors.New("AA21 didn't pay prefund")
+	result, err = svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{UserOperation: op, Submit: true})
+	if err != nil || result.Submitted || result.SubmitError == "" {
+		t.Fatalf("expected the bundler error in the result, got %+v (%v)", result, err)
+	}
+}
+
+func TestSignUserOperationRejectsMixedVersionFields(t *testing.T) {
+	svc := NewWalletService(newStubRepo(), &stubSigner{}, WithBundler(&stubBundler{}, stubBundlerRegistry{}))
+	ctx := context.Background()
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	_, err = svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{
+		Version:       EntryPointV06,
+		UserOperation: UserOperation{Sender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Factory: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+	})
+	if !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+}
+
+// executeBatchCalldata encodes a v0.7 executeBatch(address[],uint256[],bytes[]).
+func executeBatchCalldata(dests []string, values []int64, funcs []string) string {
+	word := func(n int) string { return fmt.Sprintf("%064x", n) }
+	destsPart := word(len(dests))
+	for _, dest := range dests {
+		destsPart += padWord(strings.TrimPrefix(dest, "0x"))
+	}
+	valuesPart := word(len(values))
+	for _, value := range values {
+		valuesPart += fmt.Sprintf("%064x", value)
+	}
+	funcsPart, bodies := word(len(funcs)), ""
+	for _, f := range funcs {
+		funcsPart += word(len(funcs)*32 + len(bodies)/2)
+		data := strings.TrimPrefix(f, "0x")
+		bodies += word(len(data)/2) + data + strings.Repeat("0", (64-len(data)%64)%64)
+	}
+	funcsPart += bodies
+
+	head := word(96) + word(96+len(destsPart)/2) + word(96+len(destsPart)/2+len(valuesPart)/2)
+	return accountExecuteBatchValueSelector + head + destsPart + valuesPart + funcsPart
+}
+
+func TestAccountCallsDecodesExecuteAndBatches(t *testing.T) {
+	sender := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	transfer := erc20TransferSelector + padWord(strings.Repeat("b", 40)) + padWord("64")
+	execute := accountExecuteSelector + padWord(strings.Repeat("c", 40)) + padWord("5") + padWord("60") + padWord("44") + strings.TrimPrefix(transfer, "0x") + strings.Repeat("0", 56)
+
+	calls, decoded, err := accountCalls(1, &UserOperation{Sender: sender, CallData: execute})
+	if err != nil || !decoded || len(calls) != 1 || calls[0].To != "0x"+strings.Repeat("c", 40) || calls[0].Value != "0x5" || calls[0].Data != transfer {
+		t.Fatalf("unexpected execute calls %+v (%v)", calls, err)
+	}
+
+	batch := executeBatchCalldata([]string{"0x" + strings.Repeat("c", 40), "0x" + strings.Repeat("d", 40)}, []int64{1, 2}, []string{transfer, "0x"})
+	calls, decoded, err = accountCalls(1, &UserOperation{Sender: sender, CallData: batch})
+	if err != nil || !decoded || len(calls) != 2 || calls[1].To != "0x"+strings.Repeat("d", 40) || calls[1].Value != "0x2" || calls[0].Data != transfer || calls[1].Data != "0x" {
+		t.Fatalf("unexpected batch calls %+v (%v)", calls, err)
+	}
+
+	calls, decoded, err = accountCalls(1, &UserOperation{Sender: sender, CallData: "0x12345678"})
+	if err != nil || decoded || len(calls) != 1 || calls[0].To != sender || calls[0].Data != "0x12345678" {
+		t.Fatalf("expected other calldata as an undecoded call to the account, got %+v (%v)", calls, err)
+	}
+	if _, _, err := accountCalls(1, &UserOperation{Sender: sender, CallData: batch[:len(batch)-64]}); !errors.Is(err, ErrValidation) {
+		t.Fatalf("expected truncated calldata to be rejected, got %v", err)
+	}
+	if calls, decoded, err := accountCalls(1, &UserOperation{Sender: sender, CallData: "0x"}); err != nil || decoded || len(calls) != 1 || calls[0].To != sender {
+		t.Fatalf("expected empty calldata as an undecoded call to the account, got %+v (%v)", calls, err)
+	}
+}
+
+func TestSignUserOperationRejectsUndecodedCallsUnderValueRules(t *testing.T) {
+	repo := newStubRepo()
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{})
+	svc := NewWalletService(repo, &stubSigner{}, WithBundler(&stubBundler{}, stubBundlerRegistry{}), WithPolicies(policies))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	policyService := NewPolicyService(policies, repo, familyRegistry{})
+	methods, err := policyService.CreatePolicy(ctx, Policy{Name: "methods", Rules: PolicyRules{AllowedMethods: []string{"0x12345678"}}})
+	if err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	sign := func(callData string) error {
+		op := UserOperation{Sender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0", CallData: callData}
+		_, err := svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{UserOperation: op})
+		return err
+	}
+	if err := sign("0x12345678"); err != nil {
+		t.Fatalf("expected an allowed method without value rules to be signed, got %v", err)
+	}
+	var violation *PolicyViolation
+	if err := sign("0x87654321"); !errors.As(err, &violation) || violation.Rule != PolicyRuleAllowedMethods {
+		t.Fatalf("expected the account call to be checked against methods, got %v", err)
+	}
+	if err := policyService.DeletePolicy(ctx, methods.ID); err != nil {
+		t.Fatalf("DeletePolicy returned error: %v", err)
+	}
+
+	if _, err := policyService.CreatePolicy(ctx, Policy{Name: "cap", Rules: PolicyRules{MaxValue: "10"}}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	for _, callData := range []string{"0x", "0x1234", "0x87654321"} {
+		if err := sign(callData); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+			t.Fatalf("expected undecoded callData %s to be rejected, got %v", callData, err)
+		}
+	}
+}
+
+func TestSignUserOperationChecksEachCallAtItsOwnValue(t *testing.T) {
+	repo := newStubRepo()
+	evaluator := &stubEvaluator{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, &stubLedger{}, WithExpressionEvaluator(evaluator))
+	svc := NewWalletService(repo, &stubSigner{}, WithBundler(&stubBundler{}, stubBundlerRegistry{}), WithPolicies(policies))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if _, err := NewPolicyService(policies, repo, familyRegistry{}).CreatePolicy(ctx, Policy{Name: "per-call", Expression: "value <= 100"}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	dests := []string{"0x" + strings.Repeat("c", 40), "0x" + strings.Repeat("d", 40)}
+	op := UserOperation{Sender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0", CallData: executeBatchCalldata(dests, []int64{60, 70}, []string{"0x", "0x"})}
+	if _, err := svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{UserOperation: op}); err != nil {
+		t.Fatalf("expected calls of 60 and 70 to pass a per-call cap of 100, got %v", err)
+	}
+	if len(evaluator.inputs) != 2 || evaluator.inputs[0].Value.Int64() != 60 || evaluator.inputs[1].Value.Int64() != 70 {
+		t.Fatalf("expected each call to be evaluated at its own value, got %+v", evaluator.inputs)
+	}
+}
+
+func TestSignUserOperationEnforcesPolicies(t *testing.T) {
+	repo := newStubRepo()
+	ledger := &stubLedger{}
+	policies := NewPolicyEngine(&stubPolicyRepo{}, ledger)
+	bundler := &stubBundler{}
+	svc := NewWalletService(repo, &stubSigner{},
+		WithBundler(bundler, stubBundlerRegistry{}),
+		WithPolicies(policies),
+		WithApprovals(newStubApprovalRepo()),
+	)
+	ctx := actorContext("alice")
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	policyService := NewPolicyService(policies, repo, familyRegistry{})
+	if _, err := policyService.CreatePolicy(ctx, Policy{
+		Name:  "limits",
+		Rules: PolicyRules{MaxValue: "10", DeniedDestinations: []string{"0x" + strings.Repeat("d", 40)}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+
+	sign := func(dests []string, values []int64) (*UserOperationResult, error) {
+		funcs := make([]string, len(dests))
+		for i := range funcs {
+			funcs[i] = "0x"
+		}
+		op := UserOperation{Sender: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0", CallData: executeBatchCalldata(dests, values, funcs)}
+		return svc.SignUserOperation(ctx, wallet.ID, UserOperationRequest{UserOperation: op, Submit: true})
+	}
+
+	var violation *PolicyViolation
+	if _, err := sign([]string{"0x" + strings.Repeat("c", 40), "0x" + strings.Repeat("d", 40)}, []int64{1, 1}); !errors.As(err, &violation) || violation.Rule != PolicyRuleDeniedDestinations {
+		t.Fatalf("expected the denied call to be caught, got %v", err)
+	}
+	if _, err := sign([]string{"0x" + strings.Repeat("c", 40), "0x" + strings.Repeat("e", 40)}, []int64{6, 6}); !errors.As(err, &violation) || violation.Rule != PolicyRuleMaxValue {
+		t.Fatalf("expected the batch total to exceed the cap, got %v", err)
+	}
+	if _, err := sign([]string{"0x" + strings.Repeat("c", 40), "0x" + strings.Repeat("e", 40)}, []int64{4, 4}); err != nil {
+		t.Fatalf("SignUserOperation returned error: %v", err)
+	}
+	if len(ledger.entries) != 1 || ledger.entries[0].Amount.Int64() != 8 {
+		t.Fatalf("expected the batch total to be recorded, got %+v", ledger.entries)
+	}
+
+	if _, err := policyService.CreatePolicy(ctx, Policy{
+		Name:  "treasury",
+		Rules: PolicyRules{Quorum: &QuorumRule{Required: 1, Approvers: []string{"apikey:alice", "apikey:bob"}, TTL: Duration(time.Hour)}},
+	}); err != nil {
+		t.Fatalf("CreatePolicy returned error: %v", err)
+	}
+	bundler.sent = nil
+	_, err = sign([]string{"0x" + strings.Repeat("c", 40)}, []int64{3})
+	var pending *ApprovalPendingError
+	if !errors.As(err, &pending) || pending.Request.UserOperation == nil || bundler.sent != nil {
+		t.Fatalf("expected the operation to be queued, got %v", err)
+	}
+	if !pending.Request.Broadcast || pending.Request.Transaction.To != "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" || pending.Request.Transaction.Value != "0x3" {
+		t.Fatalf("unexpected request %+v", pending.Request)
+	}
+
+	approved, err := svc.ApproveRequest(actorContext("bob"), pending.Request.ID, "")
+	if err != nil {
+		t.Fatalf("ApproveRequest returned error: %v", err)
+	}
+	result := approved.UserOperationResult
+	if approved.Status != ApprovalStatusApproved || result == nil || result.UserOperation.Signature != "signature" || !result.Submitted || bundler.sent == nil {
+		t.Fatalf("expected the approved operation to be signed and submitted, got %+v", approved)
+	}
+	if len(ledger.entries) != 2 || ledger.entries[1].Amount.Int64() != 3 {
+		t.Fatalf("expected the approved spend to be recorded, got %+v", ledger.entries)
+	}
+}
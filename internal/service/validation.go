@@ -6,7 +6,18 @@ import (
 	"strings"
 )
 
-var addressPattern = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+var (
+	addressPattern = regexp.MustCompile(`^(0x)?[0-9a-fA-F]{40}$`)
+	hexDataPattern = regexp.MustCompile(`^0x([0-9a-fA-F]{2})*$`)
+	// signatureWordPattern matches an r or s value of up to 32 bytes.
+	signatureWordPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{1,64}$`)
+	// bitcoinAddressPattern matches bech32 segwit addresses and base58
+	// legacy ones on mainnet, testnet, signet and regtest.
+	bitcoinAddressPattern = regexp.MustCompile(`^((?i)(bc|tb|bcrt)1[02-9ac-hj-np-z]{8,87}|[123mn][1-9A-HJ-NP-Za-km-z]{25,34})$`)
+	// bech32AddressPattern matches the bech32 segwit addresses among them,
+	// which unlike base58 ones are case-insensitive.
+	bech32AddressPattern = regexp.MustCompile(`^(?i)(bc|tb|bcrt)1[02-9ac-hj-np-z]{8,87}$`)
+)
 
 func ValidateTransaction(tx *Transaction) error {
 	if tx == nil {
@@ -24,8 +35,37 @@ func ValidateTransaction(tx *Transaction) error {
 	if strings.TrimSpace(tx.GasPrice) == "" {
 		return fmt.Errorf("%w: gasPrice required", ErrValidation)
 	}
-	if tx.Nonce == 0 {
+	if tx.Nonce == 0 && !tx.nonceResolved {
 		return fmt.Errorf("%w: nonce required", ErrValidation)
 	}
+	if err := validatePriorityFee(tx); err != nil {
+		return err
+	}
+	if err := validateAuthorizationList(tx); err != nil {
+		return err
+	}
+	return validateBlobs(tx)
+}
+
+// validatePriorityFee checks the tip of set-code and blob transactions,
+// whose GasPrice is the max fee per gas.
+func validatePriorityFee(tx *Transaction) error {
+	if strings.TrimSpace(tx.MaxPriorityFeePerGas) == "" {
+		return nil
+	}
+	if len(tx.AuthorizationList) == 0 && len(tx.Blobs) == 0 {
+		return fmt.Errorf("%w: maxPriorityFeePerGas only applies to set-code and blob transactions", ErrValidation)
+	}
+	feeCap, err := parseHexQuantity(tx.GasPrice)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	tip, err := parseHexQuantity(tx.MaxPriorityFeePerGas)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrValidation, err)
+	}
+	if tip.Cmp(feeCap) > 0 {
+		return fmt.Errorf("%w: maxPriorityFeePerGas exceeds gasPrice", ErrValidation)
+	}
 	return nil
 }
@@ -5,125 +5,307 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/rickyreddygari/walletsdk/internal/config"
 )
 
+// WalletRepository stores wallet records. Reads are scoped to a tenant: a
+// record owned by another tenant behaves as if it does not exist.
 type WalletRepository interface {
 	Create(ctx context.Context, wallet WalletRecord) (*WalletRecord, error)
-	GetByID(ctx context.Context, id string) (*WalletRecord, error)
-	ListByNetwork(ctx context.Context, network string) ([]WalletRecord, error)
+	GetByID(ctx context.Context, tenantID string, id string) (*WalletRecord, error)
+	ListByNetwork(ctx context.Context, tenantID string, network string) ([]WalletRecord, error)
+	Update(ctx context.Context, wallet WalletRecord) (*WalletRecord, error)
+	// ListAll returns records across every tenant, for maintenance jobs only.
+	ListAll(ctx context.Context) ([]WalletRecord, error)
 }
 
 type Signer interface {
 	NewWallet(network string) (*WalletRecord, error)
 	SignMessage(network string, privKeyHex string, payload []byte) (*SignatureOutput, error)
 	SignTransaction(tx *Transaction, privKeyHex string) (string, error)
+	// SignTypedData signs the EIP-712 hash of data.
+	SignTypedData(network string, privKeyHex string, data *TypedData) (*SignatureOutput, error)
+	// SignAuthorization signs an EIP-7702 authorization tuple.
+	SignAuthorization(privKeyHex string, auth *Authorization) (*Authorization, error)
 }
 
 type WalletRecord struct {
-	ID        string
-	Network   string
-	Address   string
-	PublicKey string
-	PrivKey   string
-	CreatedAt time.Time
+	ID             string
+	TenantID       string
+	Network        string
+	Address        string
+	PublicKey      string
+	PrivKey        string
+	Status         WalletStatus
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeleteAfter    *time.Time
+	KeyDestroyedAt *time.Time
+	AccountType    AccountType
+	// SmartAccount is set for smart account wallets, whose Address is the
+	// account and whose key belongs to SmartAccount.Owner.
+	SmartAccount *SmartAccount
+	// Family is the chain family of Network, which decides the key type and
+	// address format.
+	Family ChainFamily
+	// AddressType is the script the address pays to, on families that have
+	// more than one.
+	AddressType AddressType
 }
 
 type walletService struct {
 	repo   WalletRepository
 	signer Signer
+	audit  AuditLog
+
+	tenants  TenantRepository
+	createMu sync.Mutex
+
+	policies *PolicyEngine
+	// spendMu serialises policy checks with spend recording so concurrent
+	// requests cannot both fit under a rolling-window limit.
+	spendMu sync.Mutex
+
+	approvals   ApprovalRepository
+	approvalMu  sync.Mutex
+	broadcaster Broadcaster
+	registry    NetworkRegistry
+	simulator   Simulator
+	chain       TokenChain
+	permits     PermitBuilder
+	bundler     Bundler
+	accounts    SmartAccountBuilder
+
+	families map[ChainFamily]FamilySigner
+
+	safes         SafeClient
+	safeProposals SafeProposalRepository
+	// safeMu serialises signatures on Safe proposals so two owners signing
+	// at once do not overwrite each other.
+	safeMu sync.Mutex
+
+	simulateBeforeSigning bool
+
+	// lifecycleMu serialises status changes and key purges so a purge cannot
+	// remove the key of a wallet that is being restored at the same time.
+	lifecycleMu         sync.Mutex
+	deletionGracePeriod time.Duration
+	now                 func() time.Time
+}
+
+// WalletServiceOption customises a wallet service at construction time.
+type WalletServiceOption func(*walletService)
+
+// WithDeletionGracePeriod sets how long a deleted wallet keeps its key
+// material before PurgeDeletedWallets removes it from the wallet record.
+func WithDeletionGracePeriod(d time.Duration) WalletServiceOption {
+	return func(s *walletService) {
+		s.deletionGracePeriod = d
+	}
+}
+
+// WithAuditLog records every signing attempt in the given log.
+func WithAuditLog(log AuditLog) WalletServiceOption {
+	return func(s *walletService) {
+		s.audit = log
+	}
 }
 
-func NewWalletService(repo WalletRepository, signer Signer) WalletService {
-	return &walletService{repo: repo, signer: signer}
+// WithTenants enforces each tenant's network allowlist and wallet quota when
+// wallets are created.
+func WithTenants(tenants TenantRepository) WalletServiceOption {
+	return func(s *walletService) {
+		s.tenants = tenants
+	}
+}
+
+// WithClock overrides the time source, mainly for tests.
+func WithClock(now func() time.Time) WalletServiceOption {
+	return func(s *walletService) {
+		s.now = now
+	}
+}
+
+func NewWalletService(repo WalletRepository, signer Signer, opts ...WalletServiceOption) WalletService {
+	svc := &walletService{
+		repo:                repo,
+		signer:              signer,
+		audit:               noopAuditLog{},
+		deletionGracePeriod: config.DefaultDeletionGracePeriod,
+		now:                 func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
 }
 
 type WalletService interface {
-	CreateWallet(ctx context.Context, network string) (*Wallet, error)
+	CreateWallet(ctx context.Context, network string, opts ...CreateWalletOption) (*Wallet, error)
 	GetWallet(ctx context.Context, id string) (*Wallet, error)
 	ListWallets(ctx context.Context, network string) ([]Wallet, error)
 	SignMessage(ctx context.Context, walletID string, payload []byte) (*SignatureOutput, error)
-	SignTransaction(ctx context.Context, walletID string, tx *Transaction) (string, error)
+	SignTransaction(ctx context.Context, walletID string, tx *Transaction, opts ...SignOption) (string, error)
+	SimulateTransaction(ctx context.Context, walletID string, tx *Transaction) (*SimulationResult, error)
+	TransferToken(ctx context.Context, walletID string, req TokenTransferRequest) (*TokenTxResult, error)
+	ApproveToken(ctx context.Context, walletID string, req TokenApprovalRequest) (*TokenTxResult, error)
+	SignPermit(ctx context.Context, walletID string, req PermitRequest) (*PermitResult, error)
+	SignUserOperation(ctx context.Context, walletID string, req UserOperationRequest) (*UserOperationResult, error)
+	SignAuthorization(ctx context.Context, walletID string, req AuthorizationRequest) (*Authorization, error)
+	SignSerializedTransaction(ctx context.Context, walletID string, req SerializedTransactionRequest) (*SerializedTransactionResult, error)
+	SignPSBT(ctx context.Context, walletID string, req PSBTRequest) (*PSBTResult, error)
+	ProposeSafeTransaction(ctx context.Context, req SafeProposalRequest) (*SafeProposal, error)
+	GetSafeProposal(ctx context.Context, id string) (*SafeProposal, error)
+	ListSafeProposals(ctx context.Context, status SafeProposalStatus) ([]SafeProposal, error)
+	SignSafeProposal(ctx context.Context, proposalID string, walletID string) (*SafeProposal, error)
+	ExecuteSafeProposal(ctx context.Context, proposalID string, walletID string, req SafeExecuteRequest) (*SafeProposal, error)
+	DisableWallet(ctx context.Context, id string) (*Wallet, error)
+	EnableWallet(ctx context.Context, id string) (*Wallet, error)
+	ArchiveWallet(ctx context.Context, id string) (*Wallet, error)
+	DeleteWallet(ctx context.Context, id string) (*Wallet, error)
+	PurgeDeletedWallets(ctx context.Context) (int, error)
+	ListApprovals(ctx context.Context, status ApprovalStatus) ([]ApprovalRequest, error)
+	GetApproval(ctx context.Context, id string) (*ApprovalRequest, error)
+	ApproveRequest(ctx context.Context, id string, comment string) (*ApprovalRequest, error)
+	RejectRequest(ctx context.Context, id string, comment string) (*ApprovalRequest, error)
+	ExpireApprovals(ctx context.Context) (int, error)
 }
 
-func (s *walletService) CreateWallet(ctx context.Context, network string) (*Wallet, error) {
+func (s *walletService) CreateWallet(ctx context.Context, network string, opts ...CreateWalletOption) (*Wallet, error) {
 	network = strings.TrimSpace(network)
 	if network == "" {
 		return nil, fmt.Errorf("%w: network is required", ErrValidation)
 	}
+	options := createWalletOptions{accountType: AccountTypeEOA}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.accountType != AccountTypeEOA && options.accountType != AccountTypeSmart {
+		return nil, fmt.Errorf("%w: unknown account type %q", ErrValidation, options.accountType)
+	}
+
+	if walletRestricted(ctx) {
+		return nil, fmt.Errorf("%w: caller is limited to assigned wallets", ErrForbidden)
+	}
+
+	tenantID := TenantFromContext(ctx)
+
+	s.createMu.Lock()
+	defer s.createMu.Unlock()
+
+	if err := s.checkTenantLimits(ctx, tenantID, network); err != nil {
+		return nil, err
+	}
+
+	family, err := s.networkFamily(network)
+	if err != nil {
+		return nil, err
+	}
+	if family != ChainFamilyEthereum && options.accountType == AccountTypeSmart {
+		return nil, fmt.Errorf("%w: smart accounts are only available on ethereum networks", ErrValidation)
+	}
+	if family == ChainFamilyEthereum && options.addressType != "" {
+		return nil, fmt.Errorf("%w: address types are not available on ethereum networks", ErrValidation)
+	}
 
-	record, err := s.signer.NewWallet(network)
+	record, err := s.newWallet(family, network, options.addressType)
 	if err != nil {
 		return nil, fmt.Errorf("generate wallet: %w", err)
 	}
 
+	record.Family = family
+	record.AccountType = AccountTypeEOA
+	if options.accountType == AccountTypeSmart {
+		if err := s.deriveSmartAccount(ctx, record, options); err != nil {
+			return nil, err
+		}
+	}
+
 	record.ID = uuid.NewString()
-	record.CreatedAt = time.Now().UTC()
+	record.TenantID = tenantID
+	record.Status = WalletStatusActive
+	record.CreatedAt = s.now()
+	record.UpdatedAt = record.CreatedAt
 
 	stored, err := s.repo.Create(ctx, *record)
 	if err != nil {
 		return nil, fmt.Errorf("store wallet: %w", err)
 	}
 
-	return &Wallet{
-		ID:        stored.ID,
-		Network:   stored.Network,
-		Address:   stored.Address,
-		PublicKey: stored.PublicKey,
-		CreatedAt: stored.CreatedAt,
-	}, nil
+	return toWallet(stored), nil
 }
 
 func (s *walletService) GetWallet(ctx context.Context, id string) (*Wallet, error) {
-	record, err := s.repo.GetByID(ctx, id)
+	record, err := s.load(ctx, id)
 	if err != nil {
-		if errors.Is(err, ErrNotFound) {
-			return nil, ErrNotFound
-		}
-		return nil, fmt.Errorf("get wallet: %w", err)
+		return nil, err
 	}
 
-	return &Wallet{
-		ID:        record.ID,
-		Network:   record.Network,
-		Address:   record.Address,
-		PublicKey: record.PublicKey,
-		CreatedAt: record.CreatedAt,
-	}, nil
+	return toWallet(record), nil
 }
 
 func (s *walletService) ListWallets(ctx context.Context, network string) ([]Wallet, error) {
-	records, err := s.repo.ListByNetwork(ctx, network)
+	records, err := s.repo.ListByNetwork(ctx, TenantFromContext(ctx), network)
 	if err != nil {
 		return nil, fmt.Errorf("list wallets: %w", err)
 	}
 
 	wallets := make([]Wallet, 0, len(records))
-	for _, record := range records {
-		wallets = append(wallets, Wallet{
-			ID:        record.ID,
-			Network:   record.Network,
-			Address:   record.Address,
-			PublicKey: record.PublicKey,
-			CreatedAt: record.CreatedAt,
-		})
+	for i := range records {
+		if !walletVisible(ctx, records[i].ID) {
+			continue
+		}
+		wallets = append(wallets, *toWallet(&records[i]))
 	}
 
 	return wallets, nil
 }
 
 func (s *walletService) SignMessage(ctx context.Context, walletID string, payload []byte) (*SignatureOutput, error) {
-	record, err := s.repo.GetByID(ctx, walletID)
+	signature, err := s.signMessage(ctx, walletID, payload)
+
+	var result string
+	if signature != nil {
+		result = signature.Signature
+	}
+	if auditErr := s.record(ctx, walletID, AuditOperationSignMessage, hashPayload(payload), result, err); auditErr != nil {
+		return nil, auditErr
+	}
+	return signature, err
+}
+
+func (s *walletService) signMessage(ctx context.Context, walletID string, payload []byte) (*SignatureOutput, error) {
+	record, err := s.load(ctx, walletID)
 	if err != nil {
-		if errors.Is(err, ErrNotFound) {
-			return nil, ErrNotFound
+		return nil, err
+	}
+	if record.Status != WalletStatusActive {
+		return nil, ErrWalletInactive
+	}
+	// The owner key authorises a smart account's user operations with a
+	// personal_sign over their hash, so a message signature could stand in
+	// for an operation that policies never saw.
+	if record.SmartAccount != nil && s.policies != nil {
+		if err := s.policies.CheckUndecoded(ctx, record, "messages from smart account wallets"); err != nil {
+			return nil, err
 		}
-		return nil, fmt.Errorf("get wallet: %w", err)
 	}
 
-	signature, err := s.signer.SignMessage(record.Network, record.PrivKey, payload)
+	var signature *SignatureOutput
+	if requireEthereum(record) == nil {
+		signature, err = s.signer.SignMessage(record.Network, record.PrivKey, payload)
+	} else {
+		var signer FamilySigner
+		if signer, err = s.familySigner(record.Family); err != nil {
+			return nil, err
+		}
+		signature, err = signer.SignMessage(record, payload)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("sign payload: %w", err)
 	}
@@ -131,26 +313,85 @@ func (s *walletService) SignMessage(ctx context.Context, walletID string, payloa
 	return signature, nil
 }
 
-func (s *walletService) SignTransaction(ctx context.Context, walletID string, tx *Transaction) (string, error) {
-	record, err := s.repo.GetByID(ctx, walletID)
+func (s *walletService) SignTransaction(ctx context.Context, walletID string, tx *Transaction, opts ...SignOption) (string, error) {
+	var options signOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	signed, err := s.signTransaction(ctx, walletID, tx, options)
+
+	if auditErr := s.record(ctx, walletID, AuditOperationSignTransaction, hashTransactionRequest(tx), transactionHash(signed), err); auditErr != nil {
+		return "", auditErr
+	}
+	return signed, err
+}
+
+func (s *walletService) signTransaction(ctx context.Context, walletID string, tx *Transaction, opts signOptions) (string, error) {
+	record, err := s.load(ctx, walletID)
 	if err != nil {
-		if errors.Is(err, ErrNotFound) {
-			return "", ErrNotFound
-		}
-		return "", fmt.Errorf("get wallet: %w", err)
+		return "", err
+	}
+	if record.Status != WalletStatusActive {
+		return "", ErrWalletInactive
+	}
+	if err := requireEthereum(record); err != nil {
+		return "", err
+	}
+	if record.SmartAccount != nil {
+		return "", errSmartAccountTransaction
 	}
 
 	if err := ValidateTransaction(tx); err != nil {
 		return "", err
 	}
+	if err := s.checkSimulation(ctx, record, tx, opts); err != nil {
+		return "", err
+	}
 
-	signed, err := s.signer.SignTransaction(tx, record.PrivKey)
+	s.spendMu.Lock()
+	defer s.spendMu.Unlock()
+
+	value, quorum, err := s.checkPolicies(ctx, record, tx)
 	if err != nil {
-		if errors.Is(err, ErrNotImplemented) {
-			return "", ErrNotImplemented
+		return "", err
+	}
+	if quorum != nil {
+		return "", s.queueForApproval(ctx, record, tx, quorum)
+	}
+
+	return s.signAndRecordSpend(ctx, record, tx, value)
+}
+
+// load fetches a wallet in the caller's tenant that the caller may act on.
+func (s *walletService) load(ctx context.Context, id string) (*WalletRecord, error) {
+	record, err := s.repo.GetByID(ctx, TenantFromContext(ctx), id)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, ErrNotFound
 		}
-		return "", fmt.Errorf("sign transaction: %w", err)
+		return nil, fmt.Errorf("get wallet: %w", err)
+	}
+	if !walletVisible(ctx, record.ID) {
+		return nil, ErrNotFound
 	}
+	return record, nil
+}
 
-	return signed, nil
+func toWallet(record *WalletRecord) *Wallet {
+	return &Wallet{
+		ID:             record.ID,
+		TenantID:       record.TenantID,
+		Network:        record.Network,
+		Address:        record.Address,
+		PublicKey:      record.PublicKey,
+		Status:         record.Status,
+		CreatedAt:      record.CreatedAt,
+		UpdatedAt:      record.UpdatedAt,
+		DeleteAfter:    record.DeleteAfter,
+		KeyDestroyedAt: record.KeyDestroyedAt,
+		AccountType:    record.AccountType,
+		Family:         record.Family,
+		AddressType:    record.AddressType,
+		SmartAccount:   record.SmartAccount,
+	}
 }
@@ -27,12 +27,12 @@ func (r *stubRepo) Create(_ context.Context, wallet WalletRecord) (*WalletRecord
 	return &copy, nil
 }
 
-func (r *stubRepo) GetByID(_ context.Context, id string) (*WalletRecord, error) {
+func (r *stubRepo) GetByID(_ context.Context, tenantID string, id string) (*WalletRecord, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	wallet, ok := r.wallets[id]
-	if !ok {
+	if !ok || wallet.TenantID != tenantID {
 		return nil, ErrNotFound
 	}
 
@@ -40,12 +40,15 @@ func (r *stubRepo) GetByID(_ context.Context, id string) (*WalletRecord, error)
 	return &copy, nil
 }
 
-func (r *stubRepo) ListByNetwork(_ context.Context, network string) ([]WalletRecord, error) {
+func (r *stubRepo) ListByNetwork(_ context.Context, tenantID string, network string) ([]WalletRecord, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	items := make([]WalletRecord, 0)
 	for _, wallet := range r.wallets {
+		if wallet.TenantID != tenantID {
+			continue
+		}
 		if network != "" && wallet.Network != network {
 			continue
 		}
@@ -54,6 +57,29 @@ func (r *stubRepo) ListByNetwork(_ context.Context, network string) ([]WalletRec
 	return items, nil
 }
 
+func (r *stubRepo) Update(_ context.Context, wallet WalletRecord) (*WalletRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.wallets[wallet.ID]; !ok {
+		return nil, ErrNotFound
+	}
+	copy := wallet
+	r.wallets[wallet.ID] = copy
+	return &copy, nil
+}
+
+func (r *stubRepo) ListAll(_ context.Context) ([]WalletRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	items := make([]WalletRecord, 0, len(r.wallets))
+	for _, wallet := range r.wallets {
+		items = append(items, wallet)
+	}
+	return items, nil
+}
+
 type stubSigner struct {
 	newWalletErr    error
 	signMessageErr  error
@@ -62,6 +88,8 @@ type stubSigner struct {
 	lastNetwork string
 	lastPayload []byte
 	lastTx      *Transaction
+
+	lastTypedData *TypedData
 }
 
 func (s *stubSigner) NewWallet(network string) (*WalletRecord, error) {
@@ -86,6 +114,25 @@ func (s *stubSigner) SignMessage(network string, privKeyHex string, payload []by
 	return &SignatureOutput{Signature: "signature", PublicKey: "pub-key"}, nil
 }
 
+func (s *stubSigner) SignTypedData(network string, privKeyHex string, data *TypedData) (*SignatureOutput, error) {
+	s.lastNetwork = network
+	s.lastTypedData = data
+	if s.signMessageErr != nil {
+		return nil, s.signMessageErr
+	}
+	return &SignatureOutput{Signature: "0x" + strings.Repeat("11", 32) + strings.Repeat("22", 32) + "1b", PublicKey: "pub-key"}, nil
+}
+
+func (s *stubSigner) SignAuthorization(privKeyHex string, auth *Authorization) (*Authorization, error) {
+	if s.signMessageErr != nil {
+		return nil, s.signMessageErr
+	}
+	signed := *auth
+	signed.R = "0x" + strings.Repeat("11", 32)
+	signed.S = "0x" + strings.Repeat("22", 32)
+	return &signed, nil
+}
+
 func (s *stubSigner) SignTransaction(tx *Transaction, privKeyHex string) (string, error) {
 	s.lastTx = tx
 	if s.signTransaction != nil {
@@ -121,7 +168,7 @@ func TestCreateWalletPersistsRecord(t *testing.T) {
 		t.Fatal("expected wallet CreatedAt to be set")
 	}
 
-	stored, err := repo.GetByID(context.Background(), wallet.ID)
+	stored, err := repo.GetByID(context.Background(), DefaultTenantID, wallet.ID)
 	if err != nil {
 		t.Fatalf("GetByID returned error: %v", err)
 	}
@@ -314,3 +361,95 @@ func TestGetWalletSetsTimestamps(t *testing.T) {
 		t.Fatalf("expected recent CreatedAt timestamp, got %v", fetched.CreatedAt)
 	}
 }
+
+func TestDisabledWalletRefusesSigning(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	svc := NewWalletService(repo, signer)
+
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	if wallet.Status != WalletStatusActive {
+		t.Fatalf("expected active wallet, got %s", wallet.Status)
+	}
+
+	disabled, err := svc.DisableWallet(context.Background(), wallet.ID)
+	if err != nil {
+		t.Fatalf("DisableWallet returned error: %v", err)
+	}
+	if disabled.Status != WalletStatusDisabled {
+		t.Fatalf("expected disabled wallet, got %s", disabled.Status)
+	}
+
+	if _, err := svc.SignMessage(context.Background(), wallet.ID, []byte("gm")); !errors.Is(err, ErrWalletInactive) {
+		t.Fatalf("expected ErrWalletInactive, got %v", err)
+	}
+	tx := &Transaction{
+		ChainID:  11155111,
+		To:       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		Value:    "0x1",
+		GasLimit: 21000,
+		GasPrice: "0x1",
+		Nonce:    1,
+	}
+	if _, err := svc.SignTransaction(context.Background(), wallet.ID, tx); !errors.Is(err, ErrWalletInactive) {
+		t.Fatalf("expected ErrWalletInactive, got %v", err)
+	}
+
+	if _, err := svc.EnableWallet(context.Background(), wallet.ID); err != nil {
+		t.Fatalf("EnableWallet returned error: %v", err)
+	}
+	if _, err := svc.SignMessage(context.Background(), wallet.ID, []byte("gm")); err != nil {
+		t.Fatalf("expected re-enabled wallet to sign, got %v", err)
+	}
+}
+
+func TestDeleteWalletRemovesKeyAfterGracePeriod(t *testing.T) {
+	repo := newStubRepo()
+	signer := &stubSigner{}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	svc := NewWalletService(repo, signer, WithDeletionGracePeriod(time.Hour), WithClock(func() time.Time { return now }))
+
+	wallet, err := svc.CreateWallet(context.Background(), "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+
+	deleted, err := svc.DeleteWallet(context.Background(), wallet.ID)
+	if err != nil {
+		t.Fatalf("DeleteWallet returned error: %v", err)
+	}
+	if deleted.Status != WalletStatusDeleted || deleted.DeleteAfter == nil {
+		t.Fatalf("expected deleted wallet with DeleteAfter, got %+v", deleted)
+	}
+
+	purged, err := svc.PurgeDeletedWallets(context.Background())
+	if err != nil {
+		t.Fatalf("PurgeDeletedWallets returned error: %v", err)
+	}
+	if purged != 0 {
+		t.Fatalf("expected no purge inside grace period, got %d", purged)
+	}
+
+	now = now.Add(2 * time.Hour)
+	purged, err = svc.PurgeDeletedWallets(context.Background())
+	if err != nil {
+		t.Fatalf("PurgeDeletedWallets returned error: %v", err)
+	}
+	if purged != 1 {
+		t.Fatalf("expected 1 purged wallet, got %d", purged)
+	}
+
+	stored, err := repo.GetByID(context.Background(), DefaultTenantID, wallet.ID)
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+	if stored.PrivKey != "" || stored.KeyDestroyedAt == nil {
+		t.Fatal("expected the key to be removed")
+	}
+	if _, err := svc.EnableWallet(context.Background(), wallet.ID); !errors.Is(err, ErrConflict) {
+		t.Fatalf("expected ErrConflict restoring destroyed wallet, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package file
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// AuditLog stores audit entries as JSON lines in an append-only file so the
+// chain survives restarts and can be verified offline.
+type AuditLog struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	head *servicepkg.AuditEntry
+}
+
+func OpenAuditLog(path string) (*AuditLog, error) {
+	entries, err := ReadAuditLog(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+
+	log := &AuditLog{path: path, file: f}
+	if n := len(entries); n > 0 {
+		head := entries[n-1]
+		log.head = &head
+	}
+	return log, nil
+}
+
+func (l *AuditLog) Append(_ context.Context, entry servicepkg.AuditEntry) (*servicepkg.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	sealed := servicepkg.ChainAuditEntry(l.head, entry)
+	line, err := json.Marshal(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("encode audit entry: %w", err)
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("write audit entry: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return nil, fmt.Errorf("sync audit log: %w", err)
+	}
+
+	l.head = &sealed
+	return &sealed, nil
+}
+
+func (l *AuditLog) Entries(_ context.Context) ([]servicepkg.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return ReadAuditLog(l.path)
+}
+
+func (l *AuditLog) Close() error {
+	return l.file.Close()
+}
+
+// ReadAuditLog loads every entry from an audit log file in order.
+func ReadAuditLog(path string) ([]servicepkg.AuditEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open audit log: %w", err)
+	}
+	defer f.Close()
+
+	entries := make([]servicepkg.AuditEntry, 0)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry servicepkg.AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("decode audit entry on line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,74 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// NetworkStore keeps runtime network changes in a JSON file. Saves replace
+// the file atomically so a crash leaves the previous state intact.
+type NetworkStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func NewNetworkStore(path string) *NetworkStore {
+	return &NetworkStore{path: path}
+}
+
+// Load returns the stored state, which is empty before the first save.
+func (s *NetworkStore) Load(_ context.Context) (*servicepkg.NetworkState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &servicepkg.NetworkState{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read network store: %w", err)
+	}
+	var state servicepkg.NetworkState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("decode network store: %w", err)
+	}
+	return &state, nil
+}
+
+func (s *NetworkStore) Save(_ context.Context, state servicepkg.NetworkState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode network store: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return fmt.Errorf("write network store: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write network store: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync network store: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("write network store: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace network store: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type APIKeyRepository struct {
+	mu     sync.RWMutex
+	keys   map[string]servicepkg.APIKey
+	byHash map[string]string
+}
+
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{
+		keys:   make(map[string]servicepkg.APIKey),
+		byHash: make(map[string]string),
+	}
+}
+
+func (r *APIKeyRepository) Create(_ context.Context, key servicepkg.APIKey) (*servicepkg.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.keys[key.ID]; exists {
+		return nil, errors.New("api key already exists")
+	}
+	if _, exists := r.byHash[key.Hash]; exists {
+		return nil, errors.New("api key already exists")
+	}
+
+	r.keys[key.ID] = key
+	r.byHash[key.Hash] = key.ID
+	copy := key
+	return &copy, nil
+}
+
+func (r *APIKeyRepository) GetByHash(_ context.Context, hash string) (*servicepkg.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.byHash[hash]
+	if !ok {
+		return nil, servicepkg.ErrNotFound
+	}
+	key := r.keys[id]
+	return &key, nil
+}
+
+func (r *APIKeyRepository) GetByID(_ context.Context, id string) (*servicepkg.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.keys[id]
+	if !ok {
+		return nil, servicepkg.ErrNotFound
+	}
+	return &key, nil
+}
+
+func (r *APIKeyRepository) List(_ context.Context) ([]servicepkg.APIKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.APIKey, 0, len(r.keys))
+	for _, key := range r.keys {
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *APIKeyRepository) Update(_ context.Context, key servicepkg.APIKey) (*servicepkg.APIKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.keys[key.ID]
+	if !ok {
+		return nil, servicepkg.ErrNotFound
+	}
+	delete(r.byHash, existing.Hash)
+	r.keys[key.ID] = key
+	r.byHash[key.Hash] = key.ID
+	copy := key
+	return &copy, nil
+}
@@ -0,0 +1,99 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type ApprovalRepository struct {
+	mu       sync.RWMutex
+	requests map[string]servicepkg.ApprovalRequest
+}
+
+func NewApprovalRepository() *ApprovalRepository {
+	return &ApprovalRepository{
+		requests: make(map[string]servicepkg.ApprovalRequest),
+	}
+}
+
+func (r *ApprovalRepository) Create(_ context.Context, request servicepkg.ApprovalRequest) (*servicepkg.ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.requests[request.ID]; exists {
+		return nil, errors.New("approval request already exists")
+	}
+
+	r.requests[request.ID] = cloneApproval(request)
+	copy := cloneApproval(request)
+	return &copy, nil
+}
+
+func (r *ApprovalRepository) GetByID(_ context.Context, tenantID string, id string) (*servicepkg.ApprovalRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	request, ok := r.requests[id]
+	if !ok || request.TenantID != tenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+	copy := cloneApproval(request)
+	return &copy, nil
+}
+
+func (r *ApprovalRepository) List(_ context.Context, tenantID string) ([]servicepkg.ApprovalRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.ApprovalRequest, 0)
+	for _, request := range r.requests {
+		if request.TenantID == tenantID {
+			result = append(result, cloneApproval(request))
+		}
+	}
+	sortApprovals(result)
+	return result, nil
+}
+
+func (r *ApprovalRepository) Update(_ context.Context, request servicepkg.ApprovalRequest) (*servicepkg.ApprovalRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.requests[request.ID]
+	if !exists || existing.TenantID != request.TenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+
+	r.requests[request.ID] = cloneApproval(request)
+	copy := cloneApproval(request)
+	return &copy, nil
+}
+
+func (r *ApprovalRepository) ListAll(_ context.Context) ([]servicepkg.ApprovalRequest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.ApprovalRequest, 0, len(r.requests))
+	for _, request := range r.requests {
+		result = append(result, cloneApproval(request))
+	}
+	sortApprovals(result)
+	return result, nil
+}
+
+// cloneApproval copies the slices so callers cannot mutate stored requests.
+func cloneApproval(request servicepkg.ApprovalRequest) servicepkg.ApprovalRequest {
+	request.Approvers = append([]string(nil), request.Approvers...)
+	request.Decisions = append([]servicepkg.ApprovalDecision{}, request.Decisions...)
+	return request
+}
+
+func sortApprovals(requests []servicepkg.ApprovalRequest) {
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].CreatedAt.Before(requests[j].CreatedAt)
+	})
+}
@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type AuditLog struct {
+	mu      sync.RWMutex
+	entries []servicepkg.AuditEntry
+}
+
+func NewAuditLog() *AuditLog {
+	return &AuditLog{}
+}
+
+func (l *AuditLog) Append(_ context.Context, entry servicepkg.AuditEntry) (*servicepkg.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var prev *servicepkg.AuditEntry
+	if n := len(l.entries); n > 0 {
+		prev = &l.entries[n-1]
+	}
+	sealed := servicepkg.ChainAuditEntry(prev, entry)
+	l.entries = append(l.entries, sealed)
+	return &sealed, nil
+}
+
+func (l *AuditLog) Entries(_ context.Context) ([]servicepkg.AuditEntry, error) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	result := make([]servicepkg.AuditEntry, len(l.entries))
+	copy(result, l.entries)
+	return result, nil
+}
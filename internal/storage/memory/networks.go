@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// NetworkStore keeps runtime network changes until the process exits.
+type NetworkStore struct {
+	mu    sync.RWMutex
+	state servicepkg.NetworkState
+}
+
+func NewNetworkStore() *NetworkStore {
+	return &NetworkStore{}
+}
+
+func (s *NetworkStore) Load(_ context.Context) (*servicepkg.NetworkState, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &servicepkg.NetworkState{
+		Added:    slices.Clone(s.state.Added),
+		Disabled: slices.Clone(s.state.Disabled),
+	}, nil
+}
+
+func (s *NetworkStore) Save(_ context.Context, state servicepkg.NetworkState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state = state
+	return nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// NonceStore keeps SIWE nonces until they are consumed. Expired nonces are
+// dropped whenever a new one is issued.
+type NonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]servicepkg.SIWENonce
+}
+
+func NewNonceStore() *NonceStore {
+	return &NonceStore{
+		nonces: make(map[string]servicepkg.SIWENonce),
+	}
+}
+
+func (s *NonceStore) Create(_ context.Context, nonce servicepkg.SIWENonce) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, existing := range s.nonces {
+		if !now.Before(existing.ExpiresAt) {
+			delete(s.nonces, key)
+		}
+	}
+	if _, exists := s.nonces[nonce.Nonce]; exists {
+		return errors.New("nonce already exists")
+	}
+	s.nonces[nonce.Nonce] = nonce
+	return nil
+}
+
+func (s *NonceStore) Consume(_ context.Context, tenantID string, nonce string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, ok := s.nonces[nonce]
+	if !ok || stored.TenantID != tenantID {
+		return servicepkg.ErrNotFound
+	}
+	delete(s.nonces, nonce)
+	if !now.Before(stored.ExpiresAt) {
+		return servicepkg.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"slices"
+	"sort"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type PolicyRepository struct {
+	mu       sync.RWMutex
+	policies map[string]servicepkg.Policy
+	versions map[string][]servicepkg.Policy
+}
+
+func NewPolicyRepository() *PolicyRepository {
+	return &PolicyRepository{
+		policies: make(map[string]servicepkg.Policy),
+		versions: make(map[string][]servicepkg.Policy),
+	}
+}
+
+func (r *PolicyRepository) Create(_ context.Context, policy servicepkg.Policy) (*servicepkg.Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.policies[policy.ID]; exists {
+		return nil, errors.New("policy already exists")
+	}
+
+	r.policies[policy.ID] = policy
+	r.versions[policy.ID] = []servicepkg.Policy{policy}
+	copy := policy
+	return &copy, nil
+}
+
+func (r *PolicyRepository) GetByID(_ context.Context, tenantID string, id string) (*servicepkg.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, ok := r.policies[id]
+	if !ok || policy.TenantID != tenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+	return &policy, nil
+}
+
+func (r *PolicyRepository) List(_ context.Context, tenantID string) ([]servicepkg.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.Policy, 0)
+	for _, policy := range r.policies {
+		if policy.TenantID == tenantID {
+			result = append(result, policy)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *PolicyRepository) Update(_ context.Context, policy servicepkg.Policy) (*servicepkg.Policy, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.policies[policy.ID]
+	if !exists || existing.TenantID != policy.TenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+
+	r.policies[policy.ID] = policy
+	r.versions[policy.ID] = append(r.versions[policy.ID], policy)
+	copy := policy
+	return &copy, nil
+}
+
+func (r *PolicyRepository) ListVersions(_ context.Context, tenantID string, id string) ([]servicepkg.Policy, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	policy, exists := r.policies[id]
+	if !exists || policy.TenantID != tenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+	return append([]servicepkg.Policy(nil), r.versions[id]...), nil
+}
+
+func (r *PolicyRepository) Delete(_ context.Context, tenantID string, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	policy, exists := r.policies[id]
+	if !exists || policy.TenantID != tenantID {
+		return servicepkg.ErrNotFound
+	}
+	delete(r.policies, id)
+	delete(r.versions, id)
+	return nil
+}
+
+// SpendLedger keeps signed transaction values in memory for rolling-window
+// policy checks. Entries older than the longest window are dropped as new
+// ones arrive.
+type SpendLedger struct {
+	mu      sync.Mutex
+	entries []servicepkg.SpendEntry
+}
+
+func NewSpendLedger() *SpendLedger {
+	return &SpendLedger{}
+}
+
+func (l *SpendLedger) Record(_ context.Context, entry servicepkg.SpendEntry) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	cutoff := entry.At.Add(-servicepkg.MaxSpendWindow)
+	l.entries = slices.DeleteFunc(l.entries, func(e servicepkg.SpendEntry) bool {
+		return e.At.Before(cutoff)
+	})
+
+	entry.Amount = new(big.Int).Set(entry.Amount)
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func (l *SpendLedger) Total(_ context.Context, query servicepkg.SpendQuery) (*big.Int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	total := new(big.Int)
+	for _, entry := range l.entries {
+		if query.Matches(entry) {
+			total.Add(total, entry.Amount)
+		}
+	}
+	return total, nil
+}
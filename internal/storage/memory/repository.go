@@ -32,23 +32,26 @@ func (r *WalletRepository) Create(_ context.Context, wallet servicepkg.WalletRec
 	return &copy, nil
 }
 
-func (r *WalletRepository) GetByID(_ context.Context, id string) (*servicepkg.WalletRecord, error) {
+func (r *WalletRepository) GetByID(_ context.Context, tenantID string, id string) (*servicepkg.WalletRecord, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	wallet, ok := r.wallets[id]
-	if !ok {
+	if !ok || wallet.TenantID != tenantID {
 		return nil, servicepkg.ErrNotFound
 	}
 	return &wallet, nil
 }
 
-func (r *WalletRepository) ListByNetwork(_ context.Context, network string) ([]servicepkg.WalletRecord, error) {
+func (r *WalletRepository) ListByNetwork(_ context.Context, tenantID string, network string) ([]servicepkg.WalletRecord, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	result := make([]servicepkg.WalletRecord, 0)
 	for _, wallet := range r.wallets {
+		if wallet.TenantID != tenantID {
+			continue
+		}
 		if network != "" && wallet.Network != network {
 			continue
 		}
@@ -56,3 +59,28 @@ func (r *WalletRepository) ListByNetwork(_ context.Context, network string) ([]s
 	}
 	return result, nil
 }
+
+func (r *WalletRepository) Update(_ context.Context, wallet servicepkg.WalletRecord) (*servicepkg.WalletRecord, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.wallets[wallet.ID]
+	if !exists || existing.TenantID != wallet.TenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+
+	r.wallets[wallet.ID] = wallet
+	copy := wallet
+	return &copy, nil
+}
+
+func (r *WalletRepository) ListAll(_ context.Context) ([]servicepkg.WalletRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.WalletRecord, 0, len(r.wallets))
+	for _, wallet := range r.wallets {
+		result = append(result, wallet)
+	}
+	return result, nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+func TestDeletedWalletKeyIsGoneFromTheRepository(t *testing.T) {
+	repo := NewWalletRepository()
+	svc := servicepkg.NewWalletService(repo, ethereum.NewSigner(), servicepkg.WithDeletionGracePeriod(0))
+	ctx := context.Background()
+
+	wallet, err := svc.CreateWallet(ctx, "eth-sepolia")
+	if err != nil {
+		t.Fatalf("CreateWallet returned error: %v", err)
+	}
+	created, err := repo.GetByID(ctx, servicepkg.DefaultTenantID, wallet.ID)
+	if err != nil || created.PrivKey == "" {
+		t.Fatalf("expected the new wallet to have a key, got %v", err)
+	}
+	if _, err := svc.DeleteWallet(ctx, wallet.ID); err != nil {
+		t.Fatalf("DeleteWallet returned error: %v", err)
+	}
+
+	stored, err := repo.GetByID(ctx, servicepkg.DefaultTenantID, wallet.ID)
+	if err != nil {
+		t.Fatalf("GetByID returned error: %v", err)
+	}
+	if stored.PrivKey != "" || stored.KeyDestroyedAt == nil {
+		t.Fatalf("expected the stored record to have no key, got %+v", stored)
+	}
+	all, err := repo.ListAll(ctx)
+	if err != nil {
+		t.Fatalf("ListAll returned error: %v", err)
+	}
+	for _, record := range all {
+		if record.PrivKey != "" {
+			t.Fatalf("expected no stored record to keep the key, got %+v", record)
+		}
+	}
+}
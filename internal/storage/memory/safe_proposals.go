@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type SafeProposalRepository struct {
+	mu        sync.RWMutex
+	proposals map[string]servicepkg.SafeProposal
+}
+
+func NewSafeProposalRepository() *SafeProposalRepository {
+	return &SafeProposalRepository{
+		proposals: make(map[string]servicepkg.SafeProposal),
+	}
+}
+
+func (r *SafeProposalRepository) Create(_ context.Context, proposal servicepkg.SafeProposal) (*servicepkg.SafeProposal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.proposals[proposal.ID]; exists {
+		return nil, errors.New("Safe proposal already exists")
+	}
+
+	r.proposals[proposal.ID] = cloneSafeProposal(proposal)
+	copy := cloneSafeProposal(proposal)
+	return &copy, nil
+}
+
+func (r *SafeProposalRepository) GetByID(_ context.Context, tenantID string, id string) (*servicepkg.SafeProposal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	proposal, ok := r.proposals[id]
+	if !ok || proposal.TenantID != tenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+	copy := cloneSafeProposal(proposal)
+	return &copy, nil
+}
+
+func (r *SafeProposalRepository) List(_ context.Context, tenantID string) ([]servicepkg.SafeProposal, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.SafeProposal, 0)
+	for _, proposal := range r.proposals {
+		if proposal.TenantID == tenantID {
+			result = append(result, cloneSafeProposal(proposal))
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *SafeProposalRepository) Update(_ context.Context, proposal servicepkg.SafeProposal) (*servicepkg.SafeProposal, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.proposals[proposal.ID]
+	if !exists || existing.TenantID != proposal.TenantID {
+		return nil, servicepkg.ErrNotFound
+	}
+
+	r.proposals[proposal.ID] = cloneSafeProposal(proposal)
+	copy := cloneSafeProposal(proposal)
+	return &copy, nil
+}
+
+// cloneSafeProposal copies the slices so callers cannot mutate stored
+// proposals. The typed data is never modified after a proposal is created.
+func cloneSafeProposal(proposal servicepkg.SafeProposal) servicepkg.SafeProposal {
+	proposal.Owners = append([]string(nil), proposal.Owners...)
+	proposal.Signatures = append([]servicepkg.SafeSignature{}, proposal.Signatures...)
+	return proposal
+}
@@ -0,0 +1,72 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	servicepkg "github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+type TenantRepository struct {
+	mu      sync.RWMutex
+	tenants map[string]servicepkg.Tenant
+}
+
+func NewTenantRepository() *TenantRepository {
+	return &TenantRepository{
+		tenants: make(map[string]servicepkg.Tenant),
+	}
+}
+
+func (r *TenantRepository) Create(_ context.Context, tenant servicepkg.Tenant) (*servicepkg.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tenants[tenant.ID]; exists {
+		return nil, errors.New("tenant already exists")
+	}
+
+	r.tenants[tenant.ID] = tenant
+	copy := tenant
+	return &copy, nil
+}
+
+func (r *TenantRepository) GetByID(_ context.Context, id string) (*servicepkg.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	tenant, ok := r.tenants[id]
+	if !ok {
+		return nil, servicepkg.ErrNotFound
+	}
+	return &tenant, nil
+}
+
+func (r *TenantRepository) List(_ context.Context) ([]servicepkg.Tenant, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]servicepkg.Tenant, 0, len(r.tenants))
+	for _, tenant := range r.tenants {
+		result = append(result, tenant)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+	return result, nil
+}
+
+func (r *TenantRepository) Update(_ context.Context, tenant servicepkg.Tenant) (*servicepkg.Tenant, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tenants[tenant.ID]; !exists {
+		return nil, servicepkg.ErrNotFound
+	}
+
+	r.tenants[tenant.ID] = tenant
+	copy := tenant
+	return &copy, nil
+}
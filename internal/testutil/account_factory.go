@@ -0,0 +1,82 @@
+package testutil
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// AccountFactoryStub is a local Ethereum RPC endpoint for a deployed account
+// factory. It answers getAddress(owner, salt) eth_calls with the CREATE2
+// address Factory derives, and reports every account as undeployed.
+type AccountFactoryStub struct {
+	*httptest.Server
+	Factory service.AccountFactory
+}
+
+// NewAccountFactoryStub starts a factory stub that is closed when the test
+// ends.
+func NewAccountFactoryStub(t *testing.T, factory service.AccountFactory) *AccountFactoryStub {
+	t.Helper()
+	stub := &AccountFactoryStub{Factory: factory}
+	stub.Server = httptest.NewServer(http.HandlerFunc(stub.serve))
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+func (f *AccountFactoryStub) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	rpcError := func(code int, message string) {
+		resp["error"] = map[string]interface{}{"code": code, "message": message}
+	}
+
+	switch req.Method {
+	case "eth_getCode":
+		resp["result"] = "0x"
+	case "eth_call":
+		var call struct {
+			Data  hexutil.Bytes `json:"data"`
+			Input hexutil.Bytes `json:"input"`
+		}
+		if len(req.Params) == 0 || json.Unmarshal(req.Params[0], &call) != nil {
+			rpcError(-32602, "invalid params")
+			break
+		}
+		data := call.Input
+		if len(data) == 0 {
+			data = call.Data
+		}
+		if len(data) != 4+64 {
+			rpcError(-32000, "execution reverted")
+			break
+		}
+		owner := common.BytesToAddress(data[4:36])
+		salt := new(big.Int).SetBytes(data[36:68])
+		address, err := ethereum.NewBundler().AccountAddress(f.Factory, owner.Hex(), salt)
+		if err != nil {
+			rpcError(-32000, err.Error())
+			break
+		}
+		resp["result"] = hexutil.Encode(common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32))
+	default:
+		rpcError(-32601, "method not found")
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
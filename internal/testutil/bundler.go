@@ -0,0 +1,97 @@
+package testutil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rickyreddygari/walletsdk/internal/blockchain/ethereum"
+	"github.com/rickyreddygari/walletsdk/internal/service"
+)
+
+// BundlerStub is a local ERC-4337 bundler. It accepts eth_sendUserOperation
+// for the canonical v0.6 and v0.7 EntryPoints, records each operation and
+// answers with the operation's hash for ChainID.
+type BundlerStub struct {
+	*httptest.Server
+	ChainID int64
+
+	mu         sync.Mutex
+	operations []service.UserOperation
+}
+
+// NewBundlerStub starts a bundler stub that is closed when the test ends.
+func NewBundlerStub(t *testing.T, chainID int64) *BundlerStub {
+	t.Helper()
+	stub := &BundlerStub{ChainID: chainID}
+	stub.Server = httptest.NewServer(http.HandlerFunc(stub.serve))
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+// Operations returns the user operations received so far.
+func (b *BundlerStub) Operations() []service.UserOperation {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]service.UserOperation(nil), b.operations...)
+}
+
+func (b *BundlerStub) serve(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+	rpcError := func(code int, message string) {
+		resp["error"] = map[string]interface{}{"code": code, "message": message}
+	}
+
+	switch req.Method {
+	case "eth_chainId":
+		resp["result"] = "0x" + strconv.FormatInt(b.ChainID, 16)
+	case "eth_supportedEntryPoints":
+		resp["result"] = []string{service.EntryPointV07Address, service.EntryPointV06Address}
+	case "eth_sendUserOperation":
+		var op service.UserOperation
+		var entryPoint string
+		if len(req.Params) != 2 || json.Unmarshal(req.Params[0], &op) != nil || json.Unmarshal(req.Params[1], &entryPoint) != nil {
+			rpcError(-32602, "invalid params")
+			break
+		}
+		version := service.EntryPointV07
+		switch {
+		case strings.EqualFold(entryPoint, service.EntryPointV06Address):
+			version = service.EntryPointV06
+		case !strings.EqualFold(entryPoint, service.EntryPointV07Address):
+			rpcError(-32602, "unsupported entry point")
+		}
+		if resp["error"] != nil {
+			break
+		}
+		if op.Signature == "" || op.Signature == "0x" {
+			rpcError(-32507, "missing signature")
+			break
+		}
+		hash, err := ethereum.NewBundler().HashUserOperation(&op, service.EntryPoint{Version: version, Address: entryPoint}, b.ChainID)
+		if err != nil {
+			rpcError(-32602, err.Error())
+			break
+		}
+		b.mu.Lock()
+		b.operations = append(b.operations, op)
+		b.mu.Unlock()
+		resp["result"] = hash
+	default:
+		rpcError(-32601, "method not found")
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
@@ -8,18 +8,31 @@ import (
 	"testing"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/rickyreddygari/walletsdk/internal/app"
 )
 
-func NewTestServer(t *testing.T) (*httptest.Server, *grpc.ClientConn, func()) {
+// AdminAPIKey is the bootstrap admin key registered by NewTestServer and
+// NewTestContainer.
+const AdminAPIKey = "test-admin-key"
+
+// NewTestContainer builds the application with AdminAPIKey registered.
+func NewTestContainer(t *testing.T) *app.Container {
 	t.Helper()
 
+	t.Setenv("BOOTSTRAP_ADMIN_KEY", AdminAPIKey)
 	container, err := app.NewContainer()
 	if err != nil {
 		t.Fatalf("bootstrap container: %v", err)
 	}
+	return container
+}
 
+func NewTestServer(t *testing.T) (*httptest.Server, *grpc.ClientConn, func()) {
+	t.Helper()
+
+	container := NewTestContainer(t)
 	server := httptest.NewServer(container.HTTPServer)
 
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
@@ -33,7 +46,7 @@ func NewTestServer(t *testing.T) (*httptest.Server, *grpc.ClientConn, func()) {
 		}
 	}()
 
-	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure(), grpc.WithUnaryInterceptor(bearerInterceptor(AdminAPIKey)))
 	if err != nil {
 		t.Fatalf("dial grpc: %v", err)
 	}
@@ -60,3 +73,10 @@ func MustDo(t *testing.T, client *http.Client, req *http.Request) *http.Response
 func Background() context.Context {
 	return context.Background()
 }
+
+func bearerInterceptor(token string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type ApprovalDecision struct {
+	Actor    string    `json:"actor"`
+	Approved bool      `json:"approved"`
+	Comment  string    `json:"comment,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// ApprovalRequest is a transaction waiting for a quorum of approvers.
+// SignedTransaction is set once the request is approved. A request whose
+// quorum was reached but whose transaction could not be signed is "failed",
+// and Error says why.
+type ApprovalRequest struct {
+	ID          string      `json:"id"`
+	TenantID    string      `json:"tenantId"`
+	WalletID    string      `json:"walletId"`
+	PolicyID    string      `json:"policyId"`
+	Requester   string      `json:"requester"`
+	Transaction Transaction `json:"transaction"`
+	// UserOperation is set for a queued user operation, and
+	// UserOperationResult once it has been signed.
+	UserOperation       *UserOperationRequest `json:"userOperation,omitempty"`
+	UserOperationResult *UserOperationResult  `json:"userOperationResult,omitempty"`
+	Required            int                   `json:"required"`
+	Approvers           []string              `json:"approvers"`
+	Broadcast           bool                  `json:"broadcast,omitempty"`
+	Decisions           []ApprovalDecision    `json:"decisions"`
+	Status              string                `json:"status"`
+	SignedTransaction   string                `json:"signedTransaction,omitempty"`
+	TxHash              string                `json:"txHash,omitempty"`
+	BroadcastError      string                `json:"broadcastError,omitempty"`
+	Error               string                `json:"error,omitempty"`
+	CreatedAt           time.Time             `json:"createdAt"`
+	UpdatedAt           time.Time             `json:"updatedAt"`
+	ExpiresAt           time.Time             `json:"expiresAt"`
+}
+
+// ApprovalPendingError is returned by SignTransaction when the transaction
+// was queued for approval rather than signed.
+type ApprovalPendingError struct {
+	Request *ApprovalRequest
+}
+
+func (e *ApprovalPendingError) Error() string {
+	return fmt.Sprintf("approval required: request %s needs %d approvals", e.Request.ID, e.Request.Required)
+}
+
+// ListApprovals returns approval requests, optionally filtered by status
+// ("pending", "approved", "rejected" or "expired").
+func (c *Client) ListApprovals(status string) ([]ApprovalRequest, error) {
+	endpoint := fmt.Sprintf("%s/v1/approvals", c.baseURL)
+	if status != "" {
+		endpoint += "?status=" + url.QueryEscape(status)
+	}
+
+	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var requests []ApprovalRequest
+	if err := json.NewDecoder(resp.Body).Decode(&requests); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return requests, nil
+}
+
+func (c *Client) GetApproval(id string) (*ApprovalRequest, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/approvals/%s", c.baseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var request ApprovalRequest
+	if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &request, nil
+}
+
+func (c *Client) ApproveRequest(id string, comment string) (*ApprovalRequest, error) {
+	return c.decideApproval(fmt.Sprintf("%s/v1/approvals/%s/approve", c.baseURL, id), comment)
+}
+
+func (c *Client) RejectRequest(id string, comment string) (*ApprovalRequest, error) {
+	return c.decideApproval(fmt.Sprintf("%s/v1/approvals/%s/reject", c.baseURL, id), comment)
+}
+
+func (c *Client) decideApproval(endpoint string, comment string) (*ApprovalRequest, error) {
+	payload, err := json.Marshal(map[string]string{"comment": comment})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var request ApprovalRequest
+	if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &request, nil
+}
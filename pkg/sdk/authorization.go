@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Authorization is a signed EIP-7702 tuple delegating an account's code to
+// Address. R and S are 0x hex words.
+type Authorization struct {
+	ChainID int64  `json:"chainId"`
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	YParity uint8  `json:"yParity"`
+	R       string `json:"r"`
+	S       string `json:"s"`
+}
+
+// AuthorizationRequest asks a wallet to delegate its code to Address.
+// ChainID defaults to the wallet's network and Nonce to the account's
+// pending nonce.
+type AuthorizationRequest struct {
+	Address string  `json:"address"`
+	ChainID int64   `json:"chainId,omitempty"`
+	Nonce   *uint64 `json:"nonce,omitempty"`
+}
+
+// SignAuthorization signs an EIP-7702 authorization with the wallet, for
+// use in the AuthorizationList of a set-code transaction.
+func (c *Client) SignAuthorization(walletID string, req AuthorizationRequest) (*Authorization, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var result Authorization
+	if err := c.decode(fmt.Sprintf("%s/v1/wallets/%s/authorization", c.baseURL, walletID), payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
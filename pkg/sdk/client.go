@@ -47,16 +47,45 @@ func WithAPIKey(key string) Option {
 	}
 }
 
+// CreateWalletRequest creates an EOA wallet, or with AccountType "smart" a
+// counterfactual smart account owned by a new key. Factory and Salt select
+// the account; Salt is a decimal or 0x-prefixed hex uint256. AddressType
+// is "p2wpkh" (the default) or "p2tr" on bitcoin networks.
 type CreateWalletRequest struct {
-	Network string `json:"network"`
+	Network     string `json:"network"`
+	AccountType string `json:"accountType,omitempty"`
+	Factory     string `json:"factory,omitempty"`
+	Salt        string `json:"salt,omitempty"`
+	AddressType string `json:"addressType,omitempty"`
 }
 
 type WalletResponse struct {
-	ID        string    `json:"id"`
-	Network   string    `json:"network"`
-	Address   string    `json:"address"`
-	PublicKey string    `json:"publicKey"`
-	CreatedAt time.Time `json:"createdAt"`
+	ID             string        `json:"id"`
+	TenantID       string        `json:"tenantId"`
+	Network        string        `json:"network"`
+	Address        string        `json:"address"`
+	PublicKey      string        `json:"publicKey"`
+	Status         string        `json:"status"`
+	CreatedAt      time.Time     `json:"createdAt"`
+	UpdatedAt      time.Time     `json:"updatedAt"`
+	DeleteAfter    *time.Time    `json:"deleteAfter,omitempty"`
+	KeyDestroyedAt *time.Time    `json:"keyDestroyedAt,omitempty"`
+	AccountType    string        `json:"accountType"`
+	SmartAccount   *SmartAccount `json:"smartAccount,omitempty"`
+	// Family is the chain family of the wallet's network, such as
+	// "ethereum", "solana" or "bitcoin".
+	Family      string `json:"family"`
+	AddressType string `json:"addressType,omitempty"`
+}
+
+// SmartAccount describes the account behind a smart account wallet. Deployed
+// turns true once a user operation has deployed it.
+type SmartAccount struct {
+	Owner      string `json:"owner"`
+	Factory    string `json:"factory"`
+	Salt       string `json:"salt"`
+	EntryPoint string `json:"entryPoint"`
+	Deployed   bool   `json:"deployed"`
 }
 
 func (c *Client) CreateWallet(req CreateWalletRequest) (*WalletResponse, error) {
@@ -112,15 +141,23 @@ type SignMessageRequest struct {
 	Message string `json:"message"`
 }
 
+// Transaction is a transaction to sign. Setting AuthorizationList makes it
+// an EIP-7702 set-code transaction and setting Blobs an EIP-4844 blob
+// transaction; for both, GasPrice is the max fee per gas and
+// MaxPriorityFeePerGas the tip. Blobs are 0x hex of at most 131072 bytes.
 type Transaction struct {
-	ChainID  int64  `json:"chainId"`
-	From     string `json:"from,omitempty"`
-	To       string `json:"to"`
-	Value    string `json:"value"`
-	Data     string `json:"data,omitempty"`
-	GasLimit uint64 `json:"gasLimit"`
-	GasPrice string `json:"gasPrice"`
-	Nonce    uint64 `json:"nonce"`
+	ChainID              int64           `json:"chainId"`
+	From                 string          `json:"from,omitempty"`
+	To                   string          `json:"to"`
+	Value                string          `json:"value"`
+	Data                 string          `json:"data,omitempty"`
+	GasLimit             uint64          `json:"gasLimit"`
+	GasPrice             string          `json:"gasPrice"`
+	MaxPriorityFeePerGas string          `json:"maxPriorityFeePerGas,omitempty"`
+	Nonce                uint64          `json:"nonce"`
+	AuthorizationList    []Authorization `json:"authorizationList,omitempty"`
+	MaxFeePerBlobGas     string          `json:"maxFeePerBlobGas,omitempty"`
+	Blobs                []string        `json:"blobs,omitempty"`
 }
 
 func (c *Client) GetWallet(id string) (*WalletResponse, error) {
@@ -179,8 +216,15 @@ func (c *Client) SignMessage(walletID string, req SignMessageRequest) (*Signatur
 	return &signature, nil
 }
 
-func (c *Client) SignTransaction(walletID string, tx *Transaction) (string, error) {
-	payload, err := json.Marshal(tx)
+// SignTransaction returns the raw signed transaction. When a quorum policy
+// queues the transaction instead, the error is an *ApprovalPendingError
+// carrying the approval request.
+func (c *Client) SignTransaction(walletID string, tx *Transaction, opts ...SignOption) (string, error) {
+	req := signTransactionRequest{Transaction: tx}
+	for _, opt := range opts {
+		opt(&req)
+	}
+	payload, err := json.Marshal(req)
 	if err != nil {
 		return "", fmt.Errorf("marshal request: %w", err)
 	}
@@ -191,6 +235,14 @@ func (c *Client) SignTransaction(walletID string, tx *Transaction) (string, erro
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusAccepted {
+		var request ApprovalRequest
+		if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+			return "", fmt.Errorf("decode response: %w", err)
+		}
+		return "", &ApprovalPendingError{Request: &request}
+	}
+
 	var body struct {
 		SignedTransaction string `json:"signedTransaction"`
 	}
@@ -214,6 +266,284 @@ func (c *Client) GetBalance(walletID string) (*BalanceResponse, error) {
 	return &balance, nil
 }
 
+// GetTokenBalance reads the wallet's balance of token, a symbol known on
+// its network or a contract address, in the token's base units.
+func (c *Client) GetTokenBalance(walletID string, token string) (*BalanceResponse, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/wallets/%s/balance?token=%s", c.baseURL, walletID, url.QueryEscape(token)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var balance BalanceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&balance); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &balance, nil
+}
+
+// DisableWallet stops the wallet from signing while keeping balance queries available.
+func (c *Client) DisableWallet(walletID string) (*WalletResponse, error) {
+	return c.walletTransition(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/disable", c.baseURL, walletID))
+}
+
+// EnableWallet reactivates a disabled, archived or recently deleted wallet.
+func (c *Client) EnableWallet(walletID string) (*WalletResponse, error) {
+	return c.walletTransition(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/enable", c.baseURL, walletID))
+}
+
+func (c *Client) ArchiveWallet(walletID string) (*WalletResponse, error) {
+	return c.walletTransition(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/archive", c.baseURL, walletID))
+}
+
+// DeleteWallet schedules the wallet's key for destruction after the server's grace period.
+func (c *Client) DeleteWallet(walletID string) (*WalletResponse, error) {
+	return c.walletTransition(http.MethodDelete, fmt.Sprintf("%s/v1/wallets/%s", c.baseURL, walletID))
+}
+
+func (c *Client) walletTransition(method string, endpoint string) (*WalletResponse, error) {
+	resp, err := c.doRequest(method, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var wallet WalletResponse
+	if err := json.NewDecoder(resp.Body).Decode(&wallet); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &wallet, nil
+}
+
+type AuditEntry struct {
+	Sequence    uint64    `json:"sequence"`
+	Timestamp   time.Time `json:"timestamp"`
+	Actor       string    `json:"actor"`
+	TenantID    string    `json:"tenantId"`
+	WalletID    string    `json:"walletId"`
+	Operation   string    `json:"operation"`
+	PayloadHash string    `json:"payloadHash"`
+	Result      string    `json:"result,omitempty"`
+	Delegate    string    `json:"delegate,omitempty"`
+	Outcome     string    `json:"outcome"`
+	Error       string    `json:"error,omitempty"`
+	PrevHash    string    `json:"prevHash"`
+	Hash        string    `json:"hash"`
+}
+
+type AuditFilter struct {
+	WalletID  string
+	Actor     string
+	Operation string
+	Since     time.Time
+	Until     time.Time
+	Limit     int
+}
+
+type AuditVerification struct {
+	Valid        bool   `json:"valid"`
+	Entries      int    `json:"entries"`
+	HeadHash     string `json:"headHash,omitempty"`
+	FirstInvalid uint64 `json:"firstInvalid,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+func (c *Client) ListAuditEntries(filter AuditFilter) ([]AuditEntry, error) {
+	query := url.Values{}
+	if filter.WalletID != "" {
+		query.Set("walletId", filter.WalletID)
+	}
+	if filter.Actor != "" {
+		query.Set("actor", filter.Actor)
+	}
+	if filter.Operation != "" {
+		query.Set("operation", filter.Operation)
+	}
+	if !filter.Since.IsZero() {
+		query.Set("since", filter.Since.Format(time.RFC3339))
+	}
+	if !filter.Until.IsZero() {
+		query.Set("until", filter.Until.Format(time.RFC3339))
+	}
+	if filter.Limit > 0 {
+		query.Set("limit", fmt.Sprint(filter.Limit))
+	}
+
+	endpoint := fmt.Sprintf("%s/v1/audit", c.baseURL)
+	if encoded := query.Encode(); encoded != "" {
+		endpoint += "?" + encoded
+	}
+
+	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var entries []AuditEntry
+	if err := json.NewDecoder(resp.Body).Decode(&entries); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return entries, nil
+}
+
+func (c *Client) VerifyAuditLog() (*AuditVerification, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/audit/verify", c.baseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result AuditVerification
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
+
+// CreateAPIKeyRequest issues a key. TenantID defaults to the caller's tenant;
+// other tenants require the platform scope.
+type CreateAPIKeyRequest struct {
+	Name     string   `json:"name"`
+	TenantID string   `json:"tenantId,omitempty"`
+	Scopes   []string `json:"scopes"`
+}
+
+type APIKeyResponse struct {
+	ID        string     `json:"id"`
+	TenantID  string     `json:"tenantId"`
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	// Token is only populated by CreateAPIKey.
+	Token string `json:"token,omitempty"`
+}
+
+func (c *Client) CreateAPIKey(req CreateAPIKeyRequest) (*APIKeyResponse, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/api-keys", c.baseURL), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var key APIKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &key, nil
+}
+
+func (c *Client) ListAPIKeys() ([]APIKeyResponse, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/api-keys", c.baseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var keys []APIKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return keys, nil
+}
+
+func (c *Client) RevokeAPIKey(keyID string) (*APIKeyResponse, error) {
+	resp, err := c.doRequest(http.MethodDelete, fmt.Sprintf("%s/v1/api-keys/%s", c.baseURL, keyID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var key APIKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &key, nil
+}
+
+type Tenant struct {
+	ID              string    `json:"id"`
+	Name            string    `json:"name"`
+	AllowedNetworks []string  `json:"allowedNetworks,omitempty"`
+	MaxWallets      int       `json:"maxWallets"`
+	CreatedAt       time.Time `json:"createdAt,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt,omitempty"`
+}
+
+type tenantRequest struct {
+	ID              string   `json:"id"`
+	Name            string   `json:"name"`
+	AllowedNetworks []string `json:"allowedNetworks"`
+	MaxWallets      int      `json:"maxWallets"`
+}
+
+func (c *Client) CreateTenant(tenant Tenant) (*Tenant, error) {
+	return c.sendTenant(http.MethodPost, fmt.Sprintf("%s/v1/tenants", c.baseURL), tenant)
+}
+
+// UpdateTenant replaces the tenant's name, network allowlist and wallet quota.
+func (c *Client) UpdateTenant(tenant Tenant) (*Tenant, error) {
+	return c.sendTenant(http.MethodPut, fmt.Sprintf("%s/v1/tenants/%s", c.baseURL, tenant.ID), tenant)
+}
+
+func (c *Client) GetTenant(id string) (*Tenant, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/tenants/%s", c.baseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tenant Tenant
+	if err := json.NewDecoder(resp.Body).Decode(&tenant); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &tenant, nil
+}
+
+func (c *Client) ListTenants() ([]Tenant, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/tenants", c.baseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tenants []Tenant
+	if err := json.NewDecoder(resp.Body).Decode(&tenants); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return tenants, nil
+}
+
+func (c *Client) sendTenant(method string, endpoint string, tenant Tenant) (*Tenant, error) {
+	payload, err := json.Marshal(tenantRequest{
+		ID:              tenant.ID,
+		Name:            tenant.Name,
+		AllowedNetworks: tenant.AllowedNetworks,
+		MaxWallets:      tenant.MaxWallets,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var stored Tenant
+	if err := json.NewDecoder(resp.Body).Decode(&stored); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &stored, nil
+}
+
 func (c *Client) doRequest(method string, endpoint string, body io.Reader) (*http.Response, error) {
 	req, err := http.NewRequest(method, endpoint, body)
 	if err != nil {
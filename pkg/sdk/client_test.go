@@ -8,20 +8,17 @@ import (
 	"net/http/httptest"
 	"testing"
 
-	"github.com/rickyreddygari/walletsdk/internal/app"
+	"github.com/rickyreddygari/walletsdk/internal/testutil"
 	"github.com/rickyreddygari/walletsdk/pkg/sdk"
 )
 
 func TestClientWalletLifecycle(t *testing.T) {
-	container, err := app.NewContainer()
-	if err != nil {
-		t.Fatalf("bootstrap container: %v", err)
-	}
+	container := testutil.NewTestContainer(t)
 
 	server := httptest.NewServer(container.HTTPServer)
 	defer server.Close()
 
-	client, err := sdk.NewClient(server.URL)
+	client, err := sdk.NewClient(server.URL, sdk.WithAPIKey(testutil.AdminAPIKey))
 	if err != nil {
 		t.Fatalf("create client: %v", err)
 	}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	ContractCallModeSend = "send"
+	ContractCallModeCall = "call"
+)
+
+// ContractCallRequest calls Method on Contract. ABI is a JSON ABI, a single
+// JSON fragment, or a human-readable signature such as
+// "transfer(address,uint256)". Args are marshalled to JSON; pass large
+// integers as decimal or 0x strings. Mode "call" runs a read-only eth_call and
+// decodes the return values instead of signing.
+type ContractCallRequest struct {
+	Contract string        `json:"contract"`
+	ABI      string        `json:"abi"`
+	Method   string        `json:"method,omitempty"`
+	Args     []interface{} `json:"args"`
+	Mode     string        `json:"mode,omitempty"`
+	ChainID  int64         `json:"chainId,omitempty"`
+	Value    string        `json:"value,omitempty"`
+	GasLimit uint64        `json:"gasLimit,omitempty"`
+	GasPrice string        `json:"gasPrice,omitempty"`
+	Nonce    uint64        `json:"nonce,omitempty"`
+	Force    bool          `json:"force,omitempty"`
+}
+
+type ContractOutput struct {
+	Name  string      `json:"name,omitempty"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+type ContractCallResult struct {
+	Data              string           `json:"data"`
+	SignedTransaction string           `json:"signedTransaction,omitempty"`
+	Outputs           []ContractOutput `json:"outputs,omitempty"`
+}
+
+// ContractCall encodes and signs, or calls, a contract method. When a quorum
+// policy queues the transaction, the error is an *ApprovalPendingError.
+func (c *Client) ContractCall(walletID string, req ContractCallRequest) (*ContractCallResult, error) {
+	if req.Args == nil {
+		req.Args = []interface{}{}
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/contract-call", c.baseURL, walletID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusAccepted {
+		var request ApprovalRequest
+		if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		return nil, &ApprovalPendingError{Request: &request}
+	}
+
+	var result ContractCallResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
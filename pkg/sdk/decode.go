@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// DecodedTransaction describes a raw signed transaction. Amounts are decimal
+// wei strings.
+type DecodedTransaction struct {
+	Type                 int          `json:"type"`
+	Hash                 string       `json:"hash"`
+	From                 string       `json:"from"`
+	To                   string       `json:"to,omitempty"`
+	ChainID              int64        `json:"chainId"`
+	Nonce                uint64       `json:"nonce"`
+	Value                string       `json:"value"`
+	GasLimit             uint64       `json:"gasLimit"`
+	GasPrice             string       `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string       `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string       `json:"maxPriorityFeePerGas,omitempty"`
+	MaxFeePerBlobGas     string       `json:"maxFeePerBlobGas,omitempty"`
+	BlobVersionedHashes  []string     `json:"blobVersionedHashes,omitempty"`
+	Data                 string       `json:"data,omitempty"`
+	Call                 *DecodedCall `json:"call,omitempty"`
+}
+
+// DecodedCall is calldata matched against a known or supplied ABI. Only
+// Selector is set when nothing matched.
+type DecodedCall struct {
+	Selector  string           `json:"selector"`
+	Signature string           `json:"signature,omitempty"`
+	Method    string           `json:"method,omitempty"`
+	Standards []string         `json:"standards,omitempty"`
+	Args      []ContractOutput `json:"args,omitempty"`
+	Calls     []DecodedCall    `json:"calls,omitempty"`
+}
+
+// DecodeTransaction parses a raw signed transaction. abi is optional and is
+// tried before the built-in registry.
+func (c *Client) DecodeTransaction(rawTransaction string, abi string) (*DecodedTransaction, error) {
+	payload, err := json.Marshal(map[string]string{"rawTransaction": rawTransaction, "abi": abi})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var decoded DecodedTransaction
+	if err := c.decode(c.baseURL+"/v1/decode/transaction", payload, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+// DecodeCalldata decodes calldata. abi is optional and is tried before the
+// built-in registry.
+func (c *Client) DecodeCalldata(data string, abi string) (*DecodedCall, error) {
+	payload, err := json.Marshal(map[string]string{"data": data, "abi": abi})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var call DecodedCall
+	if err := c.decode(c.baseURL+"/v1/decode/calldata", payload, &call); err != nil {
+		return nil, err
+	}
+	return &call, nil
+}
+
+func (c *Client) decode(url string, payload []byte, out interface{}) error {
+	resp, err := c.doRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
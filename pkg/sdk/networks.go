@@ -0,0 +1,109 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Network is a network in chainlist form, as in the server's networks file.
+// Network defaults to ShortName, and RPC URLs may reference server
+// environment variables as ${VAR}.
+type Network struct {
+	Network        string                `json:"network,omitempty"`
+	Name           string                `json:"name"`
+	ShortName      string                `json:"shortName,omitempty"`
+	ChainID        int64                 `json:"chainId,omitempty"`
+	RPC            []string              `json:"rpc"`
+	NativeCurrency NativeCurrency        `json:"nativeCurrency"`
+	Explorers      []Explorer            `json:"explorers,omitempty"`
+	Family         string                `json:"family,omitempty"`
+	CosmosChainID  string                `json:"cosmosChainId,omitempty"`
+	Bech32Prefix   string                `json:"bech32Prefix,omitempty"`
+	BitcoinChain   string                `json:"bitcoinChain,omitempty"`
+	BundlerURL     string                `json:"bundlerUrl,omitempty"`
+	Tokens         map[string]TokenEntry `json:"tokens,omitempty"`
+	Disabled       bool                  `json:"disabled,omitempty"`
+}
+
+type NativeCurrency struct {
+	Name     string `json:"name,omitempty"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+type Explorer struct {
+	Name     string `json:"name,omitempty"`
+	URL      string `json:"url"`
+	Standard string `json:"standard,omitempty"`
+}
+
+// TokenEntry is a token contract known on a network, keyed by symbol.
+type TokenEntry struct {
+	Address  string `json:"address"`
+	Decimals uint8  `json:"decimals"`
+}
+
+// NetworkInfo describes a registered network.
+type NetworkInfo struct {
+	Network     string `json:"network"`
+	Name        string `json:"name"`
+	Family      string `json:"family"`
+	ChainID     int64  `json:"chainId,omitempty"`
+	NativeAsset string `json:"nativeAsset"`
+	Decimals    uint8  `json:"decimals"`
+	ExplorerURL string `json:"explorerUrl,omitempty"`
+	Disabled    bool   `json:"disabled"`
+}
+
+func (c *Client) ListNetworks() ([]NetworkInfo, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/networks", c.baseURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var networks []NetworkInfo
+	if err := json.NewDecoder(resp.Body).Decode(&networks); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return networks, nil
+}
+
+// AddNetwork registers a network. It needs the platform scope.
+func (c *Client) AddNetwork(network Network) (*NetworkInfo, error) {
+	payload, err := json.Marshal(network)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var info NetworkInfo
+	if err := c.decode(fmt.Sprintf("%s/v1/networks", c.baseURL), payload, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// DisableNetwork stops wallets from being created on a network. Existing
+// wallets keep working.
+func (c *Client) DisableNetwork(network string) (*NetworkInfo, error) {
+	return c.networkTransition(fmt.Sprintf("%s/v1/networks/%s/disable", c.baseURL, network))
+}
+
+func (c *Client) EnableNetwork(network string) (*NetworkInfo, error) {
+	return c.networkTransition(fmt.Sprintf("%s/v1/networks/%s/enable", c.baseURL, network))
+}
+
+func (c *Client) networkTransition(endpoint string) (*NetworkInfo, error) {
+	resp, err := c.doRequest(http.MethodPost, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var info NetworkInfo
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &info, nil
+}
@@ -0,0 +1,192 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PolicyRules mirrors the server's rule set. Amounts are wei, as decimal
+// strings or 0x-prefixed hex, and Window is a Go duration string such as "24h".
+type PolicyRules struct {
+	MaxValue            string      `json:"maxValue,omitempty"`
+	WindowValue         string      `json:"windowValue,omitempty"`
+	Window              string      `json:"window,omitempty"`
+	AllowedDestinations []string    `json:"allowedDestinations,omitempty"`
+	DeniedDestinations  []string    `json:"deniedDestinations,omitempty"`
+	AllowedMethods      []string    `json:"allowedMethods,omitempty"`
+	Quorum              *QuorumRule `json:"quorum,omitempty"`
+	AllowDelegateCall   bool        `json:"allowDelegateCall,omitempty"`
+}
+
+// QuorumRule queues transactions until Required of Approvers approve them.
+// Approvers are actor names such as "apikey:<id>" or "user:<sub>".
+type QuorumRule struct {
+	Required  int      `json:"required"`
+	Approvers []string `json:"approvers"`
+	TTL       string   `json:"ttl,omitempty"`
+	Broadcast bool     `json:"broadcast,omitempty"`
+}
+
+// Policy constrains the transactions a wallet may sign. Leave WalletID empty
+// to apply the policy to every wallet in the tenant. Expression is an
+// optional CEL expression that must evaluate to true.
+type Policy struct {
+	ID         string      `json:"id,omitempty"`
+	TenantID   string      `json:"tenantId,omitempty"`
+	WalletID   string      `json:"walletId,omitempty"`
+	Name       string      `json:"name"`
+	Networks   []string    `json:"networks,omitempty"`
+	Rules      PolicyRules `json:"rules"`
+	Expression string      `json:"expression,omitempty"`
+	Version    int         `json:"version,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt,omitempty"`
+	UpdatedAt  time.Time   `json:"updatedAt,omitempty"`
+}
+
+type policyRequest struct {
+	Name       string      `json:"name"`
+	WalletID   string      `json:"walletId,omitempty"`
+	Networks   []string    `json:"networks,omitempty"`
+	Rules      PolicyRules `json:"rules"`
+	Expression string      `json:"expression,omitempty"`
+}
+
+// PolicyDryRunRequest evaluates Transaction against the stored policy
+// PolicyID, at Version or the latest when zero, or against the unsaved Policy
+// when PolicyID is empty.
+type PolicyDryRunRequest struct {
+	PolicyID    string      `json:"-"`
+	Version     int         `json:"version,omitempty"`
+	Policy      *Policy     `json:"policy,omitempty"`
+	WalletID    string      `json:"walletId"`
+	Transaction Transaction `json:"transaction"`
+}
+
+// PolicyDryRunResult reports whether the policy would allow the transaction.
+type PolicyDryRunResult struct {
+	PolicyID         string `json:"policyId,omitempty"`
+	Version          int    `json:"version,omitempty"`
+	Allowed          bool   `json:"allowed"`
+	Rule             string `json:"rule,omitempty"`
+	Reason           string `json:"reason,omitempty"`
+	RequiresApproval bool   `json:"requiresApproval"`
+}
+
+func (c *Client) CreatePolicy(policy Policy) (*Policy, error) {
+	return c.sendPolicy(http.MethodPost, fmt.Sprintf("%s/v1/policies", c.baseURL), policy)
+}
+
+func (c *Client) UpdatePolicy(policy Policy) (*Policy, error) {
+	return c.sendPolicy(http.MethodPut, fmt.Sprintf("%s/v1/policies/%s", c.baseURL, policy.ID), policy)
+}
+
+func (c *Client) GetPolicy(id string) (*Policy, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/policies/%s", c.baseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var policy Policy
+	if err := json.NewDecoder(resp.Body).Decode(&policy); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &policy, nil
+}
+
+// ListPolicies returns the tenant's policies. A non-empty walletID limits the
+// result to policies that govern that wallet.
+func (c *Client) ListPolicies(walletID string) ([]Policy, error) {
+	endpoint := fmt.Sprintf("%s/v1/policies", c.baseURL)
+	if walletID != "" {
+		endpoint += "?walletId=" + url.QueryEscape(walletID)
+	}
+
+	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var policies []Policy
+	if err := json.NewDecoder(resp.Body).Decode(&policies); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return policies, nil
+}
+
+func (c *Client) DeletePolicy(id string) error {
+	resp, err := c.doRequest(http.MethodDelete, fmt.Sprintf("%s/v1/policies/%s", c.baseURL, id), nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// ListPolicyVersions returns every version of a policy, oldest first.
+func (c *Client) ListPolicyVersions(id string) ([]Policy, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/policies/%s/versions", c.baseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var versions []Policy
+	if err := json.NewDecoder(resp.Body).Decode(&versions); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return versions, nil
+}
+
+// DryRunPolicy evaluates a transaction against a policy without signing it.
+func (c *Client) DryRunPolicy(req PolicyDryRunRequest) (*PolicyDryRunResult, error) {
+	endpoint := fmt.Sprintf("%s/v1/policies/dry-run", c.baseURL)
+	if req.PolicyID != "" {
+		endpoint = fmt.Sprintf("%s/v1/policies/%s/dry-run", c.baseURL, req.PolicyID)
+	}
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result PolicyDryRunResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
+
+func (c *Client) sendPolicy(method string, endpoint string, policy Policy) (*Policy, error) {
+	payload, err := json.Marshal(policyRequest{
+		Name:       policy.Name,
+		WalletID:   policy.WalletID,
+		Networks:   policy.Networks,
+		Rules:      policy.Rules,
+		Expression: policy.Expression,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var stored Policy
+	if err := json.NewDecoder(resp.Body).Decode(&stored); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &stored, nil
+}
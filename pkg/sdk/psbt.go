@@ -0,0 +1,31 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// PSBTResult is a PSBT with the wallet's inputs signed and finalized. Once
+// Complete, Transaction is the hex transaction ready to broadcast.
+type PSBTResult struct {
+	PSBT         string `json:"psbt"`
+	TxID         string `json:"txid"`
+	SignedInputs []int  `json:"signedInputs"`
+	Complete     bool   `json:"complete"`
+	Transaction  string `json:"transaction,omitempty"`
+}
+
+// SignPSBT signs the inputs of a base64 PSBT that spend a bitcoin wallet's
+// address. The outputs are checked against the wallet's policies first.
+func (c *Client) SignPSBT(walletID string, psbt string) (*PSBTResult, error) {
+	payload, err := json.Marshal(map[string]string{"psbt": psbt})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var result PSBTResult
+	if err := c.decode(fmt.Sprintf("%s/v1/wallets/%s/sign-psbt", c.baseURL, walletID), payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
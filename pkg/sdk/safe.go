@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	SafeOperationCall         = 0
+	SafeOperationDelegateCall = 1
+)
+
+// SafeTransaction is a SafeTx. Value and the gas fields are wei amounts,
+// decimal or 0x hex. An empty Nonce uses the Safe's current nonce.
+type SafeTransaction struct {
+	To             string `json:"to"`
+	Value          string `json:"value,omitempty"`
+	Data           string `json:"data,omitempty"`
+	Operation      uint8  `json:"operation"`
+	SafeTxGas      string `json:"safeTxGas,omitempty"`
+	BaseGas        string `json:"baseGas,omitempty"`
+	GasPrice       string `json:"gasPrice,omitempty"`
+	GasToken       string `json:"gasToken,omitempty"`
+	RefundReceiver string `json:"refundReceiver,omitempty"`
+	Nonce          string `json:"nonce,omitempty"`
+}
+
+type SafeProposalRequest struct {
+	Network     string          `json:"network"`
+	Safe        string          `json:"safe"`
+	Transaction SafeTransaction `json:"transaction"`
+}
+
+type SafeSignature struct {
+	Owner     string    `json:"owner"`
+	WalletID  string    `json:"walletId"`
+	Signature string    `json:"signature"`
+	SignedAt  time.Time `json:"signedAt"`
+}
+
+// SafeProposal is a SafeTx collecting owner signatures. Status is
+// "pending", "ready" or "executed"; ExecutionData is the execTransaction
+// calldata, set once the threshold is met.
+type SafeProposal struct {
+	ID                string          `json:"id"`
+	TenantID          string          `json:"tenantId"`
+	Network           string          `json:"network"`
+	ChainID           int64           `json:"chainId"`
+	Safe              string          `json:"safe"`
+	Version           string          `json:"version"`
+	Threshold         int             `json:"threshold"`
+	Owners            []string        `json:"owners"`
+	Transaction       SafeTransaction `json:"transaction"`
+	SafeTxHash        string          `json:"safeTxHash"`
+	TypedData         *TypedData      `json:"typedData"`
+	Signatures        []SafeSignature `json:"signatures"`
+	Status            string          `json:"status"`
+	ExecutionData     string          `json:"executionData,omitempty"`
+	SignedTransaction string          `json:"signedTransaction,omitempty"`
+	TxHash            string          `json:"txHash,omitempty"`
+	BroadcastError    string          `json:"broadcastError,omitempty"`
+	CreatedAt         time.Time       `json:"createdAt"`
+	UpdatedAt         time.Time       `json:"updatedAt"`
+}
+
+// ProposeSafeTransaction records a SafeTx for the Safe's owner wallets to
+// sign.
+func (c *Client) ProposeSafeTransaction(req SafeProposalRequest) (*SafeProposal, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var proposal SafeProposal
+	if err := c.decode(c.baseURL+"/v1/safe-transactions", payload, &proposal); err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+// ListSafeProposals returns Safe proposals, optionally filtered by status.
+func (c *Client) ListSafeProposals(status string) ([]SafeProposal, error) {
+	endpoint := fmt.Sprintf("%s/v1/safe-transactions", c.baseURL)
+	if status != "" {
+		endpoint += "?status=" + url.QueryEscape(status)
+	}
+
+	resp, err := c.doRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var proposals []SafeProposal
+	if err := json.NewDecoder(resp.Body).Decode(&proposals); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return proposals, nil
+}
+
+func (c *Client) GetSafeProposal(id string) (*SafeProposal, error) {
+	resp, err := c.doRequest(http.MethodGet, fmt.Sprintf("%s/v1/safe-transactions/%s", c.baseURL, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var proposal SafeProposal
+	if err := json.NewDecoder(resp.Body).Decode(&proposal); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &proposal, nil
+}
+
+// SignSafeProposal adds the signature of walletID, an owner of the Safe.
+func (c *Client) SignSafeProposal(proposalID string, walletID string) (*SafeProposal, error) {
+	var proposal SafeProposal
+	if err := c.decode(fmt.Sprintf("%s/v1/wallets/%s/safe-transactions/%s/sign", c.baseURL, walletID, proposalID), []byte("{}"), &proposal); err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+// ExecuteSafeProposal signs, and optionally broadcasts, the execTransaction
+// call of a ready proposal from owner wallet walletID. When a quorum policy
+// queues it, the error is an *ApprovalPendingError.
+func (c *Client) ExecuteSafeProposal(proposalID string, walletID string, opts TokenTxOptions) (*SafeProposal, error) {
+	payload, err := json.Marshal(opts)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/safe-transactions/%s/execute", c.baseURL, walletID, proposalID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusAccepted {
+		var request ApprovalRequest
+		if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		return nil, &ApprovalPendingError{Request: &request}
+	}
+
+	var proposal SafeProposal
+	if err := json.NewDecoder(resp.Body).Decode(&proposal); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &proposal, nil
+}
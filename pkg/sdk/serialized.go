@@ -0,0 +1,31 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SerializedTransactionResult is a signed transaction in the encoding it
+// was submitted in, with the wallet's signature. TxID is set for Tron.
+type SerializedTransactionResult struct {
+	Transaction string `json:"transaction"`
+	Signature   string `json:"signature"`
+	TxID        string `json:"txId,omitempty"`
+}
+
+// SignSerializedTransaction signs a transaction in its chain's wire
+// encoding. Solana transactions are base64; the signature is base58.
+// Cosmos transactions are base64 SignDocs and Tron transactions hex
+// raw_data.
+func (c *Client) SignSerializedTransaction(walletID string, transaction string) (*SerializedTransactionResult, error) {
+	payload, err := json.Marshal(map[string]string{"transaction": transaction})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var result SerializedTransactionResult
+	if err := c.decode(fmt.Sprintf("%s/v1/wallets/%s/sign-serialized-transaction", c.baseURL, walletID), payload, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
@@ -0,0 +1,41 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SignatureVerifyRequest checks that Address signed exactly one of Message
+// (EIP-191), Hash (0x 32 bytes) or TypedData (EIP-712) on Network.
+type SignatureVerifyRequest struct {
+	Network   string     `json:"network"`
+	Address   string     `json:"address"`
+	Message   string     `json:"message,omitempty"`
+	Hash      string     `json:"hash,omitempty"`
+	TypedData *TypedData `json:"typedData,omitempty"`
+	Signature string     `json:"signature"`
+}
+
+// SignatureVerification reports whether a signature is valid. Method is
+// "ecrecover", "erc1271" or "erc6492" when it is.
+type SignatureVerification struct {
+	Valid   bool   `json:"valid"`
+	Method  string `json:"method,omitempty"`
+	Address string `json:"address"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// VerifySignature verifies an EOA or smart-account signature. A signature
+// that does not verify is not an error; check Valid and Reason.
+func (c *Client) VerifySignature(req SignatureVerifyRequest) (*SignatureVerification, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var verification SignatureVerification
+	if err := c.decode(c.baseURL+"/v1/signatures/verify", payload, &verification); err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
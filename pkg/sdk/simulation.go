@@ -0,0 +1,56 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SimulationResult is the outcome of running a transaction with eth_call.
+// RevertReason is decoded from Error(string) or Panic(uint256) revert data.
+type SimulationResult struct {
+	Success      bool            `json:"success"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	RevertData   string          `json:"revertData,omitempty"`
+	ReturnData   string          `json:"returnData,omitempty"`
+	GasEstimate  uint64          `json:"gasEstimate,omitempty"`
+	Trace        json.RawMessage `json:"trace,omitempty"`
+}
+
+type signTransactionRequest struct {
+	*Transaction
+	Force bool `json:"force,omitempty"`
+}
+
+// SignOption adjusts a single SignTransaction call.
+type SignOption func(*signTransactionRequest)
+
+// ForceSign signs the transaction even when the server's simulation shows it
+// reverting.
+func ForceSign() SignOption {
+	return func(req *signTransactionRequest) {
+		req.Force = true
+	}
+}
+
+// SimulateTransaction runs the transaction against the wallet's network
+// without signing it.
+func (c *Client) SimulateTransaction(walletID string, tx *Transaction) (*SimulationResult, error) {
+	payload, err := json.Marshal(tx)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/simulate", c.baseURL, walletID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result SimulationResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type SIWENonce struct {
+	Nonce     string    `json:"nonce"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SIWERequest describes a Sign-In With Ethereum message. Nonce should come
+// from IssueSIWENonce and is issued by the server when empty. ChainID
+// defaults to the wallet's network. ExpiresIn is a Go duration such as "5m".
+type SIWERequest struct {
+	Domain    string     `json:"domain"`
+	URI       string     `json:"uri"`
+	Statement string     `json:"statement,omitempty"`
+	ChainID   int64      `json:"chainId,omitempty"`
+	Nonce     string     `json:"nonce,omitempty"`
+	ExpiresIn string     `json:"expiresIn,omitempty"`
+	NotBefore *time.Time `json:"notBefore,omitempty"`
+	RequestID string     `json:"requestId,omitempty"`
+	Resources []string   `json:"resources,omitempty"`
+}
+
+// SIWEMessage holds the fields of an EIP-4361 message.
+type SIWEMessage struct {
+	Domain         string     `json:"domain"`
+	Address        string     `json:"address"`
+	Statement      string     `json:"statement,omitempty"`
+	URI            string     `json:"uri"`
+	Version        string     `json:"version"`
+	ChainID        int64      `json:"chainId"`
+	Nonce          string     `json:"nonce"`
+	IssuedAt       time.Time  `json:"issuedAt"`
+	ExpirationTime *time.Time `json:"expirationTime,omitempty"`
+	NotBefore      *time.Time `json:"notBefore,omitempty"`
+	RequestID      string     `json:"requestId,omitempty"`
+	Resources      []string   `json:"resources,omitempty"`
+}
+
+type SIWESignature struct {
+	Message   string       `json:"message"`
+	Signature string       `json:"signature"`
+	Fields    *SIWEMessage `json:"fields"`
+}
+
+type SIWEVerification struct {
+	Valid   bool         `json:"valid"`
+	Address string       `json:"address,omitempty"`
+	Reason  string       `json:"reason,omitempty"`
+	Fields  *SIWEMessage `json:"fields,omitempty"`
+}
+
+// IssueSIWENonce asks the server for a single-use sign-in nonce.
+func (c *Client) IssueSIWENonce() (*SIWENonce, error) {
+	var nonce SIWENonce
+	if err := c.decode(c.baseURL+"/v1/siwe/nonce", []byte("{}"), &nonce); err != nil {
+		return nil, err
+	}
+	return &nonce, nil
+}
+
+// SignInWithEthereum builds an EIP-4361 message for the wallet and signs it.
+func (c *Client) SignInWithEthereum(walletID string, req SIWERequest) (*SIWESignature, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var signature SIWESignature
+	if err := c.decode(fmt.Sprintf("%s/v1/wallets/%s/siwe", c.baseURL, walletID), payload, &signature); err != nil {
+		return nil, err
+	}
+	return &signature, nil
+}
+
+// VerifySIWE checks a signed SIWE message and consumes its nonce. domain is
+// optional and, when set, must match the message. An invalid sign-in is not
+// an error; check Valid and Reason.
+func (c *Client) VerifySIWE(message, signature, domain string) (*SIWEVerification, error) {
+	payload, err := json.Marshal(map[string]string{"message": message, "signature": signature, "domain": domain})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	var verification SIWEVerification
+	if err := c.decode(c.baseURL+"/v1/siwe/verify", payload, &verification); err != nil {
+		return nil, err
+	}
+	return &verification, nil
+}
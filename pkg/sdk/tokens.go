@@ -0,0 +1,164 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TokenAmountMax approves an unlimited allowance.
+const TokenAmountMax = "max"
+
+// TokenTxOptions fills in the transaction around a token operation. Fields
+// left empty are read from the network.
+type TokenTxOptions struct {
+	GasLimit  uint64 `json:"gasLimit,omitempty"`
+	GasPrice  string `json:"gasPrice,omitempty"`
+	Nonce     uint64 `json:"nonce,omitempty"`
+	Broadcast bool   `json:"broadcast,omitempty"`
+	Force     bool   `json:"force,omitempty"`
+}
+
+// TokenTransferRequest sends Amount whole tokens, such as "12.5", of Token to
+// To. Token is a registered symbol such as "USDC" or a contract address.
+type TokenTransferRequest struct {
+	Token  string `json:"token"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+	TokenTxOptions
+}
+
+// TokenApprovalRequest lets Spender move up to Amount of Token. Amount may be
+// TokenAmountMax.
+type TokenApprovalRequest struct {
+	Token   string `json:"token"`
+	Spender string `json:"spender"`
+	Amount  string `json:"amount"`
+	TokenTxOptions
+}
+
+type Token struct {
+	Symbol   string `json:"symbol,omitempty"`
+	Address  string `json:"address"`
+	Decimals uint8  `json:"decimals"`
+}
+
+type TokenTxResult struct {
+	Token Token `json:"token"`
+	// Amount is in the token's base units.
+	Amount            string       `json:"amount"`
+	Transaction       *Transaction `json:"transaction"`
+	SignedTransaction string       `json:"signedTransaction"`
+	TxHash            string       `json:"txHash,omitempty"`
+	BroadcastError    string       `json:"broadcastError,omitempty"`
+}
+
+// TransferToken signs, and optionally broadcasts, an ERC-20 transfer. When a
+// quorum policy queues it, the error is an *ApprovalPendingError.
+func (c *Client) TransferToken(walletID string, req TokenTransferRequest) (*TokenTxResult, error) {
+	return c.tokenOperation(walletID, "transfer-token", req)
+}
+
+// ApproveToken signs, and optionally broadcasts, an ERC-20 approval. When a
+// quorum policy queues it, the error is an *ApprovalPendingError.
+func (c *Client) ApproveToken(walletID string, req TokenApprovalRequest) (*TokenTxResult, error) {
+	return c.tokenOperation(walletID, "approve-token", req)
+}
+
+func (c *Client) tokenOperation(walletID string, operation string, req interface{}) (*TokenTxResult, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/%s", c.baseURL, walletID, operation), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusAccepted {
+		var request ApprovalRequest
+		if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		return nil, &ApprovalPendingError{Request: &request}
+	}
+
+	var result TokenTxResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
+
+const (
+	PermitKindEIP2612 = "eip2612"
+	PermitKindPermit2 = "permit2"
+)
+
+// PermitRequest asks for a permit signature letting Spender move Value, in
+// whole tokens or TokenAmountMax, of Token. Kind defaults to EIP-2612.
+// Deadline and Expiration are unix seconds; the deadline defaults to an hour
+// from now.
+type PermitRequest struct {
+	Kind       string `json:"kind,omitempty"`
+	Token      string `json:"token"`
+	Spender    string `json:"spender"`
+	Value      string `json:"value"`
+	Deadline   int64  `json:"deadline,omitempty"`
+	Expiration int64  `json:"expiration,omitempty"`
+}
+
+// TypedData is the EIP-712 document that was signed.
+type TypedData struct {
+	Types       map[string][]TypedDataField `json:"types"`
+	PrimaryType string                      `json:"primaryType"`
+	Domain      TypedDataDomain             `json:"domain"`
+	Message     map[string]interface{}      `json:"message"`
+}
+
+type TypedDataField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type TypedDataDomain struct {
+	Name              string `json:"name,omitempty"`
+	Version           string `json:"version,omitempty"`
+	ChainID           int64  `json:"chainId,omitempty"`
+	VerifyingContract string `json:"verifyingContract,omitempty"`
+}
+
+type PermitResult struct {
+	Token Token `json:"token"`
+	// Value is in the token's base units.
+	Value     string     `json:"value"`
+	Deadline  int64      `json:"deadline"`
+	Signature string     `json:"signature"`
+	V         uint8      `json:"v"`
+	R         string     `json:"r"`
+	S         string     `json:"s"`
+	TypedData *TypedData `json:"typedData"`
+}
+
+// SignPermit signs an EIP-2612 or Permit2 permit with the wallet.
+func (c *Client) SignPermit(walletID string, req PermitRequest) (*PermitResult, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/permit", c.baseURL, walletID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result PermitResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UserOperation is an ERC-4337 user operation. Quantities and byte fields
+// are 0x hex. InitCode and PaymasterAndData are the v0.6 fields; v0.7 uses
+// Factory/FactoryData and the Paymaster fields instead.
+type UserOperation struct {
+	Sender                        string `json:"sender"`
+	Nonce                         string `json:"nonce"`
+	InitCode                      string `json:"initCode,omitempty"`
+	Factory                       string `json:"factory,omitempty"`
+	FactoryData                   string `json:"factoryData,omitempty"`
+	CallData                      string `json:"callData"`
+	CallGasLimit                  string `json:"callGasLimit"`
+	VerificationGasLimit          string `json:"verificationGasLimit"`
+	PreVerificationGas            string `json:"preVerificationGas"`
+	MaxFeePerGas                  string `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas          string `json:"maxPriorityFeePerGas"`
+	PaymasterAndData              string `json:"paymasterAndData,omitempty"`
+	Paymaster                     string `json:"paymaster,omitempty"`
+	PaymasterVerificationGasLimit string `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       string `json:"paymasterPostOpGasLimit,omitempty"`
+	PaymasterData                 string `json:"paymasterData,omitempty"`
+	Signature                     string `json:"signature,omitempty"`
+}
+
+// UserOperationRequest signs UserOperation with the wallet as the smart
+// account's owner. Version is "v0.6" or "v0.7" (the default); EntryPoint
+// defaults to the canonical deployment. Submit sends it to the bundler.
+type UserOperationRequest struct {
+	Version       string        `json:"version,omitempty"`
+	EntryPoint    string        `json:"entryPoint,omitempty"`
+	UserOperation UserOperation `json:"userOperation"`
+	Submit        bool          `json:"submit,omitempty"`
+}
+
+type EntryPoint struct {
+	Version string `json:"version"`
+	Address string `json:"address"`
+}
+
+type UserOperationResult struct {
+	EntryPoint    EntryPoint     `json:"entryPoint"`
+	ChainID       int64          `json:"chainId"`
+	UserOpHash    string         `json:"userOpHash"`
+	UserOperation *UserOperation `json:"userOperation"`
+	Submitted     bool           `json:"submitted"`
+	SubmitError   string         `json:"submitError,omitempty"`
+}
+
+// SignUserOperation signs an ERC-4337 user operation and optionally submits
+// it to the network's bundler. When a quorum policy queues it, the error is
+// an *ApprovalPendingError.
+func (c *Client) SignUserOperation(walletID string, req UserOperationRequest) (*UserOperationResult, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(http.MethodPost, fmt.Sprintf("%s/v1/wallets/%s/user-operation", c.baseURL, walletID), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusAccepted {
+		var request ApprovalRequest
+		if err := json.NewDecoder(resp.Body).Decode(&request); err != nil {
+			return nil, fmt.Errorf("decode response: %w", err)
+		}
+		return nil, &ApprovalPendingError{Request: &request}
+	}
+
+	var result UserOperationResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return &result, nil
+}